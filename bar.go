@@ -2,16 +2,68 @@ package balafon
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/mgnsk/balafon/internal/ast"
 	"github.com/mgnsk/balafon/internal/constants"
 )
 
 // Bar is a single bar of events.
 type Bar struct {
-	Events  []Event
-	timeSig [2]uint8
+	Events    []Event
+	timeSig   [2]uint8
+	tempoRamp *tempoRamp
+	hairpin   *hairpin
+	ramps     []*controlRamp
+	grooves   map[uint8]groove     // grooves by human channel
+	humanize  map[uint8]*humanizer // humanizers by human channel
+	meters    map[uint8][2]uint8   // time signatures by human channel that differ from the bar
+	repeat    repeatMark
+	pickup    uint32                  // the length of a pickup bar or 0
+	split     map[*ast.Note]*ast.Note // the source notes of notes split at bar lines
+	velocity  []ast.Option            // the velocity options of play commands in the order applied
+}
+
+// repeatMark is the position of a bar in a repeat block for notation.
+type repeatMark struct {
+	skip        bool   // the bar repeats an already notated bar
+	forward     bool   // the bar begins a repeated section
+	backward    bool   // the bar ends a repeated section
+	times       int    // the number of times the section is played if not implied by voltas
+	ending      int    // the volta number or 0
+	endingStart bool   // the bar begins a volta
+	endingStop  string // the volta end type if the bar ends a volta
+}
+
+// tempoRamp is a gradual tempo change starting at the beginning of a bar.
+type tempoRamp struct {
+	from float64
+	to   float64
+	bars int
+}
+
+// hairpin is a gradual dynamics change on a channel starting at the beginning of a bar.
+type hairpin struct {
+	track   uint8  // the human channel
+	voice   uint8  // the voice for notation
+	wedge   string // the notated wedge type
+	dynamic string // the target dynamic
+	from    int
+	to      int
+	bars    int
+	control int // the controller number or -1 to change note velocities
+}
+
+// controlRamp is a gradual control change on a channel over the duration of a bar.
+type controlRamp struct {
+	track   uint8 // the human channel
+	control uint8
+	from    int
+	to      int
+	curve   string
 }
 
 // SetTimeSig sets the timesig for testing.
@@ -35,6 +87,24 @@ func (b *Bar) String() string {
 	return s.String()
 }
 
+// clone returns a copy of the bar that can be modified without affecting b.
+func (b *Bar) clone() *Bar {
+	return &Bar{
+		Events:    slices.Clone(b.Events),
+		timeSig:   b.timeSig,
+		tempoRamp: b.tempoRamp,
+		hairpin:   b.hairpin,
+		ramps:     slices.Clone(b.ramps),
+		grooves:   maps.Clone(b.grooves),
+		humanize:  maps.Clone(b.humanize),
+		meters:    maps.Clone(b.meters),
+		repeat:    b.repeat,
+		pickup:    b.pickup,
+		split:     maps.Clone(b.split),
+		velocity:  slices.Clone(b.velocity),
+	}
+}
+
 // IsZeroDuration returns whether the bar consists of only zero duration events.
 func (b *Bar) IsZeroDuration() bool {
 	for _, ev := range b.Events {
@@ -47,8 +117,30 @@ func (b *Bar) IsZeroDuration() bool {
 }
 
 // Cap returns the bar's capacity in ticks.
+// A pickup bar is only as long as its notes.
 func (b *Bar) Cap() uint32 {
-	return uint32(b.timeSig[0]) * (uint32(constants.TicksPerWhole) / uint32(b.timeSig[1]))
+	if b.pickup > 0 {
+		return b.pickup
+	}
+	return timeSigCap(b.timeSig)
+}
+
+// IsPickup reports whether the bar is a pickup bar.
+func (b *Bar) IsPickup() bool {
+	return b.pickup > 0
+}
+
+// trackCap returns the capacity in ticks of a track in the bar.
+// A track with its own meter loops on its own length.
+func (b *Bar) trackCap(track uint8) uint32 {
+	if meter, ok := b.meters[track]; ok {
+		return timeSigCap(meter)
+	}
+	return b.Cap()
+}
+
+func timeSigCap(timeSig [2]uint8) uint32 {
+	return uint32(timeSig[0]) * (uint32(constants.TicksPerWhole) / uint32(timeSig[1]))
 }
 
 // Duration returns the bar's duration.
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/mgnsk/balafon"
+	"github.com/mgnsk/balafon/internal/constants"
 	"github.com/spf13/cobra"
 	"gitlab.com/gomidi/midi/v2"
 	"gitlab.com/gomidi/midi/v2/drivers"
@@ -20,6 +21,27 @@ func addPortFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringP("port", "p", "0", "MIDI output port")
 }
 
+func addTempoResolutionFlag(cmd *cobra.Command, ticks *uint32) {
+	cmd.PersistentFlags().Uint32Var(ticks, "tempo-resolution", uint32(constants.DefaultTempoResolution), "tempo ramp resolution in ticks (960 per quarter note), 0 uses the default")
+}
+
+func addControlResolutionFlag(cmd *cobra.Command, ticks *uint32) {
+	cmd.PersistentFlags().Uint32Var(ticks, "control-resolution", uint32(constants.DefaultControlResolution), "control ramp resolution in ticks (960 per quarter note), 0 uses the default")
+}
+
+func addSeedFlag(cmd *cobra.Command, seed *uint64) {
+	cmd.PersistentFlags().Uint64Var(seed, "seed", 0, "seed of the random numbers for notes played by chance")
+}
+
+func newInterpreter(tempoResolution, controlResolution uint32, seed uint64) *balafon.Interpreter {
+	it := balafon.New()
+	it.SetTempoResolution(tempoResolution)
+	it.SetControlResolution(controlResolution)
+	it.SetSeed(seed)
+
+	return it
+}
+
 func main() {
 	defer midi.CloseDriver()
 
@@ -52,6 +74,8 @@ func main() {
 }
 
 func createCmdLive() *cobra.Command {
+	var seed uint64
+
 	cmd := &cobra.Command{
 		Use:   "live [file]",
 		Short: "Load a file and continue in a live shell",
@@ -63,6 +87,8 @@ func createCmdLive() *cobra.Command {
 			}
 
 			it := balafon.New()
+			it.SetSeed(seed)
+
 			if err := it.EvalFile(args[0]); err != nil {
 				return err
 			}
@@ -86,10 +112,16 @@ func createCmdLive() *cobra.Command {
 		},
 	}
 	addPortFlag(cmd)
+	addSeedFlag(cmd, &seed)
 	return cmd
 }
 
 func createCmdPlay() *cobra.Command {
+	var (
+		tempoResolution, controlResolution uint32
+		seed                               uint64
+	)
+
 	cmd := &cobra.Command{
 		Use:   "play [file]",
 		Short: "Play a file",
@@ -100,7 +132,8 @@ func createCmdPlay() *cobra.Command {
 				return err
 			}
 
-			it := balafon.New()
+			it := newInterpreter(tempoResolution, controlResolution, seed)
+
 			if err := it.EvalFile(args[0]); err != nil {
 				return err
 			}
@@ -116,6 +149,9 @@ func createCmdPlay() *cobra.Command {
 		},
 	}
 	addPortFlag(cmd)
+	addTempoResolutionFlag(cmd, &tempoResolution)
+	addControlResolutionFlag(cmd, &controlResolution)
+	addSeedFlag(cmd, &seed)
 	return cmd
 }
 
@@ -134,6 +170,12 @@ func createCmdLint() *cobra.Command {
 				os.Exit(1)
 			}
 
+			for _, w := range it.Warnings() {
+				if _, err := fmt.Fprintln(os.Stderr, w.String()); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -181,8 +223,11 @@ func createCmdFmt() *cobra.Command {
 
 func createCmdSMF() *cobra.Command {
 	var (
-		isText     bool
-		outputFile string
+		isText            bool
+		outputFile        string
+		tempoResolution   uint32
+		controlResolution uint32
+		seed              uint64
 	)
 
 	cmd := &cobra.Command{
@@ -194,12 +239,13 @@ func createCmdSMF() *cobra.Command {
 				outputFile = strings.TrimSuffix(args[0], ".bal") + ".mid"
 			}
 
-			b, err := os.ReadFile(args[0])
-			if err != nil {
+			it := newInterpreter(tempoResolution, controlResolution, seed)
+
+			if err := it.EvalFile(args[0]); err != nil {
 				return err
 			}
 
-			s, err := balafon.ToSMF(b)
+			s, err := balafon.BarsToSMF(it.Flush()...)
 			if err != nil {
 				return err
 			}
@@ -214,6 +260,9 @@ func createCmdSMF() *cobra.Command {
 
 	cmd.PersistentFlags().StringVarP(&outputFile, "output", "o", "", "output file")
 	cmd.PersistentFlags().BoolVarP(&isText, "text", "t", false, "write SMF as text")
+	addTempoResolutionFlag(cmd, &tempoResolution)
+	addControlResolutionFlag(cmd, &controlResolution)
+	addSeedFlag(cmd, &seed)
 
 	return cmd
 }
@@ -0,0 +1,13 @@
+package balafon
+
+// defaultDynamics maps dynamic marks to note velocities.
+var defaultDynamics = map[string]uint8{
+	"ppp": 16,
+	"pp":  33,
+	"p":   49,
+	"mp":  64,
+	"mf":  80,
+	"f":   96,
+	"ff":  112,
+	"fff": 127,
+}
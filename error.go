@@ -28,3 +28,19 @@ func (e *EvalError) Error() string {
 
 	return fmt.Sprintf("%d:%d: error: %s", e.Pos.Line, e.Pos.Column, e.Err.Error())
 }
+
+// Warning is a lint warning.
+type Warning struct {
+	Msg string
+	Pos Pos
+}
+
+func (w *Warning) String() string {
+	if w.Pos.Context != nil {
+		if src, ok := w.Pos.Context.(token.Sourcer); ok {
+			return fmt.Sprintf("%s:%d:%d: warning: %s", src.Source(), w.Pos.Line, w.Pos.Column, w.Msg)
+		}
+	}
+
+	return fmt.Sprintf("%d:%d: warning: %s", w.Pos.Line, w.Pos.Column, w.Msg)
+}
@@ -1,7 +1,9 @@
 package balafon
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/mgnsk/balafon/internal/ast"
@@ -34,7 +36,11 @@ type Event struct {
 	Pos      uint32 // in relative ticks from beginning of bar
 	Duration uint32 // in ticks
 	Voice    uint8
-	Track    uint8 // track is the MIDI channel in human value
+	Track    uint8  // track is the MIDI channel in human value
+	TieStart bool   // if the note is tied to the next note of the same pitch
+	TieStop  bool   // if the note continues a tied note and must not be played
+	Dynamic  string // dynamic mark for notation
+	Wedge    string // hairpin wedge type for notation
 }
 
 func (e *Event) String() string {
@@ -50,13 +56,56 @@ func (e *Event) String() string {
 		fmt.Fprintf(&s, " voice: %d", e.Voice)
 	}
 
+	if e.TieStop {
+		s.WriteString(" tie: stop")
+	}
+
+	if e.TieStart {
+		s.WriteString(" tie: start")
+	}
+
 	if e.Note != nil {
 		s.WriteString(" note: ")
 		e.Note.WriteTo(&s)
 	}
 
+	if e.Dynamic != "" {
+		fmt.Fprintf(&s, " dynamic: %s", e.Dynamic)
+	}
+
+	if e.Wedge != "" {
+		fmt.Fprintf(&s, " wedge: %s", e.Wedge)
+	}
+
 	s.WriteString(" message: ")
 	s.WriteString(e.Message.String())
 
 	return s.String()
 }
+
+// findNoteOff returns the index of the note off event
+// that ends the note on event at index i or -1 if not found.
+func findNoteOff(events []Event, i int) int {
+	var ch, key uint8
+	if !events[i].Message.GetNoteStart(&ch, &key, nil) {
+		return -1
+	}
+
+	end := events[i].Pos + events[i].Duration
+
+	for j := i + 1; j < len(events); j++ {
+		var offCh, offKey uint8
+		if events[j].Pos == end && events[j].Message.GetNoteEnd(&offCh, &offKey) && offCh == ch && offKey == key {
+			return j
+		}
+	}
+
+	return -1
+}
+
+// sortEvents sorts the events by position, keeping the order of events at the same position.
+func sortEvents(events []Event) {
+	slices.SortStableFunc(events, func(a, b Event) int {
+		return cmp.Compare(a.Pos, b.Pos)
+	})
+}
@@ -53,11 +53,9 @@ Count in.
 xxxo
 
 /* Play 8 :bars of the Bonham groove. */
-:play bonham1
-:play bonham2
-:play bonham2
-:play fill
-:play bonham1
-:play bonham2
-:play bonham2
-:play fill
+:repeat 2
+	:play bonham1
+	:play bonham2
+	:play bonham2
+	:play fill
+:end
@@ -13,6 +13,22 @@ import (
 
 var lf = []byte("\n")
 
+var blockPrefixes = [][]byte{
+	[]byte(":bar"),
+	[]byte(":repeat"),
+}
+
+// blockPrefix returns the prefix of a line that begins an indented block.
+func blockPrefix(line []byte) []byte {
+	for _, pref := range blockPrefixes {
+		if bytes.HasPrefix(line, pref) {
+			return pref
+		}
+	}
+
+	return nil
+}
+
 // FormatFile formats a file.
 func FormatFile(filename string) error {
 	f, err := os.Open(filename)
@@ -45,8 +61,7 @@ func Format(input []byte) ([]byte, error) {
 	p := parser.NewParser()
 
 	var (
-		isBar       bool
-		barBuffer   bytes.Buffer
+		depth       int
 		output      bytes.Buffer
 		isEmptyLine bool
 	)
@@ -63,64 +78,39 @@ func Format(input []byte) ([]byte, error) {
 			isEmptyLine = false
 		}
 
-		if isBar && bytes.HasPrefix(line, []byte(":end")) {
-			barBuffer.Write(line)
-			barBuffer.Write(lf)
-			isBar = false
+		isEnd := depth > 0 && bytes.HasPrefix(line, []byte(":end"))
+		if isEnd {
+			depth--
+		}
 
-			barBuffer.WriteTo(&output)
-			barBuffer.Reset()
-		} else if pref := []byte(":bar"); bytes.HasPrefix(line, pref) {
-			isBar = true
+		if len(line) > 0 {
+			output.Write(bytes.Repeat([]byte("\t"), depth))
+		}
 
-			barName := bytes.TrimPrefix(line, pref)
-			barName = bytes.TrimSpace(barName)
+		if isEnd {
+			output.Write(line)
+		} else if pref := blockPrefix(line); pref != nil {
+			depth++
 
-			barBuffer.Write(pref)
-			barBuffer.WriteString(" ")
-			barBuffer.Write(barName)
-			barBuffer.Write(lf)
-		} else if pref := []byte(":play"); bytes.HasPrefix(line, pref) {
-			barName := bytes.TrimPrefix(line, pref)
-			barName = bytes.TrimSpace(barName)
+			arg := bytes.TrimPrefix(line, pref)
+			arg = bytes.TrimSpace(arg)
 
 			output.Write(pref)
 			output.WriteString(" ")
-			output.Write(barName)
-			output.Write(lf)
-		} else if isBar {
-			if len(line) > 0 {
-				barBuffer.WriteString("\t")
-			}
-
-			if bytes.HasPrefix(line, []byte(":")) {
-				// Parse the command line and print.
-				node, err := p.Parse(lexer.NewLexer(line))
-				if err != nil {
-					return nil, err
-				}
-				node.(ast.NodeList).WriteTo(&barBuffer)
-			} else {
-				// Print note list raw.
-				barBuffer.Write(line)
+			output.Write(arg)
+		} else if bytes.HasPrefix(line, []byte(":")) {
+			// Parse the command line and print.
+			node, err := p.Parse(lexer.NewLexer(line))
+			if err != nil {
+				return nil, err
 			}
-
-			barBuffer.Write(lf)
+			node.(ast.NodeList).WriteTo(&output)
 		} else {
-			if bytes.HasPrefix(line, []byte(":")) {
-				// Parse the command line and print.
-				node, err := p.Parse(lexer.NewLexer(line))
-				if err != nil {
-					return nil, err
-				}
-				node.(ast.NodeList).WriteTo(&output)
-			} else {
-				// Print note list raw.
-				output.Write(line)
-			}
-
-			output.Write(lf)
+			// Print note list raw.
+			output.Write(line)
 		}
+
+		output.Write(lf)
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -101,3 +101,79 @@ func TestFmtPlayCommand(t *testing.T) {
 	g.Expect(err).NotTo(HaveOccurred())
 	g.Expect(string(res)).To(Equal(":play a\n"))
 }
+
+func TestFmtPlayCommandOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	input := `
+:play	  a   transpose=+5	velocity=-10 channel=3
+`
+
+	res, err := balafon.Format([]byte(input))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(res)).To(Equal(":play a transpose=+5 velocity=-10 channel=3\n"))
+}
+
+func TestFmtLineComment(t *testing.T) {
+	g := NewWithT(t)
+
+	input := `
+// Assign a note.
+:assign   c  60   // middle C
+:bar a // first bar
+  c   // note
+:end
+`
+
+	res, err := balafon.Format([]byte(input))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(res)).To(Equal(`// Assign a note.
+:assign c 60 // middle C
+:bar a // first bar
+	c   // note
+:end
+`))
+}
+
+func TestFmtRepeatIndent(t *testing.T) {
+	g := NewWithT(t)
+
+	input := `
+:repeat    2
+:play one
+   c
+ :volta  1
+d
+:volta 2
+   e
+  :end
+`
+
+	res, err := balafon.Format([]byte(input))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(res)).To(Equal(`:repeat 2
+	:play one
+	c
+	:volta 1
+	d
+	:volta 2
+	e
+:end
+`))
+}
+
+func TestFmtMultiCharacterSymbols(t *testing.T) {
+	g := NewWithT(t)
+
+	input := `:assign @kick 36
+:assign k 35
+[@kick k]8 @kick    k  @kick8 k8
+`
+
+	res, err := balafon.Format([]byte(input))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(res)).To(Equal(`:assign @kick 36
+:assign k 35
+[@kick k]8 @kick    k  @kick8 k8
+`))
+}
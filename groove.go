@@ -0,0 +1,208 @@
+package balafon
+
+import (
+	"math/rand/v2"
+
+	"gitlab.com/gomidi/midi/v2"
+	"gitlab.com/gomidi/midi/v2/smf"
+
+	"github.com/mgnsk/balafon/internal/constants"
+)
+
+// groove moves the notes on a grid and scales their velocities.
+type groove struct {
+	unit     uint32 // the grid step in ticks
+	offsets  []int  // the position offsets of the steps in percent of the step
+	velocity []int  // the velocities of the steps in percent
+}
+
+// grooves are the built-in groove templates.
+var grooves = map[string]groove{
+	"straight": {},
+	"shuffle": {
+		unit:     uint32(constants.TicksPerWhole / 8),
+		offsets:  []int{0, 33},
+		velocity: []int{100, 80},
+	},
+	"swing16": {
+		unit:     uint32(constants.TicksPerWhole / 16),
+		offsets:  []int{0, 20},
+		velocity: []int{100, 90},
+	},
+	"funk16": {
+		unit:     uint32(constants.TicksPerWhole / 16),
+		offsets:  []int{0, 8, 0, 12},
+		velocity: []int{100, 75, 90, 70},
+	},
+	"laidback": {
+		unit:     uint32(constants.TicksPerQuarter),
+		offsets:  []int{0, 4, 0, 4},
+		velocity: []int{100, 95, 100, 95},
+	},
+}
+
+// newSwing creates a groove that delays every second note of the value to percent of the note pair.
+func newSwing(percent, value uint8) groove {
+	return groove{
+		unit:    uint32(constants.TicksPerWhole) / uint32(value),
+		offsets: []int{0, 2*int(percent) - 100},
+	}
+}
+
+// isStraight reports whether the groove does not change the notes.
+func (g groove) isStraight() bool {
+	for _, v := range g.offsets {
+		if v != 0 {
+			return false
+		}
+	}
+	for _, v := range g.velocity {
+		if v != 100 {
+			return false
+		}
+	}
+	return true
+}
+
+// apply moves the note events of a track that are on the grid of the groove
+// and scales the velocities of the moved notes. Steps that don't fit in the bar are not moved.
+func (g groove) apply(events []Event, track uint8, barCap uint32) {
+	type noteKey struct {
+		channel, key uint8
+	}
+
+	moved := map[noteKey]uint32{} // note on positions of moved notes
+
+	for i := range events {
+		ev := &events[i]
+
+		if ev.Track != track {
+			continue
+		}
+
+		var channel, key, velocity uint8
+
+		switch {
+		case ev.Message.GetNoteStart(&channel, &key, &velocity):
+		case ev.Message.GetPolyAfterTouch(&channel, &key, nil):
+		case ev.Message.GetNoteEnd(&channel, &key):
+			// Keep the note off after a moved note on.
+			if pos, ok := moved[noteKey{channel, key}]; ok && ev.Pos < pos {
+				ev.Pos = pos
+			}
+		default:
+			continue
+		}
+
+		if ev.Pos%g.unit != 0 || ev.Pos+g.unit > barCap {
+			continue
+		}
+
+		step := int(ev.Pos / g.unit)
+
+		if len(g.offsets) > 0 {
+			ev.Pos += uint32(int(g.unit) * g.offsets[step%len(g.offsets)] / 100)
+		}
+
+		if velocity > 0 {
+			if len(g.velocity) > 0 {
+				v := int(velocity) * g.velocity[step%len(g.velocity)] / 100
+				ev.Message = smf.Message(midi.NoteOn(channel, key, uint8(max(1, min(v, constants.MaxValue)))))
+			}
+			moved[noteKey{channel, key}] = ev.Pos
+		}
+	}
+}
+
+// humanizer randomizes the positions and velocities of notes.
+type humanizer struct {
+	timing   int
+	velocity int
+	lock     uint32 // the grid in ticks that is not humanized or 0
+	rand     *rand.Rand
+}
+
+// newHumanizer creates a humanizer with a deterministic random source.
+func newHumanizer(timing, velocity int, seed uint64, lock uint8) *humanizer {
+	h := &humanizer{
+		timing:   timing,
+		velocity: velocity,
+		rand:     rand.New(rand.NewPCG(seed, seed)),
+	}
+
+	if lock > 0 {
+		h.lock = uint32(constants.TicksPerWhole) / uint32(lock)
+	}
+
+	return h
+}
+
+// jitter returns a random value in range [-n, n].
+func (h *humanizer) jitter(n int) int {
+	if n == 0 {
+		return 0
+	}
+	return h.rand.IntN(2*n+1) - n
+}
+
+// apply moves the note on and note off events of a track by the same random offset
+// and randomizes the note velocities. The events must be sorted.
+func (h *humanizer) apply(events []Event, track uint8, barCap uint32) {
+	type noteKey struct {
+		channel, key uint8
+	}
+
+	var (
+		offsets = map[noteKey]int{}    // the offsets of the playing notes
+		ons     = map[noteKey]uint32{} // the positions of the last note ons
+		offs    = map[noteKey]int{}    // the indices of the last note offs
+	)
+
+	for i := range events {
+		ev := &events[i]
+
+		if ev.Track != track {
+			continue
+		}
+
+		var channel, key, velocity uint8
+
+		switch {
+		case ev.Message.GetNoteStart(&channel, &key, &velocity):
+			k := noteKey{channel, key}
+
+			if ev.TieStop || (h.lock > 0 && ev.Pos%h.lock == 0) {
+				offsets[k] = 0
+				ons[k] = ev.Pos
+				continue
+			}
+
+			d := h.jitter(h.timing)
+			// Keep the note in the bar and after the previous note of the same key.
+			d = min(d, int(barCap)-1-int(ev.Pos))
+			d = max(d, -int(ev.Pos))
+			if pos, ok := ons[k]; ok {
+				d = max(d, int(pos)-int(ev.Pos))
+			}
+
+			ev.Pos = uint32(int(ev.Pos) + d)
+			offsets[k] = d
+			ons[k] = ev.Pos
+
+			// Shorten the previous note of the same key if the notes overlap.
+			if j, ok := offs[k]; ok && events[j].Pos > ev.Pos {
+				events[j].Pos = ev.Pos
+			}
+
+			v := int(velocity) + h.jitter(h.velocity)
+			ev.Message = smf.Message(midi.NoteOn(channel, key, uint8(max(1, min(v, constants.MaxValue)))))
+
+		case ev.Message.GetNoteEnd(&channel, &key):
+			k := noteKey{channel, key}
+			// Keep the note off in the bar so that it doesn't end the note of the next bar.
+			ev.Pos = min(uint32(int(ev.Pos)+offsets[k]), max(ev.Pos, barCap))
+			delete(offsets, k)
+			offs[k] = i
+		}
+	}
+}
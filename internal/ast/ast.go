@@ -2,6 +2,7 @@ package ast
 
 import (
 	"io"
+	"strings"
 )
 
 // Node is an AST node.
@@ -16,13 +17,31 @@ type NodeList []Node
 func (list NodeList) WriteTo(w io.Writer) (n int64, err error) {
 	ew := newErrWriter(w)
 
-	for _, decl := range list {
+	for i, decl := range list {
+		if _, ok := decl.(LineComment); ok && i > 0 {
+			// Trailing comment.
+			n += int64(ew.WriteString(" "))
+		} else if i > 0 && isSymbolJoined(list[i-1], decl) {
+			n += int64(ew.WriteString(" "))
+		}
 		n += int64(ew.WriteFrom(decl))
 	}
 
 	return n, ew.Flush()
 }
 
+// isSymbolJoined reports whether a multi-character symbol without properties
+// would be joined with the next letter symbol when written without a space.
+func isSymbolJoined(prev, next Node) bool {
+	a, ok := prev.(*Note)
+	if !ok || len(a.Props) > 0 || !strings.HasPrefix(a.Name, "@") {
+		return false
+	}
+
+	b, ok := next.(*Note)
+	return ok && b.Chord == nil && b.Pitch == nil && b.Degree == 0 && !b.IsPause() && !strings.HasPrefix(b.Name, "@")
+}
+
 // NewNodeList creates a new node list.
 func NewNodeList(stmt Node, inner ...Node) (list NodeList) {
 	return append(NodeList{stmt}, inner...)
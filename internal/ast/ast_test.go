@@ -156,6 +156,35 @@ func TestAdditiveProperties(t *testing.T) {
 	g.Expect(n.Props.NumDot()).To(Equal(2))
 }
 
+func TestOctaveProperties(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		octave int
+	}{
+		{":assign c 60; c'", 1},
+		{":assign c 60; c''", 2},
+		{":assign c 60; c,", -1},
+		{":assign c 60; [c',]'", 1},
+		{":assign c 60; [c,],", -2},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			nodeList, err := parse(tc.input)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			var notes []*ast.Note
+			ast.WalkNotes(nodeList, nil, func(note *ast.Note) error {
+				notes = append(notes, note)
+				return nil
+			})
+
+			g.Expect(notes).To(HaveLen(1))
+			g.Expect(notes[0].Props.Octave()).To(Equal(tc.octave))
+		})
+	}
+}
+
 func BenchmarkParser(b *testing.B) {
 	lex := lexer.NewLexer([]byte(
 		"[[[[[k*]/3].]$].8]))",
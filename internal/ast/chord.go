@@ -0,0 +1,154 @@
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mgnsk/balafon/internal/parser/token"
+)
+
+// chordQualities maps chord qualities to semitone intervals above the root.
+var chordQualities = map[string][]int{
+	"":      {0, 4, 7},
+	"m":     {0, 3, 7},
+	"dim":   {0, 3, 6},
+	"aug":   {0, 4, 8},
+	"+":     {0, 4, 8},
+	"sus2":  {0, 2, 7},
+	"sus4":  {0, 5, 7},
+	"6":     {0, 4, 7, 9},
+	"m6":    {0, 3, 7, 9},
+	"7":     {0, 4, 7, 10},
+	"7sus4": {0, 5, 7, 10},
+	"maj7":  {0, 4, 7, 11},
+	"m7":    {0, 3, 7, 10},
+	"mmaj7": {0, 3, 7, 11},
+	"m7b5":  {0, 3, 6, 10},
+	"dim7":  {0, 3, 6, 9},
+	"add9":  {0, 4, 7, 14},
+	"9":     {0, 4, 7, 10, 14},
+	"maj9":  {0, 4, 7, 11, 14},
+	"m9":    {0, 3, 7, 10, 14},
+}
+
+// pitchClasses maps note letters to pitch classes.
+var pitchClasses = map[byte]int{
+	'C': 0,
+	'D': 2,
+	'E': 4,
+	'F': 5,
+	'G': 7,
+	'A': 9,
+	'B': 11,
+}
+
+// Chord is a parsed chord symbol.
+type Chord struct {
+	Symbol    string // the symbol without braces
+	Root      rune   // root letter A-G
+	RootShift int    // -1 for flat, 1 for sharp root
+	Octave    int    // explicit root octave or -1 if the root is voiced by the keymap
+	Intervals []int  // semitones above the root
+	Bass      rune   // slash chord bass letter or 0
+	BassShift int    // -1 for flat, 1 for sharp bass
+}
+
+// RootClass returns the pitch class of the root.
+func (c *Chord) RootClass() int {
+	return (pitchClasses[byte(c.Root)] + c.RootShift + 12) % 12
+}
+
+// BassClass returns the pitch class of the bass note.
+func (c *Chord) BassClass() int {
+	return (pitchClasses[byte(c.Bass)] + c.BassShift + 12) % 12
+}
+
+// IsFlat reports whether the chord is spelled with flats.
+func (c *Chord) IsFlat() bool {
+	return c.RootShift < 0 || c.BassShift < 0
+}
+
+// parseLetter parses a note letter with an optional accidental.
+func parseLetter(s string) (letter rune, shift int, rest string, err error) {
+	if s == "" {
+		return 0, 0, "", fmt.Errorf("missing note letter")
+	}
+
+	if _, ok := pitchClasses[s[0]]; !ok {
+		return 0, 0, "", fmt.Errorf("invalid note letter '%c'", s[0])
+	}
+
+	letter, rest = rune(s[0]), s[1:]
+
+	switch {
+	case strings.HasPrefix(rest, "#"):
+		shift, rest = 1, rest[1:]
+	case strings.HasPrefix(rest, "b"):
+		shift, rest = -1, rest[1:]
+	}
+
+	return letter, shift, rest, nil
+}
+
+// NewChord creates a chord note from a chord symbol in the form {RootQuality[/Bass][:Octave]}.
+func NewChord(pos token.Pos, symbol string, props PropertyList) (*Note, error) {
+	symbol = strings.TrimSuffix(strings.TrimPrefix(symbol, "{"), "}")
+
+	if props.IsSharp() || props.IsFlat() {
+		return nil, fmt.Errorf("sharp or flat property not allowed on chord '%s'", symbol)
+	}
+
+	chord := &Chord{
+		Symbol: symbol,
+		Octave: -1,
+	}
+
+	s := symbol
+
+	if before, after, ok := strings.Cut(s, ":"); ok {
+		octave, err := strconv.Atoi(after)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chord octave '%s'", after)
+		}
+		if err := validateRange(octave, 0, 9); err != nil {
+			return nil, err
+		}
+		chord.Octave = octave
+		s = before
+	}
+
+	if before, after, ok := strings.Cut(s, "/"); ok {
+		bass, shift, rest, err := parseLetter(after)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chord '%s': %w", symbol, err)
+		}
+		if rest != "" {
+			return nil, fmt.Errorf("invalid chord '%s': invalid bass note '%s'", symbol, after)
+		}
+		chord.Bass = bass
+		chord.BassShift = shift
+		s = before
+	}
+
+	root, shift, quality, err := parseLetter(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chord '%s': %w", symbol, err)
+	}
+
+	intervals, ok := chordQualities[quality]
+	if !ok {
+		return nil, fmt.Errorf("invalid chord '%s': unknown quality '%s'", symbol, quality)
+	}
+
+	chord.Root = root
+	chord.RootShift = shift
+	chord.Intervals = intervals
+
+	return &Note{
+		Pos:   pos,
+		Props: props,
+		Name:  string(root),
+		Chord: chord,
+	}, nil
+}
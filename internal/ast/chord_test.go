@@ -0,0 +1,72 @@
+package ast_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mgnsk/balafon/internal/ast"
+	. "github.com/onsi/gomega"
+)
+
+func TestChord(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected ast.Chord
+	}{
+		{
+			"{C}",
+			ast.Chord{Symbol: "C", Root: 'C', Octave: -1, Intervals: []int{0, 4, 7}},
+		},
+		{
+			"{Cmaj7}2",
+			ast.Chord{Symbol: "Cmaj7", Root: 'C', Octave: -1, Intervals: []int{0, 4, 7, 11}},
+		},
+		{
+			"{Bbm7b5}",
+			ast.Chord{Symbol: "Bbm7b5", Root: 'B', RootShift: -1, Octave: -1, Intervals: []int{0, 3, 6, 10}},
+		},
+		{
+			"{Dm/F}",
+			ast.Chord{Symbol: "Dm/F", Root: 'D', Octave: -1, Intervals: []int{0, 3, 7}, Bass: 'F'},
+		},
+		{
+			"{F#7/C#:3}8.",
+			ast.Chord{Symbol: "F#7/C#:3", Root: 'F', RootShift: 1, Octave: 3, Intervals: []int{0, 4, 7, 10}, Bass: 'C', BassShift: 1},
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			res, err := parse(tc.input)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			note := res[0].(ast.NodeList)[0].(*ast.Note)
+			g.Expect(note.Chord).To(Equal(&tc.expected))
+
+			var buf bytes.Buffer
+			_, err = res.WriteTo(&buf)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(buf.String()).To(Equal(tc.input))
+		})
+	}
+}
+
+func TestInvalidChord(t *testing.T) {
+	for _, input := range []string{
+		"{c}",
+		"{H}",
+		"{Cxyz}",
+		"{C/}",
+		"{C/Fm}",
+		"{C:10}",
+		"{C:x}",
+		"{C}#",
+	} {
+		t.Run(input, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			_, err := parse(input)
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
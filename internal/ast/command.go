@@ -1,17 +1,21 @@
 package ast
 
 import (
+	"fmt"
 	"io"
 	"math"
+	"strconv"
+	"strings"
 
 	"github.com/mgnsk/balafon/internal/constants"
+	"github.com/mgnsk/balafon/internal/gm"
 	"github.com/mgnsk/balafon/internal/parser/token"
 )
 
 // CmdAssign is a note assignment command.
 type CmdAssign struct {
 	Pos  token.Pos
-	Note rune
+	Note string
 	Key  int
 }
 
@@ -21,7 +25,7 @@ func (c CmdAssign) WriteTo(w io.Writer) (int64, error) {
 	var n int
 
 	n += ew.WriteString(":assign ")
-	n += ew.WriteRune(c.Note)
+	n += ew.WriteString(c.Note)
 	n += ew.WriteString(" ")
 	n += ew.WriteInt(int(c.Key))
 
@@ -29,7 +33,7 @@ func (c CmdAssign) WriteTo(w io.Writer) (int64, error) {
 }
 
 // NewCmdAssign creates a note assignment command.
-func NewCmdAssign(pos token.Pos, note rune, key int64) (CmdAssign, error) {
+func NewCmdAssign(pos token.Pos, note string, key int64) (CmdAssign, error) {
 	if err := validateRange(key, 0, constants.MaxValue); err != nil {
 		return CmdAssign{}, err
 	}
@@ -40,6 +44,35 @@ func NewCmdAssign(pos token.Pos, note rune, key int64) (CmdAssign, error) {
 	}, nil
 }
 
+// CmdKit is a drum kit assignment command.
+type CmdKit struct {
+	Pos  token.Pos
+	Name string
+}
+
+// WriteTo writes the command to w.
+func (c CmdKit) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":kit ")
+	n += ew.WriteString(c.Name)
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdKit creates a drum kit assignment command.
+func NewCmdKit(pos token.Pos, name string) (CmdKit, error) {
+	if _, ok := gm.Kits[name]; !ok {
+		return CmdKit{}, fmt.Errorf("unknown kit '%s'", name)
+	}
+
+	return CmdKit{
+		Pos:  pos,
+		Name: name,
+	}, nil
+}
+
 // CmdTempo is a tempo command.
 type CmdTempo struct {
 	BPM uint16
@@ -72,6 +105,51 @@ func NewCmdTempo(bpm int64) (CmdTempo, error) {
 	}, nil
 }
 
+// CmdTempoRamp is a gradual tempo change command.
+type CmdTempoRamp struct {
+	From uint16
+	To   uint16
+	Bars uint8
+}
+
+// WriteTo writes the command to w.
+func (c CmdTempoRamp) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":tempo ")
+	n += ew.WriteInt(int(c.From))
+	n += ew.WriteString(" -> ")
+	n += ew.WriteInt(int(c.To))
+	if c.Bars > 1 {
+		n += ew.WriteString(" ")
+		n += ew.WriteInt(int(c.Bars))
+	}
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdTempoRamp creates a gradual tempo change command over a number of bars.
+func NewCmdTempoRamp(from, to, bars int64) (CmdTempoRamp, error) {
+	if err := validateRange(from, 1, math.MaxUint16); err != nil {
+		return CmdTempoRamp{}, err
+	}
+
+	if err := validateRange(to, 1, math.MaxUint16); err != nil {
+		return CmdTempoRamp{}, err
+	}
+
+	if err := validateRange(bars, 1, math.MaxUint8); err != nil {
+		return CmdTempoRamp{}, err
+	}
+
+	return CmdTempoRamp{
+		From: uint16(from),
+		To:   uint16(to),
+		Bars: uint8(bars),
+	}, nil
+}
+
 // CmdTime is a time signature change command.
 type CmdTime struct {
 	Num   uint8
@@ -107,6 +185,35 @@ func NewCmdTime(num, denom int64) (CmdTime, error) {
 	}, nil
 }
 
+// CmdMeter is a time signature change command for the current channel.
+type CmdMeter struct {
+	Num   uint8
+	Denom uint8
+}
+
+// WriteTo writes the command to w.
+func (c CmdMeter) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":meter ")
+	n += ew.WriteInt(int(c.Num))
+	n += ew.WriteString(" ")
+	n += ew.WriteInt(int(c.Denom))
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdMeter creates a time signature change command for the current channel.
+func NewCmdMeter(num, denom int64) (CmdMeter, error) {
+	cmd, err := NewCmdTime(num, denom)
+	if err != nil {
+		return CmdMeter{}, err
+	}
+
+	return CmdMeter(cmd), nil
+}
+
 // CmdChannel is a channel change command.
 type CmdChannel struct {
 	Channel uint8
@@ -188,9 +295,46 @@ func NewCmdVelocity(value int64) (CmdVelocity, error) {
 	}, nil
 }
 
+// CmdOctave is an octave shift command.
+type CmdOctave struct {
+	Octave int
+}
+
+// WriteTo writes the command to w.
+func (c CmdOctave) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":octave ")
+	if c.Octave > 0 {
+		n += ew.WriteString("+")
+	}
+	n += ew.WriteInt(c.Octave)
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdOctave creates an octave shift command from a signed value.
+func NewCmdOctave(value string) (CmdOctave, error) {
+	v, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return CmdOctave{}, err
+	}
+
+	if err := validateRange(v, -constants.MaxOctaveShift, constants.MaxOctaveShift); err != nil {
+		return CmdOctave{}, err
+	}
+
+	return CmdOctave{
+		Octave: v,
+	}, nil
+}
+
 // CmdProgram is a program change command.
 type CmdProgram struct {
 	Program uint8
+	Bank    []uint8 // the bank select MSB and optional LSB
+	Name    string  // the General MIDI instrument name if the program was selected by name
 }
 
 // WriteTo writes the command to w.
@@ -199,19 +343,64 @@ func (c CmdProgram) WriteTo(w io.Writer) (int64, error) {
 	var n int
 
 	n += ew.WriteString(":program ")
+
+	if c.Name != "" {
+		n += ew.WriteString(`"`)
+		n += ew.WriteString(c.Name)
+		n += ew.WriteString(`"`)
+
+		return int64(n), ew.Flush()
+	}
+
 	n += ew.WriteInt(int(c.Program))
 
+	for i, v := range c.Bank {
+		if i == 0 {
+			n += ew.WriteString(" bank=")
+		} else {
+			n += ew.WriteString(":")
+		}
+		n += ew.WriteInt(int(v))
+	}
+
 	return int64(n), ew.Flush()
 }
 
-// NewCmdProgram creates a program change command.
-func NewCmdProgram(value int64) (CmdProgram, error) {
-	if err := validateRange(value, 0, constants.MaxValue); err != nil {
+// NewCmdProgram creates a program change command from the program number and an optional bank in the form bank=MSB[:LSB].
+func NewCmdProgram(args string) (CmdProgram, error) {
+	fields := strings.Fields(args)
+
+	values, err := parseUints(fields[:1])
+	if err != nil {
 		return CmdProgram{}, err
 	}
 
+	cmd := CmdProgram{
+		Program: values[0],
+	}
+
+	if len(fields) > 1 {
+		bank := strings.Split(strings.TrimPrefix(fields[1], "bank="), ":")
+		if cmd.Bank, err = parseUints(bank); err != nil {
+			return CmdProgram{}, err
+		}
+	}
+
+	return cmd, nil
+}
+
+// NewCmdProgramName creates a program change command from a quoted General MIDI instrument name.
+func NewCmdProgramName(quotedName string) (CmdProgram, error) {
+	name := strings.Trim(quotedName, `"`)
+
+	program, ok := gm.Program(name)
+	if !ok {
+		return CmdProgram{}, fmt.Errorf("unknown instrument '%s'", name)
+	}
+
 	return CmdProgram{
-		Program: uint8(value),
+		Program: program,
+		Name:    gm.Instruments[program],
 	}, nil
 }
 
@@ -234,19 +423,211 @@ func (c CmdControl) WriteTo(w io.Writer) (int64, error) {
 	return int64(n), ew.Flush()
 }
 
-// NewCmdControl creates a control change command.
-func NewCmdControl(control, value int64) (CmdControl, error) {
-	if err := validateRange(control, 0, constants.MaxValue); err != nil {
+// NewCmdControl creates a control change command from the control number and value.
+func NewCmdControl(args string) (CmdControl, error) {
+	values, err := parseUints(strings.Fields(args))
+	if err != nil {
 		return CmdControl{}, err
 	}
 
+	return CmdControl{
+		Control:   values[0],
+		Parameter: values[1],
+	}, nil
+}
+
+// Automation curves.
+const (
+	CurveLinear = "linear"
+	CurveExp    = "exp"
+	CurveLog    = "log"
+)
+
+// CmdControlRamp is a gradual control change command.
+type CmdControlRamp struct {
+	Control uint8
+	From    uint8
+	To      uint8
+	Curve   string
+}
+
+// WriteTo writes the command to w.
+func (c CmdControlRamp) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":control ")
+	n += ew.WriteInt(int(c.Control))
+	n += ew.WriteString(" ")
+	n += ew.WriteInt(int(c.From))
+	n += ew.WriteString(" -> ")
+	n += ew.WriteInt(int(c.To))
+	n += ew.WriteString(" ")
+	n += ew.WriteString(c.Curve)
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdControlRamp creates a gradual control change command
+// from the control number, the start and target values and an optional curve.
+func NewCmdControlRamp(args string) (CmdControlRamp, error) {
+	before, after, _ := strings.Cut(args, "->")
+	fields := append(strings.Fields(before), strings.Fields(after)...)
+
+	cmd := CmdControlRamp{
+		Curve: CurveLinear,
+	}
+
+	if len(fields) > 3 {
+		cmd.Curve = fields[3]
+		fields = fields[:3]
+	}
+
+	values, err := parseUints(fields)
+	if err != nil {
+		return CmdControlRamp{}, err
+	}
+
+	cmd.Control = values[0]
+	cmd.From = values[1]
+	cmd.To = values[2]
+
+	return cmd, nil
+}
+
+// parseUints parses MIDI data values.
+func parseUints(fields []string) ([]uint8, error) {
+	values := make([]uint8, len(fields))
+
+	for i, f := range fields {
+		v, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validateRange(v, 0, constants.MaxValue); err != nil {
+			return nil, err
+		}
+
+		values[i] = uint8(v)
+	}
+
+	return values, nil
+}
+
+// CmdBend is a pitch bend command.
+type CmdBend struct {
+	Value int16
+}
+
+// WriteTo writes the command to w.
+func (c CmdBend) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":bend ")
+	n += ew.WriteInt(int(c.Value))
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdBend creates a pitch bend command from a signed value.
+func NewCmdBend(value string) (CmdBend, error) {
+	v, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return CmdBend{}, err
+	}
+
+	if err := validateRange(v, constants.MinPitchBend, constants.MaxPitchBend); err != nil {
+		return CmdBend{}, err
+	}
+
+	return CmdBend{
+		Value: int16(v),
+	}, nil
+}
+
+// CmdPressure is a channel pressure command.
+type CmdPressure struct {
+	Pressure uint8
+}
+
+// WriteTo writes the command to w.
+func (c CmdPressure) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":pressure ")
+	n += ew.WriteInt(int(c.Pressure))
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdPressure creates a channel pressure command.
+func NewCmdPressure(value int64) (CmdPressure, error) {
 	if err := validateRange(value, 0, constants.MaxValue); err != nil {
-		return CmdControl{}, err
+		return CmdPressure{}, err
 	}
 
-	return CmdControl{
-		Control:   uint8(control),
-		Parameter: uint8(value),
+	return CmdPressure{
+		Pressure: uint8(value),
+	}, nil
+}
+
+// Parameter number types.
+const (
+	RPN  = "rpn"
+	NRPN = "nrpn"
+)
+
+// CmdRPN is a registered or non-registered parameter number command.
+type CmdRPN struct {
+	Type     string // RPN or NRPN
+	ParamMSB uint8
+	ParamLSB uint8
+	ValueMSB uint8
+	ValueLSB int // the data entry LSB or -1 if not set
+}
+
+// WriteTo writes the command to w.
+func (c CmdRPN) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":")
+	n += ew.WriteString(c.Type)
+	n += ew.WriteString(" ")
+	n += ew.WriteInt(int(c.ParamMSB))
+	n += ew.WriteString(" ")
+	n += ew.WriteInt(int(c.ParamLSB))
+	n += ew.WriteString(" ")
+	n += ew.WriteInt(int(c.ValueMSB))
+
+	if c.ValueLSB >= 0 {
+		n += ew.WriteString(" ")
+		n += ew.WriteInt(c.ValueLSB)
+	}
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdRPN creates a parameter number command. A negative valueLSB means no data entry LSB.
+func NewCmdRPN(typ string, paramMSB, paramLSB, valueMSB, valueLSB int64) (CmdRPN, error) {
+	for _, v := range []int64{paramMSB, paramLSB, valueMSB, valueLSB} {
+		if v < 0 {
+			continue
+		}
+		if err := validateRange(v, 0, constants.MaxValue); err != nil {
+			return CmdRPN{}, err
+		}
+	}
+
+	return CmdRPN{
+		Type:     typ,
+		ParamMSB: uint8(paramMSB),
+		ParamLSB: uint8(paramLSB),
+		ValueMSB: uint8(valueMSB),
+		ValueLSB: int(valueLSB),
 	}, nil
 }
 
@@ -254,13 +635,55 @@ func NewCmdControl(control, value int64) (CmdControl, error) {
 type CmdPlay struct {
 	Pos     token.Pos
 	BarName string
+	Options OptionList
 }
 
 // NewCmdPlay creates a play command.
-func NewCmdPlay(pos token.Pos, barName string) (CmdPlay, error) {
+func NewCmdPlay(pos token.Pos, args string) (CmdPlay, error) {
+	fields := strings.Fields(args)
+
+	options, err := NewOptionList(fields[1:])
+	if err != nil {
+		return CmdPlay{}, err
+	}
+
+	for _, o := range options {
+		var err error
+
+		switch o.Name {
+		case "transpose":
+			err = validateRange(o.Value, -constants.MaxValue, constants.MaxValue)
+		case "velocity":
+			if o.Signed {
+				err = validateRange(o.Value, -constants.MaxValue, constants.MaxValue)
+			} else {
+				err = validateRange(o.Value, 1, constants.MaxValue)
+			}
+		case "channel":
+			if o.Signed {
+				err = fmt.Errorf("option 'channel' must not be signed")
+			} else {
+				err = validateRange(o.Value, constants.MinTrack, constants.MaxTrack)
+			}
+		case "every":
+			if o.Signed {
+				err = fmt.Errorf("option 'every' must not be signed")
+			} else {
+				err = validateRange(o.Value, 1, math.MaxUint8)
+			}
+		default:
+			err = fmt.Errorf("unknown option '%s'", o.Name)
+		}
+
+		if err != nil {
+			return CmdPlay{}, err
+		}
+	}
+
 	return CmdPlay{
 		Pos:     pos,
-		BarName: barName,
+		BarName: fields[0],
+		Options: options,
 	}, nil
 }
 
@@ -271,6 +694,67 @@ func (c CmdPlay) WriteTo(w io.Writer) (int64, error) {
 
 	n += ew.WriteString(":play ")
 	n += ew.WriteString(c.BarName)
+	n += ew.WriteFrom(c.Options)
+
+	return int64(n), ew.Flush()
+}
+
+// CmdInclude is a file include command.
+type CmdInclude struct {
+	Pos  token.Pos
+	Path string
+}
+
+// NewCmdInclude creates an include command from a quoted path.
+func NewCmdInclude(pos token.Pos, quotedPath string) (CmdInclude, error) {
+	path := strings.Trim(quotedPath, `"`)
+	if path == "" {
+		return CmdInclude{}, fmt.Errorf("empty include path")
+	}
+
+	return CmdInclude{
+		Pos:  pos,
+		Path: path,
+	}, nil
+}
+
+// WriteTo writes the command to w.
+func (c CmdInclude) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(`:include "`)
+	n += ew.WriteString(c.Path)
+	n += ew.WriteString(`"`)
+
+	return int64(n), ew.Flush()
+}
+
+// CmdVolta begins an alternate ending of a repeat block.
+type CmdVolta struct {
+	Pos    token.Pos
+	Number uint8
+}
+
+// NewCmdVolta creates a volta command.
+func NewCmdVolta(pos token.Pos, number int64) (CmdVolta, error) {
+	if err := validateRange(number, 1, math.MaxUint8); err != nil {
+		return CmdVolta{}, err
+	}
+
+	return CmdVolta{
+		Pos:    pos,
+		Number: uint8(number),
+	}, nil
+}
+
+// WriteTo writes the command to w.
+func (c CmdVolta) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":volta ")
+	n += ew.WriteInt(int(c.Number))
 
 	return int64(n), ew.Flush()
 }
@@ -300,3 +784,49 @@ func (c CmdStop) WriteTo(w io.Writer) (int64, error) {
 
 	return int64(n), ew.Flush()
 }
+
+// CmdPickup is a command that marks a bar as a pickup bar.
+// The length of a pickup bar is the length of its notes.
+type CmdPickup struct {
+	Pos token.Pos
+}
+
+// WriteTo writes the command to w.
+func (c CmdPickup) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":pickup")
+
+	return int64(n), ew.Flush()
+}
+
+// CmdStaff is a command that turns staff mode on or off.
+// In staff mode, note lists are split into bars by the time signature.
+type CmdStaff struct {
+	Pos token.Pos
+	On  bool
+}
+
+// WriteTo writes the command to w.
+func (c CmdStaff) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":staff ")
+	if c.On {
+		n += ew.WriteString("on")
+	} else {
+		n += ew.WriteString("off")
+	}
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdStaff creates a staff mode command.
+func NewCmdStaff(pos token.Pos, arg string) (CmdStaff, error) {
+	return CmdStaff{
+		Pos: pos,
+		On:  strings.TrimSpace(arg) == "on",
+	}, nil
+}
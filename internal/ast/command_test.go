@@ -16,16 +16,32 @@ func TestValidCommands(t *testing.T) {
 	}{
 		{
 			`:assign k 36`,
-			Equal(ast.CmdAssign{Note: 'k', Key: 36}),
+			Equal(ast.CmdAssign{Note: "k", Key: 36}),
+		},
+		{
+			`:assign @hh_open 46`,
+			Equal(ast.CmdAssign{Note: "@hh_open", Key: 46}),
 		},
 		{
 			`:tempo 120`,
 			Equal(ast.CmdTempo{BPM: 120}),
 		},
+		{
+			`:tempo 120 -> 80`,
+			Equal(ast.CmdTempoRamp{From: 120, To: 80, Bars: 1}),
+		},
+		{
+			`:tempo 60 -> 90 4`,
+			Equal(ast.CmdTempoRamp{From: 60, To: 90, Bars: 4}),
+		},
 		{
 			`:time 1 1`,
 			Equal(ast.CmdTime{Num: 1, Denom: 1}),
 		},
+		{
+			`:meter 7 8`,
+			Equal(ast.CmdMeter{Num: 7, Denom: 8}),
+		},
 		{
 			`:channel 16`,
 			Equal(ast.CmdChannel{Channel: 15}),
@@ -42,14 +58,118 @@ func TestValidCommands(t *testing.T) {
 			`:program 127`,
 			Equal(ast.CmdProgram{Program: 127}),
 		},
+		{
+			`:program 0 bank=1:5`,
+			Equal(ast.CmdProgram{Program: 0, Bank: []uint8{1, 5}}),
+		},
+		{
+			`:program 127 bank=127`,
+			Equal(ast.CmdProgram{Program: 127, Bank: []uint8{127}}),
+		},
+		{
+			`:program "Electric Bass (finger)"`,
+			Equal(ast.CmdProgram{Program: 33, Name: "Electric Bass (finger)"}),
+		},
 		{
 			`:control 127 127`,
 			Equal(ast.CmdControl{Control: 127, Parameter: 127}),
 		},
+		{
+			`:control 1 0 -> 127 exp`,
+			Equal(ast.CmdControlRamp{Control: 1, From: 0, To: 127, Curve: ast.CurveExp}),
+		},
+		{
+			`:control 11 127 -> 0 log`,
+			Equal(ast.CmdControlRamp{Control: 11, From: 127, To: 0, Curve: ast.CurveLog}),
+		},
+		{
+			`:control 1 0 -> 127 linear`,
+			Equal(ast.CmdControlRamp{Control: 1, From: 0, To: 127, Curve: ast.CurveLinear}),
+		},
+		{
+			`:octave +1`,
+			Equal(ast.CmdOctave{Octave: 1}),
+		},
+		{
+			`:octave -2`,
+			Equal(ast.CmdOctave{Octave: -2}),
+		},
+		{
+			`:octave 0`,
+			Equal(ast.CmdOctave{Octave: 0}),
+		},
+		{
+			`:bend -8192`,
+			Equal(ast.CmdBend{Value: -8192}),
+		},
+		{
+			`:bend 8191`,
+			Equal(ast.CmdBend{Value: 8191}),
+		},
+		{
+			`:pressure 127`,
+			Equal(ast.CmdPressure{Pressure: 127}),
+		},
+		{
+			`:sysex F0 7E 7F 09 01 F7`,
+			Equal(ast.CmdSysex{Data: []byte{0xF0, 0x7E, 0x7F, 0x09, 0x01, 0xF7}}),
+		},
+		{
+			`:sysex "patch.syx"`,
+			Equal(ast.CmdSysexFile{Path: "patch.syx"}),
+		},
+		{
+			`:rpn 0 0 2`,
+			Equal(ast.CmdRPN{Type: ast.RPN, ParamMSB: 0, ParamLSB: 0, ValueMSB: 2, ValueLSB: -1}),
+		},
+		{
+			`:nrpn 1 8 64 127`,
+			Equal(ast.CmdRPN{Type: ast.NRPN, ParamMSB: 1, ParamLSB: 8, ValueMSB: 64, ValueLSB: 127}),
+		},
+		{
+			`:kit gm`,
+			Equal(ast.CmdKit{Name: "gm"}),
+		},
+		{
+			`:swing 60%`,
+			Equal(ast.CmdSwing{Percent: 60, Value: 8}),
+		},
+		{
+			`:swing 66% 16`,
+			Equal(ast.CmdSwing{Percent: 66, Value: 16}),
+		},
+		{
+			`:groove shuffle`,
+			Equal(ast.CmdGroove{Name: "shuffle"}),
+		},
+		{
+			`:humanize timing=10 velocity=8 seed=42 lock=4`,
+			Equal(ast.CmdHumanize{Timing: 10, Velocity: 8, Seed: 42, Lock: 4}),
+		},
 		{
 			`:play chorus`,
 			Equal(ast.CmdPlay{BarName: "chorus"}),
 		},
+		{
+			`:play chorus transpose=+5 velocity=-10 channel=3`,
+			Equal(ast.CmdPlay{BarName: "chorus", Options: ast.OptionList{
+				{Name: "transpose", Value: 5, Signed: true},
+				{Name: "velocity", Value: -10, Signed: true},
+				{Name: "channel", Value: 3},
+			}}),
+		},
+		{
+			`:play fill every=4`,
+			Equal(ast.CmdPlay{BarName: "fill", Options: ast.OptionList{{Name: "every", Value: 4}}}),
+		},
+		{
+			`:include "kits/gm drums.bal"`,
+			Equal(ast.CmdInclude{Path: "kits/gm drums.bal"}),
+		},
+		{
+			`:volta 1`,
+			Equal(ast.CmdVolta{Number: 1}),
+		},
 		{
 			`:start`,
 			Equal(ast.CmdStart{}),
@@ -58,6 +178,18 @@ func TestValidCommands(t *testing.T) {
 			`:stop`,
 			Equal(ast.CmdStop{}),
 		},
+		{
+			`:pickup`,
+			Equal(ast.CmdPickup{}),
+		},
+		{
+			`:staff on`,
+			Equal(ast.CmdStaff{On: true}),
+		},
+		{
+			`:staff off`,
+			Equal(ast.CmdStaff{On: false}),
+		},
 	} {
 		t.Run(tc.input, func(t *testing.T) {
 			g := NewGomegaWithT(t)
@@ -77,16 +209,47 @@ func TestInvalidArgumentRange(t *testing.T) {
 		`:assign k 128`,
 		`:tempo 0`,
 		`:tempo 65536`,
+		`:tempo 120 -> 0`,
+		`:tempo 0 -> 120`,
+		`:tempo 120 -> 80 0`,
+		`:tempo 120 -> 80 256`,
 		`:time 0 1`,
 		`:time 1 0`,
 		`:time 1 129`,
 		`:time 129 1`,
+		`:meter 0 8`,
 		`:channel 17`,
 		`:voice 5`,
 		`:velocity 128`,
 		`:program 128`,
+		`:program 0 bank=128`,
+		`:program 0 bank=0:128`,
 		`:control 0 128`,
 		`:control 128 0`,
+		`:control 1 0 -> 128`,
+		`:control 128 0 -> 127 linear`,
+		`:volta 0`,
+		`:octave +11`,
+		`:swing 49%`,
+		`:swing 76%`,
+		`:humanize timing=961`,
+		`:humanize velocity=128`,
+		`:octave -11`,
+		`:bend -8193`,
+		`:bend 8192`,
+		`:pressure 128`,
+		`:rpn 128 0 0`,
+		`:nrpn 0 128 0`,
+		`:nrpn 0 0 128`,
+		`:nrpn 0 0 0 128`,
+		`:play a transpose=128`,
+		`:play a transpose=-128`,
+		`:play a velocity=0`,
+		`:play a velocity=+128`,
+		`:play a channel=0`,
+		`:play a channel=17`,
+		`:play a every=0`,
+		`:repeat 1 c :end`,
 	} {
 		t.Run(input, func(t *testing.T) {
 			g := NewGomegaWithT(t)
@@ -98,6 +261,71 @@ func TestInvalidArgumentRange(t *testing.T) {
 	}
 }
 
+func TestInvalidPlayOption(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{`:play a octave=1`, "unknown option 'octave'"},
+		{`:play a transpose=1 transpose=2`, "duplicate option 'transpose'"},
+		{`:play a channel=+3`, "option 'channel' must not be signed"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			_, err := parse(tc.input)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tc.err))
+		})
+	}
+}
+
+func TestInvalidSysex(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{`:sysex 7E 7F F7`, "sysex message must begin with F0, got: 7E"},
+		{`:sysex F0 7E 7F`, "sysex message must end with F7"},
+		{`:sysex F0 80 F7`, "invalid sysex data byte 80"},
+		{`:sysex F0 F7 7E`, "sysex message must begin with F0, got: 7E"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			_, err := parse(tc.input)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tc.err))
+		})
+	}
+}
+
+func TestProgramName(t *testing.T) {
+	t.Run("case insensitive", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		res, err := parse(`:program "acoustic grand piano"`)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res).To(ConsistOf(ast.CmdProgram{Program: 0, Name: "Acoustic Grand Piano"}))
+	})
+
+	t.Run("unknown instrument", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		_, err := parse(`:program "Kazoo"`)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("unknown instrument 'Kazoo'"))
+	})
+}
+
+func TestUnknownKit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := parse(`:kit tr808`)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("unknown kit 'tr808'"))
+}
+
 func TestInvalidTimeSig(t *testing.T) {
 	for _, input := range []string{
 		`:time 4 5`,
@@ -2,6 +2,8 @@ package ast
 
 import (
 	"io"
+	"strings"
+	"unicode"
 )
 
 // BlockComment is a block comment.
@@ -27,3 +29,26 @@ func NewBlockComment(text string) BlockComment {
 		Text: text[2 : len(text)-2],
 	}
 }
+
+// LineComment is a comment until the end of line.
+type LineComment struct {
+	Text string
+}
+
+// WriteTo writes the comment to w.
+func (c LineComment) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString("//")
+	n += ew.WriteString(c.Text)
+
+	return int64(n), ew.Flush()
+}
+
+// NewLineComment creates a new line comment.
+func NewLineComment(text string) LineComment {
+	return LineComment{
+		Text: strings.TrimRightFunc(text[2:], unicode.IsSpace),
+	}
+}
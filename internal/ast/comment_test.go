@@ -1,10 +1,12 @@
 package ast_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/mgnsk/balafon/internal/ast"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 )
 
 func TestBlockComment(t *testing.T) {
@@ -31,3 +33,62 @@ block comment
 		))
 	}
 }
+
+func TestLineComment(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		match types.GomegaMatcher
+	}{
+		{
+			"// this is a line comment\n:assign c 60",
+			HaveExactElements(
+				Equal(ast.LineComment{Text: " this is a line comment"}),
+				BeAssignableToTypeOf(ast.CmdAssign{}),
+			),
+		},
+		{
+			":assign c 60 // trailing comment",
+			HaveExactElements(
+				BeAssignableToTypeOf(ast.CmdAssign{}),
+				Equal(ast.LineComment{Text: " trailing comment"}),
+			),
+		},
+		{
+			"c d // trailing comment\ne",
+			HaveExactElements(
+				BeAssignableToTypeOf(ast.NodeList{}),
+				Equal(ast.LineComment{Text: " trailing comment"}),
+				BeAssignableToTypeOf(ast.NodeList{}),
+			),
+		},
+		{
+			":bar one // comment\nc\n:end",
+			HaveExactElements(
+				HaveField("DeclList", HaveExactElements(
+					Equal(ast.LineComment{Text: " comment"}),
+					BeAssignableToTypeOf(ast.NodeList{}),
+				)),
+			),
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			res, err := parse(tc.input)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(res).To(tc.match)
+		})
+	}
+}
+
+func TestLineCommentWriteTo(t *testing.T) {
+	g := NewWithT(t)
+
+	res, err := parse(":tempo 120 // fast   ")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var buf bytes.Buffer
+	_, err = res.WriteTo(&buf)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(buf.String()).To(Equal(":tempo 120 // fast"))
+}
@@ -0,0 +1,147 @@
+package ast
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/mgnsk/balafon/internal/constants"
+)
+
+// Dynamics is the list of dynamic marks from softest to loudest.
+var Dynamics = []string{"ppp", "pp", "p", "mp", "mf", "f", "ff", "fff"}
+
+// Hairpin types.
+const (
+	Crescendo  = "cresc"
+	Diminuendo = "dim"
+)
+
+// CmdDyn is a dynamic mark command.
+type CmdDyn struct {
+	Dynamic string
+}
+
+// WriteTo writes the command to w.
+func (c CmdDyn) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":dyn ")
+	n += ew.WriteString(c.Dynamic)
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdDyn creates a dynamic mark command.
+func NewCmdDyn(dynamic string) (CmdDyn, error) {
+	return CmdDyn{
+		Dynamic: strings.TrimSpace(dynamic),
+	}, nil
+}
+
+// CmdDynamics is a command that sets the velocities of dynamic marks.
+type CmdDynamics struct {
+	Options OptionList
+}
+
+// WriteTo writes the command to w.
+func (c CmdDynamics) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":dynamics")
+	n += ew.WriteFrom(c.Options)
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdDynamics creates a dynamics velocity table command.
+func NewCmdDynamics(args string) (CmdDynamics, error) {
+	options, err := NewOptionList(strings.Fields(args))
+	if err != nil {
+		return CmdDynamics{}, err
+	}
+
+	for _, o := range options {
+		if !slices.Contains(Dynamics, o.Name) {
+			return CmdDynamics{}, fmt.Errorf("unknown dynamic '%s'", o.Name)
+		}
+		if o.Signed {
+			return CmdDynamics{}, fmt.Errorf("dynamic '%s' velocity must not be signed", o.Name)
+		}
+		if err := validateRange(o.Value, 1, constants.MaxValue); err != nil {
+			return CmdDynamics{}, err
+		}
+	}
+
+	return CmdDynamics{
+		Options: options,
+	}, nil
+}
+
+// CmdHairpin is a gradual dynamics change command.
+type CmdHairpin struct {
+	Type    string // Crescendo or Diminuendo
+	Dynamic string // the target dynamic
+	Options OptionList
+	Bars    uint8
+	Control int // the controller number or -1 to change note velocities
+}
+
+// WriteTo writes the command to w.
+func (c CmdHairpin) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":")
+	n += ew.WriteString(c.Type)
+	n += ew.WriteString(" ")
+	n += ew.WriteString(c.Dynamic)
+	n += ew.WriteFrom(c.Options)
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdHairpin creates a hairpin command.
+func NewCmdHairpin(typ, args string) (CmdHairpin, error) {
+	fields := strings.Fields(args)
+
+	options, err := NewOptionList(fields[1:])
+	if err != nil {
+		return CmdHairpin{}, err
+	}
+
+	cmd := CmdHairpin{
+		Type:    typ,
+		Dynamic: fields[0],
+		Options: options,
+		Bars:    1,
+		Control: -1,
+	}
+
+	for _, o := range options {
+		if o.Signed {
+			return CmdHairpin{}, fmt.Errorf("option '%s' must not be signed", o.Name)
+		}
+
+		switch o.Name {
+		case "bars":
+			if err := validateRange(o.Value, 1, math.MaxUint8); err != nil {
+				return CmdHairpin{}, err
+			}
+			cmd.Bars = uint8(o.Value)
+		case "cc":
+			if err := validateRange(o.Value, 0, constants.MaxValue); err != nil {
+				return CmdHairpin{}, err
+			}
+			cmd.Control = o.Value
+		default:
+			return CmdHairpin{}, fmt.Errorf("unknown option '%s'", o.Name)
+		}
+	}
+
+	return cmd, nil
+}
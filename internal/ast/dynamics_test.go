@@ -0,0 +1,94 @@
+package ast_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mgnsk/balafon/internal/ast"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+func TestDynamicsCommands(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		match types.GomegaMatcher
+	}{
+		{
+			":dyn ppp",
+			Equal(ast.CmdDyn{Dynamic: "ppp"}),
+		},
+		{
+			":dyn mf",
+			Equal(ast.CmdDyn{Dynamic: "mf"}),
+		},
+		{
+			":dynamics pp=30 ff=110",
+			Equal(ast.CmdDynamics{Options: ast.OptionList{
+				{Name: "pp", Value: 30},
+				{Name: "ff", Value: 110},
+			}}),
+		},
+		{
+			":cresc ff",
+			Equal(ast.CmdHairpin{Type: ast.Crescendo, Dynamic: "ff", Bars: 1, Control: -1}),
+		},
+		{
+			":dim p bars=2 cc=11",
+			Equal(ast.CmdHairpin{
+				Type:    ast.Diminuendo,
+				Dynamic: "p",
+				Options: ast.OptionList{
+					{Name: "bars", Value: 2},
+					{Name: "cc", Value: 11},
+				},
+				Bars:    2,
+				Control: 11,
+			}),
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			res, err := parse(tc.input)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(res[0]).To(tc.match)
+
+			var buf bytes.Buffer
+			_, err = res.WriteTo(&buf)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(buf.String()).To(Equal(tc.input))
+		})
+	}
+}
+
+func TestInvalidDynamicsCommands(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{":dynamics sfz=100", "unknown dynamic 'sfz'"},
+		{":dynamics pp=+10", "dynamic 'pp' velocity must not be signed"},
+		{":dynamics pp=0", "range"},
+		{":dynamics pp=128", "range"},
+		{":cresc ff bars=0", "range"},
+		{":cresc ff cc=128", "range"},
+		{":cresc ff bars=+2", "option 'bars' must not be signed"},
+		{":dim p beats=2", "unknown option 'beats'"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			_, err := parse(tc.input)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tc.err))
+		})
+	}
+}
+
+func TestDynamicsNotAmbiguous(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := parse(":assign d 62\n:dyn f\nddd\n:dim p\n")
+	g.Expect(err).NotTo(HaveOccurred())
+}
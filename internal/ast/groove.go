@@ -0,0 +1,152 @@
+package ast
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mgnsk/balafon/internal/constants"
+	"github.com/mgnsk/balafon/internal/parser/token"
+)
+
+// CmdSwing is a swing command.
+type CmdSwing struct {
+	Percent uint8 // the position of the off-beat note in percent of the note pair
+	Value   uint8 // the swung note value
+}
+
+// WriteTo writes the command to w.
+func (c CmdSwing) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":swing ")
+	n += ew.WriteInt(int(c.Percent))
+	n += ew.WriteString("%")
+	if c.Value != 8 {
+		n += ew.WriteString(" ")
+		n += ew.WriteInt(int(c.Value))
+	}
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdSwing creates a swing command from a percentage and an optional note value.
+func NewCmdSwing(args string) (CmdSwing, error) {
+	fields := strings.Fields(args)
+
+	percent, err := strconv.Atoi(strings.TrimSuffix(fields[0], "%"))
+	if err != nil {
+		return CmdSwing{}, err
+	}
+
+	if err := validateRange(percent, 50, 75); err != nil {
+		return CmdSwing{}, err
+	}
+
+	value := 8
+	if len(fields) > 1 {
+		if value, err = strconv.Atoi(fields[1]); err != nil {
+			return CmdSwing{}, err
+		}
+		if err := validateNoteValue(value); err != nil {
+			return CmdSwing{}, err
+		}
+		if value < 2 {
+			return CmdSwing{}, fmt.Errorf("swing note value must be at least 2, got: %d", value)
+		}
+	}
+
+	return CmdSwing{
+		Percent: uint8(percent),
+		Value:   uint8(value),
+	}, nil
+}
+
+// CmdGroove is a groove template command.
+type CmdGroove struct {
+	Pos  token.Pos
+	Name string
+}
+
+// WriteTo writes the command to w.
+func (c CmdGroove) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":groove ")
+	n += ew.WriteString(c.Name)
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdGroove creates a groove template command.
+func NewCmdGroove(pos token.Pos, name string) (CmdGroove, error) {
+	return CmdGroove{
+		Pos:  pos,
+		Name: strings.TrimSpace(name),
+	}, nil
+}
+
+// CmdHumanize is a command that randomizes the timing and velocities of notes.
+type CmdHumanize struct {
+	Options  OptionList
+	Timing   int    // the maximum position offset in ticks
+	Velocity int    // the maximum velocity offset
+	Seed     uint64 // the seed of the random numbers
+	Lock     uint8  // the note value of the grid that is not humanized or 0
+}
+
+// WriteTo writes the command to w.
+func (c CmdHumanize) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":humanize")
+	n += ew.WriteFrom(c.Options)
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdHumanize creates a humanize command.
+func NewCmdHumanize(args string) (CmdHumanize, error) {
+	options, err := NewOptionList(strings.Fields(args))
+	if err != nil {
+		return CmdHumanize{}, err
+	}
+
+	cmd := CmdHumanize{
+		Options: options,
+	}
+
+	for _, o := range options {
+		if o.Signed {
+			return CmdHumanize{}, fmt.Errorf("option '%s' must not be signed", o.Name)
+		}
+
+		switch o.Name {
+		case "timing":
+			if err := validateRange(o.Value, 0, int(constants.TicksPerQuarter)); err != nil {
+				return CmdHumanize{}, err
+			}
+			cmd.Timing = o.Value
+		case "velocity":
+			if err := validateRange(o.Value, 0, constants.MaxValue); err != nil {
+				return CmdHumanize{}, err
+			}
+			cmd.Velocity = o.Value
+		case "seed":
+			cmd.Seed = uint64(o.Value)
+		case "lock":
+			if err := validateNoteValue(o.Value); err != nil {
+				return CmdHumanize{}, err
+			}
+			cmd.Lock = uint8(o.Value)
+		default:
+			return CmdHumanize{}, fmt.Errorf("unknown option '%s'", o.Name)
+		}
+	}
+
+	return cmd, nil
+}
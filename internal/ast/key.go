@@ -1,12 +1,33 @@
 package ast
 
 import (
+	"fmt"
 	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mgnsk/balafon/internal/parser/token"
 )
 
+// Modes are the built-in scales in semitones above the tonic.
+var Modes = map[string][]int{
+	"major":      {0, 2, 4, 5, 7, 9, 11},
+	"minor":      {0, 2, 3, 5, 7, 8, 10},
+	"ionian":     {0, 2, 4, 5, 7, 9, 11},
+	"dorian":     {0, 2, 3, 5, 7, 9, 10},
+	"phrygian":   {0, 1, 3, 5, 7, 8, 10},
+	"lydian":     {0, 2, 4, 6, 7, 9, 11},
+	"mixolydian": {0, 2, 4, 5, 7, 9, 10},
+	"aeolian":    {0, 2, 3, 5, 7, 8, 10},
+	"locrian":    {0, 1, 3, 5, 6, 8, 10},
+}
+
 // CmdKey is a key change command.
 type CmdKey struct {
-	Key string
+	Pos  token.Pos
+	Key  string // a standard key signature or the tonic if the mode is set
+	Mode string // a built-in or custom scale name
 }
 
 // WriteTo writes the command to w.
@@ -16,13 +37,88 @@ func (c CmdKey) WriteTo(w io.Writer) (int64, error) {
 
 	n += ew.WriteString(`:key `)
 	n += ew.WriteString(c.Key)
+	if c.Mode != "" {
+		n += ew.WriteString(" ")
+		n += ew.WriteString(c.Mode)
+	}
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdKey creates a key change command from a key signature or a tonic and a scale name.
+func NewCmdKey(pos token.Pos, args string) (CmdKey, error) {
+	fields := strings.Fields(args)
+
+	cmd := CmdKey{
+		Pos: pos,
+		Key: fields[0],
+	}
+
+	if len(fields) > 1 {
+		cmd.Mode = fields[1]
+	}
+
+	return cmd, nil
+}
+
+// CmdScale is a custom scale definition command.
+type CmdScale struct {
+	Pos       token.Pos
+	Name      string
+	Intervals []int // semitones above the tonic
+}
+
+// WriteTo writes the command to w.
+func (c CmdScale) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":scale ")
+	n += ew.WriteString(c.Name)
+	for _, v := range c.Intervals {
+		n += ew.WriteString(" ")
+		n += ew.WriteInt(v)
+	}
 
 	return int64(n), ew.Flush()
 }
 
-// NewCmdKey creates a key change command.
-func NewCmdKey(key string) (CmdKey, error) {
-	return CmdKey{
-		Key: key,
+// NewCmdScale creates a custom scale definition command from a name and ascending intervals starting from 0.
+func NewCmdScale(pos token.Pos, args string) (CmdScale, error) {
+	fields := strings.Fields(args)
+	name := fields[0]
+
+	if _, ok := Modes[name]; ok {
+		return CmdScale{}, fmt.Errorf("scale '%s' already defined", name)
+	}
+
+	if len(fields) < 2 {
+		return CmdScale{}, fmt.Errorf("scale '%s' has no intervals", name)
+	}
+
+	intervals := make([]int, 0, len(fields)-1)
+	for _, f := range fields[1:] {
+		v, err := strconv.Atoi(f)
+		if err != nil {
+			return CmdScale{}, err
+		}
+		if err := validateRange(v, 0, 11); err != nil {
+			return CmdScale{}, err
+		}
+		intervals = append(intervals, v)
+	}
+
+	if intervals[0] != 0 {
+		return CmdScale{}, fmt.Errorf("scale '%s' must begin with 0", name)
+	}
+
+	if !slices.IsSorted(intervals) || len(slices.Compact(slices.Clone(intervals))) != len(intervals) {
+		return CmdScale{}, fmt.Errorf("scale '%s' intervals must be ascending", name)
+	}
+
+	return CmdScale{
+		Pos:       pos,
+		Name:      name,
+		Intervals: intervals,
 	}, nil
 }
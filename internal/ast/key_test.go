@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/mgnsk/balafon/internal/ast"
 	. "github.com/onsi/gomega"
 )
 
@@ -40,6 +41,12 @@ var scales = []string{
 	"Fm",
 	"Bbm",
 	"Ebm",
+
+	// Modes and custom scales.
+	"D dorian",
+	"F# locrian",
+	"Bb mixolydian",
+	"C blues",
 }
 
 func TestKey(t *testing.T) {
@@ -58,3 +65,55 @@ func TestKey(t *testing.T) {
 		})
 	}
 }
+
+func TestInvalidKey(t *testing.T) {
+	for _, input := range []string{
+		":key H",
+		":key Am dorian",
+		":key C#",
+		":key D#",
+	} {
+		t.Run(input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := parse(input)
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestScale(t *testing.T) {
+	g := NewWithT(t)
+
+	nodeList, err := parse(":scale blues 0 3 5 6 7 10")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(nodeList[0]).To(BeAssignableToTypeOf(ast.CmdScale{}))
+	g.Expect(nodeList[0].(ast.CmdScale).Intervals).To(Equal([]int{0, 3, 5, 6, 7, 10}))
+
+	var buf bytes.Buffer
+	_, err = nodeList.WriteTo(&buf)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(buf.String()).To(Equal(":scale blues 0 3 5 6 7 10"))
+}
+
+func TestInvalidScale(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{":scale dorian 0 2 3", "scale 'dorian' already defined"},
+		{":scale x", "scale 'x' has no intervals"},
+		{":scale x 1 2", "scale 'x' must begin with 0"},
+		{":scale x 0 3 2", "scale 'x' intervals must be ascending"},
+		{":scale x 0 3 3", "scale 'x' intervals must be ascending"},
+		{":scale x 0 12", "value must be in range [0, 11], got: 12"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := parse(tc.input)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tc.err))
+		})
+	}
+}
@@ -35,9 +35,12 @@ func (g NoteGroup) WriteTo(w io.Writer) (int64, error) {
 
 // Note is a single note.
 type Note struct {
-	Pos   token.Pos
-	Props PropertyList
-	Name  rune
+	Pos    token.Pos
+	Props  PropertyList
+	Name   string // a single letter or an @ prefixed name
+	Chord  *Chord // if the note is a chord symbol
+	Pitch  *Pitch // if the note is an absolute pitch
+	Degree int    // if the note is a scale degree
 }
 
 // WriteTo writes the note to w.
@@ -45,7 +48,21 @@ func (note *Note) WriteTo(w io.Writer) (int64, error) {
 	ew := newErrWriter(w)
 	var n int
 
-	n += ew.WriteRune(note.Name)
+	if note.Chord != nil {
+		n += ew.WriteString("{")
+		n += ew.WriteString(note.Chord.Symbol)
+		n += ew.WriteString("}")
+	} else if note.Pitch != nil {
+		n += ew.WriteString("<")
+		n += ew.WriteString(note.Pitch.Symbol)
+		n += ew.WriteString(">")
+	} else if note.Degree > 0 {
+		n += ew.WriteString("<")
+		n += ew.WriteInt(note.Degree)
+		n += ew.WriteString(">")
+	} else {
+		n += ew.WriteString(note.Name)
+	}
 	n += ew.WriteFrom(note.Props)
 
 	return int64(n), ew.Flush()
@@ -53,11 +70,21 @@ func (note *Note) WriteTo(w io.Writer) (int64, error) {
 
 // IsPause reports whether the note is a pause.
 func (note *Note) IsPause() bool {
-	return note.Name == '-'
+	return note.Name == "-"
+}
+
+// IsSharp reports whether the note has a sharp property or is an absolute pitch spelled with a sharp.
+func (note *Note) IsSharp() bool {
+	return note.Props.IsSharp() || (note.Pitch != nil && note.Pitch.Shift > 0)
+}
+
+// IsFlat reports whether the note has a flat property or is an absolute pitch spelled with a flat.
+func (note *Note) IsFlat() bool {
+	return note.Props.IsFlat() || (note.Pitch != nil && note.Pitch.Shift < 0)
 }
 
 // NewNote creates a note with properties.
-func NewNote(pos token.Pos, name rune, propList PropertyList) *Note {
+func NewNote(pos token.Pos, name string, propList PropertyList) *Note {
 	return &Note{
 		Pos:   pos,
 		Props: propList,
@@ -76,10 +76,42 @@ func TestNoteList(t *testing.T) {
 			"-8", // 8th pause.
 			"-8",
 		},
+		{
+			"k~k8", // Tied note.
+			"k~k8",
+		},
 		{
 			"k/3.#8",
 			"k#8./3",
 		},
+		{
+			"k&100 [kk]&0",
+			"k&100[kk]&0",
+		},
+		{
+			"k8'#,,", // Octave properties after sharp and flat.
+			"k#',,8",
+		},
+		{
+			"@kick8 [@hhc k]16. @snare>", // Multi-character symbols.
+			"@kick8[@hhc k]16.@snare>",
+		},
+		{
+			"k8&64.",
+			"k8.&64",
+		},
+		{
+			"k?50 [kk]8?100 k?0.",
+			"k?50[kk]8?100k.?0",
+		},
+		{
+			"[kkkkkk]16/6:4",
+			"[kkkkkk]16/6:4",
+		},
+		{
+			"k8/9:6",
+			"k8/9:6",
+		},
 		{
 			"[[[[[k]/3].]#]8]>>^^``", // Testing the ordering of properties.
 			"[[[[[k]/3].]#]8]``>>^^",
@@ -110,6 +142,13 @@ func TestInvalidNoteValue(t *testing.T) {
 		"k22",
 		"k0",
 		"k129",
+		"k/1",
+		"k/2",
+		"k/3:3",
+		"k/3:0",
+		"k/129",
+		"k&128",
+		"k?101",
 	} {
 		t.Run(input, func(t *testing.T) {
 			g := NewGomegaWithT(t)
@@ -149,6 +188,14 @@ func TestNoteLengths(t *testing.T) {
 			input: "k./3", // Dotted triplet quarter note == quarter note.
 			offAt: uint32(constants.TicksPerQuarter),
 		},
+		{
+			input: "k16/6:4", // Sextuplet 16th note.
+			offAt: uint32(constants.TicksPerQuarter / 6),
+		},
+		{
+			input: "k8/3:4", // 3 8th notes in the time of 4.
+			offAt: uint32(constants.TicksPerQuarter * 2 / 3),
+		},
 	} {
 		t.Run(tc.input, func(t *testing.T) {
 			g := NewWithT(t)
@@ -159,6 +206,26 @@ func TestNoteLengths(t *testing.T) {
 			note := res[0].(ast.NodeList)[0]
 			g.Expect(note).To(BeAssignableToTypeOf(&ast.Note{}))
 			g.Expect(note.(*ast.Note).Props.NoteLen()).To(Equal(tc.offAt))
+			g.Expect(note.(*ast.Note).Props.IsExactLen()).To(BeTrue())
+		})
+	}
+}
+
+func TestInexactNoteLengths(t *testing.T) {
+	for _, input := range []string{
+		"k/7",
+		"k16/7:4",
+		"k/9:8",
+		"k128..",
+	} {
+		t.Run(input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			res, err := parse(input)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			note := res[0].(ast.NodeList)[0]
+			g.Expect(note.(*ast.Note).Props.IsExactLen()).To(BeFalse())
 		})
 	}
 }
@@ -0,0 +1,83 @@
+package ast
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Option is a named command argument in the form name=value.
+type Option struct {
+	Name   string
+	Value  int
+	Signed bool // if the value was written with an explicit sign
+}
+
+// WriteTo writes the option to w.
+func (o Option) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(o.Name)
+	n += ew.WriteString("=")
+	if o.Signed && o.Value >= 0 {
+		n += ew.WriteString("+")
+	}
+	n += ew.WriteInt(o.Value)
+
+	return int64(n), ew.Flush()
+}
+
+// OptionList is a list of command options.
+type OptionList []Option
+
+// WriteTo writes the options to w, each preceded by a space.
+func (l OptionList) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	for _, o := range l {
+		n += ew.WriteString(" ")
+		n += ew.WriteFrom(o)
+	}
+
+	return int64(n), ew.Flush()
+}
+
+// Get returns the option with the name.
+func (l OptionList) Get(name string) (Option, bool) {
+	for _, o := range l {
+		if o.Name == name {
+			return o, true
+		}
+	}
+
+	return Option{}, false
+}
+
+// NewOptionList creates an option list from name=value fields.
+func NewOptionList(fields []string) (OptionList, error) {
+	var list OptionList
+
+	for _, field := range fields {
+		name, value, _ := strings.Cut(field, "=")
+
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := list.Get(name); ok {
+			return nil, fmt.Errorf("duplicate option '%s'", name)
+		}
+
+		list = append(list, Option{
+			Name:   name,
+			Value:  v,
+			Signed: strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-"),
+		})
+	}
+
+	return list, nil
+}
@@ -0,0 +1,82 @@
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mgnsk/balafon/internal/constants"
+	"github.com/mgnsk/balafon/internal/parser/token"
+)
+
+// Pitch is a parsed absolute pitch.
+type Pitch struct {
+	Symbol string // the pitch without angle brackets
+	Letter rune   // note letter a-g
+	Shift  int    // -1 for flat, 1 for sharp
+	Octave int    // octave where 4 is the octave of middle C
+}
+
+// Key returns the MIDI key of the natural note letter in the octave.
+func (p *Pitch) Key() int {
+	return (p.Octave+1)*12 + pitchClasses[byte(unicode.ToUpper(p.Letter))]
+}
+
+// NewPitch creates a note from an absolute pitch in the form <LetterAccidentalOctave>.
+func NewPitch(pos token.Pos, symbol string, props PropertyList) (*Note, error) {
+	symbol = strings.TrimSuffix(strings.TrimPrefix(symbol, "<"), ">")
+
+	letter, shift, rest, err := parseLetter(strings.ToUpper(symbol[:1]) + symbol[1:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid pitch '%s': %w", symbol, err)
+	}
+
+	if shift != 0 && (props.IsSharp() || props.IsFlat()) {
+		return nil, fmt.Errorf("sharp or flat property not allowed on pitch '%s'", symbol)
+	}
+
+	octave, err := strconv.Atoi(rest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pitch octave '%s'", rest)
+	}
+
+	pitch := &Pitch{
+		Symbol: symbol,
+		Letter: unicode.ToLower(letter),
+		Shift:  shift,
+		Octave: octave,
+	}
+
+	if key := pitch.Key() + shift; key < 0 || key > constants.MaxValue {
+		return nil, fmt.Errorf("pitch '%s' key must be in range [%d, %d], got: %d", symbol, 0, constants.MaxValue, key)
+	}
+
+	return &Note{
+		Pos:   pos,
+		Props: props,
+		Name:  string(pitch.Letter),
+		Pitch: pitch,
+	}, nil
+}
+
+// NewDegree creates a note from a scale degree in the form <Degree>.
+func NewDegree(pos token.Pos, symbol string, props PropertyList) (*Note, error) {
+	symbol = strings.TrimSuffix(strings.TrimPrefix(symbol, "<"), ">")
+
+	degree, err := strconv.Atoi(symbol)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scale degree '%s'", symbol)
+	}
+
+	if err := validateRange(degree, 1, constants.MaxValue); err != nil {
+		return nil, err
+	}
+
+	return &Note{
+		Pos:    pos,
+		Props:  props,
+		Name:   symbol,
+		Degree: degree,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package ast_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mgnsk/balafon/internal/ast"
+	. "github.com/onsi/gomega"
+)
+
+func TestPitch(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected ast.Pitch
+		key      int
+	}{
+		{"<c4>", ast.Pitch{Symbol: "c4", Letter: 'c', Octave: 4}, 60},
+		{"<f#3>8.", ast.Pitch{Symbol: "f#3", Letter: 'f', Shift: 1, Octave: 3}, 53},
+		{"<bb5>^", ast.Pitch{Symbol: "bb5", Letter: 'b', Shift: -1, Octave: 5}, 83},
+		{"<c-1>", ast.Pitch{Symbol: "c-1", Letter: 'c', Octave: -1}, 0},
+		{"<g9>#", ast.Pitch{Symbol: "g9", Letter: 'g', Octave: 9}, 127},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			res, err := parse(tc.input)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			note := res[0].(ast.NodeList)[0].(*ast.Note)
+			g.Expect(note.Pitch).To(Equal(&tc.expected))
+			g.Expect(note.Pitch.Key()).To(Equal(tc.key))
+
+			var buf bytes.Buffer
+			_, err = res.WriteTo(&buf)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(buf.String()).To(Equal(tc.input))
+		})
+	}
+}
+
+func TestInvalidPitch(t *testing.T) {
+	for _, input := range []string{
+		"<c>",
+		"<h4>",
+		"<C4>",
+		"<c10>",
+		"<g#9>",
+		"<cb-1>",
+		"<c#4>#",
+		"<db4>$",
+	} {
+		t.Run(input, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			_, err := parse(input)
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/mgnsk/balafon/internal/constants"
 	"github.com/mgnsk/balafon/internal/parser/token"
@@ -21,7 +22,10 @@ func isUniqueProperty(typ token.Type) bool {
 		tokentype.PropFlat,
 		tokentype.Uint,
 		tokentype.PropTuplet,
-		tokentype.PropLetRing:
+		tokentype.PropLetRing,
+		tokentype.PropTie,
+		tokentype.PropAftertouch,
+		tokentype.PropChance:
 		return true
 	default:
 		return false
@@ -61,17 +65,35 @@ func (l PropertyList) WriteTo(w io.Writer) (int64, error) {
 
 // NoteLen returns the note duration in ticks.
 func (l PropertyList) NoteLen() uint32 {
+	length, _ := l.noteLen()
+	return length
+}
+
+// IsExactLen reports whether the note duration can be represented exactly in ticks.
+func (l PropertyList) IsExactLen() bool {
+	_, exact := l.noteLen()
+	return exact
+}
+
+func (l PropertyList) noteLen() (newLength uint32, exact bool) {
+	exact = true
 	length := uint32(constants.TicksPerWhole) / uint32(l.Value())
-	newLength := length
+	newLength = length
 	dots := l.NumDot()
 	for range dots {
+		if length%2 != 0 {
+			exact = false
+		}
 		length /= 2
 		newLength += length
 	}
-	if division := uint32(l.Tuplet()); division > 0 {
-		newLength = newLength * 2 / division
+	if n, m := l.TupletRatio(); n > 0 {
+		if newLength*uint32(m)%uint32(n) != 0 {
+			exact = false
+		}
+		newLength = newLength * uint32(m) / uint32(n)
 	}
-	return newLength
+	return newLength, exact
 }
 
 // IsSharp reports whether the list contains a sharp property.
@@ -84,6 +106,13 @@ func (l PropertyList) IsFlat() bool {
 	return l.find(tokentype.PropFlat) != -1
 }
 
+// Natural returns a copy of the list without sharp and flat properties.
+func (l PropertyList) Natural() PropertyList {
+	return slices.DeleteFunc(slices.Clone(l), func(tok *token.Token) bool {
+		return tok.Type == tokentype.PropSharp || tok.Type == tokentype.PropFlat
+	})
+}
+
 // NumSharp returns the number of sharp signs.
 func (l PropertyList) NumSharp() int {
 	return l.countProps(tokentype.PropSharp)
@@ -94,6 +123,11 @@ func (l PropertyList) NumFlat() int {
 	return l.countProps(tokentype.PropFlat)
 }
 
+// Octave returns the number of octaves the note is shifted by.
+func (l PropertyList) Octave() int {
+	return l.countProps(tokentype.PropOctaveUp) - l.countProps(tokentype.PropOctaveDown)
+}
+
 // NumStaccato reports the number of staccato properties.
 func (l PropertyList) NumStaccato() int {
 	return l.countProps(tokentype.PropStaccato)
@@ -135,16 +169,39 @@ func (l PropertyList) NumDot() int {
 
 // Tuplet returns the irregular division value if the note is a tuplet.
 func (l PropertyList) Tuplet() int {
+	n, _ := l.TupletRatio()
+	return n
+}
+
+// TupletRatio returns the tuplet ratio if the note is a tuplet.
+// A tuplet plays n notes in the time of m.
+func (l PropertyList) TupletRatio() (n, m int) {
 	idx := l.find(tokentype.PropTuplet)
 	if idx == -1 {
-		return 0
+		return 0, 0
 	}
-	// Trim the "/" prefix from tuplet token to get division number.
-	v, err := strconv.Atoi(string(l[idx].Lit[1:]))
+	n, m, err := parseTuplet(l[idx].Lit)
 	if err != nil {
 		panic(err)
 	}
-	return v
+	return n, m
+}
+
+// parseTuplet parses a tuplet token in the form /n or /n:m.
+// The ratio of /n is n:2.
+func parseTuplet(lit []byte) (n, m int, err error) {
+	// Trim the "/" prefix from tuplet token to get the ratio.
+	ns, ms, ok := strings.Cut(string(lit[1:]), ":")
+	if !ok {
+		ms = "2"
+	}
+	if n, err = strconv.Atoi(ns); err != nil {
+		return 0, 0, err
+	}
+	if m, err = strconv.Atoi(ms); err != nil {
+		return 0, 0, err
+	}
+	return n, m, nil
 }
 
 // IsLetRing reports whether the note must ring.
@@ -152,6 +209,63 @@ func (l PropertyList) IsLetRing() bool {
 	return l.find(tokentype.PropLetRing) != -1
 }
 
+// IsTie reports whether the note is tied to the next note of the same pitch.
+func (l PropertyList) IsTie() bool {
+	return l.find(tokentype.PropTie) != -1
+}
+
+// Aftertouch returns the polyphonic aftertouch pressure if the note has one.
+func (l PropertyList) Aftertouch() (uint8, bool) {
+	idx := l.find(tokentype.PropAftertouch)
+	if idx == -1 {
+		return 0, false
+	}
+	// Trim the "&" prefix from aftertouch token to get the pressure.
+	v, err := strconv.Atoi(string(l[idx].Lit[1:]))
+	if err != nil {
+		panic(err)
+	}
+	return uint8(v), true
+}
+
+// Chance returns the probability in percent that the note is played if the note has one.
+func (l PropertyList) Chance() (int, bool) {
+	idx := l.find(tokentype.PropChance)
+	if idx == -1 {
+		return 0, false
+	}
+	// Trim the "?" prefix from chance token to get the probability.
+	v, err := strconv.Atoi(string(l[idx].Lit[1:]))
+	if err != nil {
+		panic(err)
+	}
+	return v, true
+}
+
+// WithLen returns a copy of the list with the note value and dots replaced and without a tuplet.
+func (l PropertyList) WithLen(value uint8, dots int) PropertyList {
+	p := slices.DeleteFunc(slices.Clone(l), func(tok *token.Token) bool {
+		return tok.Type == tokentype.Uint || tok.Type == tokentype.PropDot || tok.Type == tokentype.PropTuplet
+	})
+
+	p = append(p, &token.Token{
+		Type: tokentype.Uint,
+		Lit:  []byte(strconv.Itoa(int(value))),
+	})
+	for range dots {
+		p = append(p, &token.Token{
+			Type: tokentype.PropDot,
+			Lit:  []byte("."),
+		})
+	}
+
+	slices.SortStableFunc(p, func(a, b *token.Token) int {
+		return cmp.Compare(a.Type, b.Type)
+	})
+
+	return p
+}
+
 func (l PropertyList) has(typ token.Type) bool {
 	return slices.ContainsFunc(l, func(tok *token.Token) bool {
 		return tok.Type == typ
@@ -186,11 +300,27 @@ func NewPropertyList(t *token.Token, inner any) (PropertyList, error) {
 			return nil, err
 		}
 	case tokentype.PropTuplet:
+		n, m, err := parseTuplet(t.Lit)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateTuplet(n, m); err != nil {
+			return nil, err
+		}
+	case tokentype.PropAftertouch:
+		v, err := strconv.Atoi(string(t.Lit[1:]))
+		if err != nil {
+			return nil, err
+		}
+		if err := validateRange(v, 0, constants.MaxValue); err != nil {
+			return nil, err
+		}
+	case tokentype.PropChance:
 		v, err := strconv.Atoi(string(t.Lit[1:]))
 		if err != nil {
 			return nil, err
 		}
-		if err := validateTuplet(v); err != nil {
+		if err := validateRange(v, 0, 100); err != nil {
 			return nil, err
 		}
 	}
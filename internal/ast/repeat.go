@@ -0,0 +1,47 @@
+package ast
+
+import (
+	"io"
+	"math"
+
+	"github.com/mgnsk/balafon/internal/parser/token"
+)
+
+// Repeat is a repeated block.
+type Repeat struct {
+	Pos      token.Pos
+	Times    uint8
+	DeclList NodeList
+}
+
+// WriteTo writes the repeat block to w.
+func (r Repeat) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(`:repeat `)
+	n += ew.WriteInt(int(r.Times))
+	n += ew.WriteString("\n")
+
+	for _, stmt := range r.DeclList {
+		n += ew.WriteString("\t")
+		n += ew.WriteFrom(stmt)
+	}
+
+	n += ew.WriteString(":end")
+
+	return int64(n), ew.Flush()
+}
+
+// NewRepeat creates a new repeat block.
+func NewRepeat(pos token.Pos, times int64, declList NodeList) (Repeat, error) {
+	if err := validateRange(times, 2, math.MaxUint8); err != nil {
+		return Repeat{}, err
+	}
+
+	return Repeat{
+		Pos:      pos,
+		Times:    uint8(times),
+		DeclList: declList,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/mgnsk/balafon/internal/ast"
+	. "github.com/onsi/gomega"
+)
+
+func TestRepeat(t *testing.T) {
+	g := NewWithT(t)
+
+	res, err := parse(`
+:repeat 2
+	c
+	:volta 1
+	d
+	:volta 2
+	e
+:end
+`)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res).To(HaveExactElements(
+		And(
+			HaveField("Times", uint8(2)),
+			HaveField("DeclList", HaveExactElements(
+				BeAssignableToTypeOf(ast.NodeList{}),
+				HaveField("Number", uint8(1)),
+				BeAssignableToTypeOf(ast.NodeList{}),
+				HaveField("Number", uint8(2)),
+				BeAssignableToTypeOf(ast.NodeList{}),
+			)),
+		),
+	))
+}
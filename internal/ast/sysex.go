@@ -0,0 +1,104 @@
+package ast
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mgnsk/balafon/internal/parser/token"
+)
+
+// CmdSysex is a system exclusive message command.
+type CmdSysex struct {
+	Data []byte // one or more complete messages including the F0 and F7 bytes
+}
+
+// WriteTo writes the command to w.
+func (c CmdSysex) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(":sysex ")
+	n += ew.WriteString(fmt.Sprintf("% X", c.Data))
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdSysex creates a system exclusive message command from space separated hex bytes.
+func NewCmdSysex(args string) (CmdSysex, error) {
+	data, err := hex.DecodeString(strings.Join(strings.Fields(args), ""))
+	if err != nil {
+		return CmdSysex{}, err
+	}
+
+	if _, err := SplitSysex(data); err != nil {
+		return CmdSysex{}, err
+	}
+
+	return CmdSysex{
+		Data: data,
+	}, nil
+}
+
+// CmdSysexFile is a command that sends the system exclusive messages of a .syx file.
+type CmdSysexFile struct {
+	Pos  token.Pos
+	Path string
+}
+
+// WriteTo writes the command to w.
+func (c CmdSysexFile) WriteTo(w io.Writer) (int64, error) {
+	ew := newErrWriter(w)
+	var n int
+
+	n += ew.WriteString(`:sysex "`)
+	n += ew.WriteString(c.Path)
+	n += ew.WriteString(`"`)
+
+	return int64(n), ew.Flush()
+}
+
+// NewCmdSysexFile creates a sysex file command from a quoted path.
+func NewCmdSysexFile(pos token.Pos, quotedPath string) (CmdSysexFile, error) {
+	path := strings.Trim(quotedPath, `"`)
+	if path == "" {
+		return CmdSysexFile{}, fmt.Errorf("empty sysex path")
+	}
+
+	return CmdSysexFile{
+		Pos:  pos,
+		Path: path,
+	}, nil
+}
+
+// SplitSysex splits data into complete system exclusive messages.
+func SplitSysex(data []byte) ([][]byte, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty sysex data")
+	}
+
+	var messages [][]byte
+
+	for len(data) > 0 {
+		if data[0] != 0xF0 {
+			return nil, fmt.Errorf("sysex message must begin with F0, got: %02X", data[0])
+		}
+
+		end := 1
+		for ; end < len(data) && data[end] != 0xF7; end++ {
+			if data[end] > 0x7F {
+				return nil, fmt.Errorf("invalid sysex data byte %02X", data[end])
+			}
+		}
+
+		if end == len(data) {
+			return nil, fmt.Errorf("sysex message must end with F7")
+		}
+
+		messages = append(messages, data[:end+1])
+		data = data[end+1:]
+	}
+
+	return messages, nil
+}
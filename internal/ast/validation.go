@@ -19,9 +19,15 @@ func validateNoteValue(v int) error {
 	return nil
 }
 
-func validateTuplet(v int) error {
-	if v == 3 || v == 5 {
-		return nil
+func validateTuplet(n, m int) error {
+	if err := validateRange(n, 2, 128); err != nil {
+		return err
 	}
-	return fmt.Errorf("invalid tuplet value, got: %d", v)
+	if err := validateRange(m, 1, 128); err != nil {
+		return err
+	}
+	if n == m {
+		return fmt.Errorf("invalid tuplet %d:%d", n, m)
+	}
+	return nil
 }
@@ -0,0 +1,112 @@
+package gm
+
+// Drum is a General MIDI percussion instrument.
+type Drum struct {
+	Name     string
+	Step     string // the display step on a percussion staff
+	Octave   int    // the display octave on a percussion staff
+	Notehead string
+}
+
+// Drums are the General MIDI level 1 percussion instruments by key.
+var Drums = map[uint8]Drum{
+	35: {"Acoustic Bass Drum", "E", 4, "normal"},
+	36: {"Bass Drum 1", "F", 4, "normal"},
+	37: {"Side Stick", "C", 5, "x"},
+	38: {"Acoustic Snare", "C", 5, "normal"},
+	39: {"Hand Clap", "C", 5, "slash"},
+	40: {"Electric Snare", "C", 5, "normal"},
+	41: {"Low Floor Tom", "F", 4, "normal"},
+	42: {"Closed Hi-Hat", "G", 5, "x"},
+	43: {"High Floor Tom", "A", 4, "normal"},
+	44: {"Pedal Hi-Hat", "D", 4, "x"},
+	45: {"Low Tom", "B", 4, "normal"},
+	46: {"Open Hi-Hat", "G", 5, "circle-x"},
+	47: {"Low-Mid Tom", "D", 5, "normal"},
+	48: {"Hi-Mid Tom", "D", 5, "normal"},
+	49: {"Crash Cymbal 1", "A", 5, "x"},
+	50: {"High Tom", "E", 5, "normal"},
+	51: {"Ride Cymbal 1", "F", 5, "x"},
+	52: {"Chinese Cymbal", "B", 5, "x"},
+	53: {"Ride Bell", "F", 5, "diamond"},
+	54: {"Tambourine", "E", 5, "triangle"},
+	55: {"Splash Cymbal", "B", 5, "x"},
+	56: {"Cowbell", "E", 5, "triangle"},
+	57: {"Crash Cymbal 2", "A", 5, "x"},
+	58: {"Vibraslap", "D", 5, "x"},
+	59: {"Ride Cymbal 2", "F", 5, "x"},
+	60: {"Hi Bongo", "E", 5, "normal"},
+	61: {"Low Bongo", "D", 5, "normal"},
+	62: {"Mute Hi Conga", "C", 5, "x"},
+	63: {"Open Hi Conga", "C", 5, "normal"},
+	64: {"Low Conga", "A", 4, "normal"},
+	65: {"High Timbale", "E", 5, "normal"},
+	66: {"Low Timbale", "D", 5, "normal"},
+	67: {"High Agogo", "E", 5, "triangle"},
+	68: {"Low Agogo", "D", 5, "triangle"},
+	69: {"Cabasa", "E", 5, "x"},
+	70: {"Maracas", "D", 5, "x"},
+	71: {"Short Whistle", "E", 5, "normal"},
+	72: {"Long Whistle", "D", 5, "normal"},
+	73: {"Short Guiro", "E", 5, "slash"},
+	74: {"Long Guiro", "D", 5, "slash"},
+	75: {"Claves", "E", 5, "x"},
+	76: {"Hi Wood Block", "E", 5, "triangle"},
+	77: {"Low Wood Block", "D", 5, "triangle"},
+	78: {"Mute Cuica", "E", 5, "x"},
+	79: {"Open Cuica", "D", 5, "circle-x"},
+	80: {"Mute Triangle", "E", 5, "x"},
+	81: {"Open Triangle", "E", 5, "triangle"},
+}
+
+// Kits are named note assignments of percussion instruments.
+var Kits = map[string]map[string]uint8{
+	"gm": {
+		"K": 35, // Acoustic Bass Drum
+		"k": 36, // Bass Drum 1
+		"t": 37, // Side Stick
+		"s": 38, // Acoustic Snare
+		"p": 39, // Hand Clap
+		"S": 40, // Electric Snare
+		"F": 41, // Low Floor Tom
+		"x": 42, // Closed Hi-Hat
+		"f": 43, // High Floor Tom
+		"X": 44, // Pedal Hi-Hat
+		"l": 45, // Low Tom
+		"o": 46, // Open Hi-Hat
+		"m": 47, // Low-Mid Tom
+		"M": 48, // Hi-Mid Tom
+		"c": 49, // Crash Cymbal 1
+		"h": 50, // High Tom
+		"r": 51, // Ride Cymbal 1
+		"n": 52, // Chinese Cymbal
+		"b": 53, // Ride Bell
+		"a": 54, // Tambourine
+		"z": 55, // Splash Cymbal
+		"w": 56, // Cowbell
+		"C": 57, // Crash Cymbal 2
+		"R": 59, // Ride Cymbal 2
+	},
+	"latin": {
+		"b": 60, // Hi Bongo
+		"B": 61, // Low Bongo
+		"m": 62, // Mute Hi Conga
+		"c": 63, // Open Hi Conga
+		"C": 64, // Low Conga
+		"t": 65, // High Timbale
+		"T": 66, // Low Timbale
+		"a": 67, // High Agogo
+		"A": 68, // Low Agogo
+		"k": 69, // Cabasa
+		"x": 70, // Maracas
+		"g": 73, // Short Guiro
+		"G": 74, // Long Guiro
+		"v": 75, // Claves
+		"w": 76, // Hi Wood Block
+		"W": 77, // Low Wood Block
+		"q": 78, // Mute Cuica
+		"Q": 79, // Open Cuica
+		"i": 80, // Mute Triangle
+		"I": 81, // Open Triangle
+	},
+}
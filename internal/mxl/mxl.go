@@ -52,9 +52,10 @@ type Part struct {
 
 // Measure represents a measure in a piece of music
 type Measure struct {
-	Atters Attributes `xml:"attributes"`
-	Notes  []any      // Note or Backup (TODO)
-	Number int        `xml:"number,attr"`
+	Atters   Attributes `xml:"attributes"`
+	Notes    []any      // Note or Backup (TODO)
+	Number   int        `xml:"number,attr"`
+	Implicit string     `xml:"implicit,attr,omitempty"` // "yes" for a measure that is not counted, such as a pickup
 }
 
 // Attributes represents
@@ -85,15 +86,35 @@ type Time struct {
 
 // Note represents a note in a measure
 type Note struct {
-	XMLName  xml.Name  `xml:"note"`
-	Pitch    *Pitch    `xml:"pitch,omitempty"`
-	Rest     *xml.Name `xml:"rest,omitempty"`
-	Chord    *xml.Name `xml:"chord,omitempty"`
-	Tie      *Tie      `xml:"tie,omitempty"`
-	NoteHead *NoteHead `xml:"notehead,omitempty"`
-	Type     string    `xml:"type,omitempty"`
-	Duration int       `xml:"duration"`
-	Voice    int       `xml:"voice,omitempty"`
+	XMLName   xml.Name   `xml:"note"`
+	Pitch     *Pitch     `xml:"pitch,omitempty"`
+	Unpitched *Unpitched `xml:"unpitched,omitempty"`
+	Rest      *xml.Name  `xml:"rest,omitempty"`
+	Chord     *xml.Name  `xml:"chord,omitempty"`
+	Ties      []Tie      `xml:"tie,omitempty"`
+	NoteHead  *NoteHead  `xml:"notehead,omitempty"`
+	Type      string     `xml:"type,omitempty"`
+	Duration  int        `xml:"duration"`
+	Voice     int        `xml:"voice,omitempty"`
+	TimeMod   *TimeMod   `xml:"time-modification,omitempty"`
+	Notations *Notations `xml:"notations,omitempty"`
+}
+
+// TimeMod represents the time modification of a tuplet note.
+type TimeMod struct {
+	ActualNotes int `xml:"actual-notes"`
+	NormalNotes int `xml:"normal-notes"`
+}
+
+// Notations represents the notations of a note.
+type Notations struct {
+	Tied    []Tied   `xml:"tied,omitempty"`
+	Tuplets []Tuplet `xml:"tuplet,omitempty"`
+}
+
+// Tuplet represents the notated bracket of a tuplet.
+type Tuplet struct {
+	Type string `xml:"type,attr"`
 }
 
 // NoteHead is a notehead element.
@@ -117,7 +138,63 @@ type Pitch struct {
 	Accidental int8   `xml:"alter"`
 }
 
+// Unpitched represents the notated position of an unpitched percussion note.
+type Unpitched struct {
+	DisplayStep   string `xml:"display-step"`
+	DisplayOctave int    `xml:"display-octave"`
+}
+
 // Tie represents whether or not a note is tied.
 type Tie struct {
 	Type string `xml:"type,attr"`
 }
+
+// Tied represents the notated tie of a note.
+type Tied struct {
+	Type string `xml:"type,attr"`
+}
+
+// Direction represents a musical direction.
+type Direction struct {
+	XMLName   xml.Name        `xml:"direction"`
+	Placement string          `xml:"placement,attr,omitempty"`
+	Types     []DirectionType `xml:"direction-type"`
+	Voice     int             `xml:"voice,omitempty"`
+}
+
+// DirectionType represents the type of a direction.
+type DirectionType struct {
+	Dynamics *Dynamics `xml:"dynamics,omitempty"`
+	Wedge    *Wedge    `xml:"wedge,omitempty"`
+}
+
+// Dynamics represents a dynamic mark.
+type Dynamics struct {
+	Value string `xml:",innerxml"`
+}
+
+// Wedge represents a hairpin.
+type Wedge struct {
+	Type string `xml:"type,attr"`
+}
+
+// Barline represents a barline element.
+type Barline struct {
+	XMLName  xml.Name `xml:"barline"`
+	Location string   `xml:"location,attr"`
+	BarStyle string   `xml:"bar-style,omitempty"`
+	Ending   *Ending  `xml:"ending,omitempty"`
+	Repeat   *Repeat  `xml:"repeat,omitempty"`
+}
+
+// Ending represents a volta bracket.
+type Ending struct {
+	Number string `xml:"number,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// Repeat represents a repeat sign.
+type Repeat struct {
+	Direction string `xml:"direction,attr"`
+	Times     int    `xml:"times,attr,omitempty"`
+}
@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 115
-	NumSymbols = 158
+	NumStates  = 412
+	NumSymbols = 376
 )
 
 type Lexer struct {
@@ -131,160 +131,378 @@ func (l *Lexer) Reset() {
 Lexer symbols:
 0: ';'
 1: '\n'
-2: '-'
-3: 'b'
-4: 'a'
-5: 'r'
-6: 'e'
-7: 'n'
-8: 'd'
-9: 'p'
-10: 'l'
-11: 'a'
-12: 'y'
+2: '@'
+3: '_'
+4: '-'
+5: 'b'
+6: 'a'
+7: 'r'
+8: 'e'
+9: 'n'
+10: 'd'
+11: 'p'
+12: 'l'
 13: 'a'
-14: 's'
-15: 's'
-16: 'i'
-17: 'g'
-18: 'n'
-19: 't'
-20: 'e'
-21: 'm'
-22: 'p'
-23: 'o'
-24: 'k'
-25: 'e'
-26: 'y'
-27: 't'
-28: 'i'
-29: 'm'
+14: 'y'
+15: 'a'
+16: 's'
+17: 's'
+18: 'i'
+19: 'g'
+20: 'n'
+21: 't'
+22: 'e'
+23: 'm'
+24: 'p'
+25: 'o'
+26: 'k'
+27: 'i'
+28: 't'
+29: 'k'
 30: 'e'
-31: 'v'
-32: 'e'
-33: 'l'
-34: 'o'
-35: 'c'
-36: 'i'
+31: 'y'
+32: 's'
+33: 'c'
+34: 'a'
+35: 'l'
+36: 'e'
 37: 't'
-38: 'y'
-39: 'c'
-40: 'h'
-41: 'a'
-42: 'n'
-43: 'n'
+38: 'i'
+39: 'm'
+40: 'e'
+41: 'm'
+42: 'e'
+43: 't'
 44: 'e'
-45: 'l'
+45: 'r'
 46: 'v'
-47: 'o'
-48: 'i'
-49: 'c'
-50: 'e'
-51: 'p'
-52: 'r'
-53: 'o'
-54: 'g'
-55: 'r'
-56: 'a'
-57: 'm'
-58: 'c'
-59: 'o'
-60: 'n'
-61: 't'
-62: 'r'
-63: 'o'
-64: 'l'
-65: 's'
-66: 't'
-67: 'a'
-68: 'r'
-69: 't'
-70: 's'
-71: 't'
-72: 'o'
-73: 'p'
-74: '['
-75: ']'
-76: '#'
-77: '$'
-78: '`'
-79: '>'
-80: '^'
-81: ')'
-82: '.'
-83: '/'
-84: '3'
-85: '/'
-86: '5'
-87: '*'
-88: '/'
-89: '*'
-90: '*'
-91: '*'
-92: '/'
-93: '0'
-94: ' '
-95: '\t'
-96: ' '
-97: '\t'
-98: ':'
-99: 'C'
-100: 'G'
-101: 'D'
-102: 'A'
-103: 'E'
-104: 'B'
-105: 'F'
-106: '#'
-107: 'F'
-108: 'B'
-109: 'b'
-110: 'E'
-111: 'b'
-112: 'A'
-113: 'b'
-114: 'D'
-115: 'b'
-116: 'G'
-117: 'b'
-118: 'A'
-119: 'm'
-120: 'E'
-121: 'm'
-122: 'B'
-123: 'm'
-124: 'F'
-125: '#'
-126: 'm'
-127: 'C'
-128: '#'
-129: 'm'
-130: 'G'
-131: '#'
-132: 'm'
-133: 'D'
-134: '#'
-135: 'm'
-136: 'D'
-137: 'm'
-138: 'G'
-139: 'm'
-140: 'C'
-141: 'm'
-142: 'F'
-143: 'm'
-144: 'B'
-145: 'b'
-146: 'm'
-147: 'E'
-148: 'b'
-149: 'm'
-150: ' '
-151: '\t'
-152: '\r'
-153: '1'-'9'
-154: '0'-'9'
-155: 'a'-'z'
-156: 'A'-'Z'
-157: .
+47: 'e'
+48: 'l'
+49: 'o'
+50: 'c'
+51: 'i'
+52: 't'
+53: 'y'
+54: 'o'
+55: 'c'
+56: 't'
+57: 'a'
+58: 'v'
+59: 'e'
+60: '+'
+61: '-'
+62: 'c'
+63: 'h'
+64: 'a'
+65: 'n'
+66: 'n'
+67: 'e'
+68: 'l'
+69: 'v'
+70: 'o'
+71: 'i'
+72: 'c'
+73: 'e'
+74: 'p'
+75: 'r'
+76: 'o'
+77: 'g'
+78: 'r'
+79: 'a'
+80: 'm'
+81: 'b'
+82: 'a'
+83: 'n'
+84: 'k'
+85: '='
+86: ':'
+87: 'p'
+88: 'r'
+89: 'o'
+90: 'g'
+91: 'r'
+92: 'a'
+93: 'm'
+94: 'c'
+95: 'o'
+96: 'n'
+97: 't'
+98: 'r'
+99: 'o'
+100: 'l'
+101: 'c'
+102: 'o'
+103: 'n'
+104: 't'
+105: 'r'
+106: 'o'
+107: 'l'
+108: '-'
+109: '>'
+110: 'b'
+111: 'e'
+112: 'n'
+113: 'd'
+114: '+'
+115: '-'
+116: 'p'
+117: 'r'
+118: 'e'
+119: 's'
+120: 's'
+121: 'u'
+122: 'r'
+123: 'e'
+124: 's'
+125: 'y'
+126: 's'
+127: 'e'
+128: 'x'
+129: 's'
+130: 'y'
+131: 's'
+132: 'e'
+133: 'x'
+134: 'r'
+135: 'p'
+136: 'n'
+137: 'n'
+138: 'r'
+139: 'p'
+140: 'n'
+141: 's'
+142: 'w'
+143: 'i'
+144: 'n'
+145: 'g'
+146: '%'
+147: 'g'
+148: 'r'
+149: 'o'
+150: 'o'
+151: 'v'
+152: 'e'
+153: 'h'
+154: 'u'
+155: 'm'
+156: 'a'
+157: 'n'
+158: 'i'
+159: 'z'
+160: 'e'
+161: 's'
+162: 't'
+163: 'a'
+164: 'r'
+165: 't'
+166: 's'
+167: 't'
+168: 'o'
+169: 'p'
+170: 'p'
+171: 'i'
+172: 'c'
+173: 'k'
+174: 'u'
+175: 'p'
+176: 's'
+177: 't'
+178: 'a'
+179: 'f'
+180: 'f'
+181: 'o'
+182: 'n'
+183: 'o'
+184: 'f'
+185: 'f'
+186: 'i'
+187: 'n'
+188: 'c'
+189: 'l'
+190: 'u'
+191: 'd'
+192: 'e'
+193: 'r'
+194: 'e'
+195: 'p'
+196: 'e'
+197: 'a'
+198: 't'
+199: 'v'
+200: 'o'
+201: 'l'
+202: 't'
+203: 'a'
+204: 'd'
+205: 'y'
+206: 'n'
+207: 'd'
+208: 'y'
+209: 'n'
+210: 'a'
+211: 'm'
+212: 'i'
+213: 'c'
+214: 's'
+215: 'c'
+216: 'r'
+217: 'e'
+218: 's'
+219: 'c'
+220: 'd'
+221: 'i'
+222: 'm'
+223: '"'
+224: '"'
+225: '{'
+226: '}'
+227: '-'
+228: '>'
+229: '<'
+230: '#'
+231: 'b'
+232: '-'
+233: '>'
+234: '<'
+235: '>'
+236: '['
+237: ']'
+238: '#'
+239: '$'
+240: '''
+241: ','
+242: '`'
+243: '>'
+244: '^'
+245: ')'
+246: '.'
+247: '/'
+248: ':'
+249: '*'
+250: '~'
+251: '&'
+252: '?'
+253: '/'
+254: '*'
+255: '*'
+256: '*'
+257: '/'
+258: '/'
+259: '/'
+260: '0'
+261: ' '
+262: '\t'
+263: ' '
+264: '\t'
+265: ':'
+266: '='
+267: '+'
+268: '-'
+269: 'C'
+270: 'G'
+271: 'D'
+272: 'A'
+273: 'E'
+274: 'B'
+275: 'F'
+276: '#'
+277: 'F'
+278: 'B'
+279: 'b'
+280: 'E'
+281: 'b'
+282: 'A'
+283: 'b'
+284: 'D'
+285: 'b'
+286: 'G'
+287: 'b'
+288: 'A'
+289: 'm'
+290: 'E'
+291: 'm'
+292: 'B'
+293: 'm'
+294: 'F'
+295: '#'
+296: 'm'
+297: 'C'
+298: '#'
+299: 'm'
+300: 'G'
+301: '#'
+302: 'm'
+303: 'D'
+304: '#'
+305: 'm'
+306: 'D'
+307: 'm'
+308: 'G'
+309: 'm'
+310: 'C'
+311: 'm'
+312: 'F'
+313: 'm'
+314: 'B'
+315: 'b'
+316: 'm'
+317: 'E'
+318: 'b'
+319: 'm'
+320: '#'
+321: 'b'
+322: 'l'
+323: 'i'
+324: 'n'
+325: 'e'
+326: 'a'
+327: 'r'
+328: 'e'
+329: 'x'
+330: 'p'
+331: 'l'
+332: 'o'
+333: 'g'
+334: 'p'
+335: 'p'
+336: 'p'
+337: 'p'
+338: 'p'
+339: 'p'
+340: 'm'
+341: 'p'
+342: 'm'
+343: 'f'
+344: 'f'
+345: 'f'
+346: 'f'
+347: 'f'
+348: 'f'
+349: 'f'
+350: ' '
+351: '!'
+352: '#'
+353: '+'
+354: '/'
+355: ':'
+356: ' '
+357: '\t'
+358: '\r'
+359: 'a'-'g'
+360: '0'-'9'
+361: '1'-'9'
+362: '0'-'9'
+363: '1'-'9'
+364: '0'-'9'
+365: 'a'-'z'
+366: 'A'-'Z'
+367: 'A'-'G'
+368: '0'-'9'
+369: 'A'-'F'
+370: 'a'-'f'
+371: '#'-'~'
+372: '0'-'9'
+373: \u0000-'\t'
+374: '\v'-\U0010ffff
+375: .
 */
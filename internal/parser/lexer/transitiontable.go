@@ -21,42 +21,60 @@ var TransTab = TransitionTable{
 			return 1
 		case r == 32: // [' ',' ']
 			return 1
-		case r == 35: // ['#','#']
+		case r == 34: // ['"','"']
 			return 3
-		case r == 36: // ['$','$']
+		case r == 35: // ['#','#']
 			return 4
-		case r == 41: // [')',')']
+		case r == 36: // ['$','$']
 			return 5
-		case r == 42: // ['*','*']
+		case r == 38: // ['&','&']
 			return 6
-		case r == 45: // ['-','-']
+		case r == 39: // [''',''']
 			return 7
-		case r == 46: // ['.','.']
+		case r == 41: // [')',')']
 			return 8
-		case r == 47: // ['/','/']
+		case r == 42: // ['*','*']
 			return 9
-		case r == 48: // ['0','0']
+		case r == 44: // [',',',']
 			return 10
-		case 49 <= r && r <= 57: // ['1','9']
+		case r == 45: // ['-','-']
 			return 11
-		case r == 58: // [':',':']
+		case r == 46: // ['.','.']
 			return 12
+		case r == 47: // ['/','/']
+			return 13
+		case r == 48: // ['0','0']
+			return 14
+		case 49 <= r && r <= 57: // ['1','9']
+			return 15
+		case r == 58: // [':',':']
+			return 16
 		case r == 59: // [';',';']
 			return 2
+		case r == 60: // ['<','<']
+			return 17
 		case r == 62: // ['>','>']
-			return 13
+			return 18
+		case r == 63: // ['?','?']
+			return 19
+		case r == 64: // ['@','@']
+			return 20
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 14
+			return 21
 		case r == 91: // ['[','[']
-			return 15
+			return 22
 		case r == 93: // [']',']']
-			return 16
+			return 23
 		case r == 94: // ['^','^']
-			return 17
+			return 24
 		case r == 96: // ['`','`']
-			return 18
+			return 25
 		case 97 <= r && r <= 122: // ['a','z']
-			return 14
+			return 21
+		case r == 123: // ['{','{']
+			return 26
+		case r == 126: // ['~','~']
+			return 27
 		}
 		return NoState
 	},
@@ -75,6 +93,14 @@ var TransTab = TransitionTable{
 	// S3
 	func(r rune) int {
 		switch {
+		case r == 32: // [' ',' ']
+			return 28
+		case r == 33: // ['!','!']
+			return 28
+		case r == 34: // ['"','"']
+			return 29
+		case 35 <= r && r <= 126: // ['#','~']
+			return 28
 		}
 		return NoState
 	},
@@ -93,6 +119,10 @@ var TransTab = TransitionTable{
 	// S6
 	func(r rune) int {
 		switch {
+		case r == 48: // ['0','0']
+			return 30
+		case 49 <= r && r <= 57: // ['1','9']
+			return 31
 		}
 		return NoState
 	},
@@ -111,12 +141,6 @@ var TransTab = TransitionTable{
 	// S9
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 19
-		case r == 51: // ['3','3']
-			return 20
-		case r == 53: // ['5','5']
-			return 20
 		}
 		return NoState
 	},
@@ -129,38 +153,28 @@ var TransTab = TransitionTable{
 	// S11
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 11
+		case r == 62: // ['>','>']
+			return 32
 		}
 		return NoState
 	},
 	// S12
 	func(r rune) int {
 		switch {
-		case r == 97: // ['a','a']
-			return 21
-		case r == 98: // ['b','b']
-			return 22
-		case r == 99: // ['c','c']
-			return 23
-		case r == 101: // ['e','e']
-			return 24
-		case r == 107: // ['k','k']
-			return 25
-		case r == 112: // ['p','p']
-			return 26
-		case r == 115: // ['s','s']
-			return 27
-		case r == 116: // ['t','t']
-			return 28
-		case r == 118: // ['v','v']
-			return 29
 		}
 		return NoState
 	},
 	// S13
 	func(r rune) int {
 		switch {
+		case r == 42: // ['*','*']
+			return 33
+		case r == 47: // ['/','/']
+			return 34
+		case r == 48: // ['0','0']
+			return 35
+		case 49 <= r && r <= 57: // ['1','9']
+			return 36
 		}
 		return NoState
 	},
@@ -173,18 +187,58 @@ var TransTab = TransitionTable{
 	// S15
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 15
 		}
 		return NoState
 	},
 	// S16
 	func(r rune) int {
 		switch {
+		case r == 97: // ['a','a']
+			return 37
+		case r == 98: // ['b','b']
+			return 38
+		case r == 99: // ['c','c']
+			return 39
+		case r == 100: // ['d','d']
+			return 40
+		case r == 101: // ['e','e']
+			return 41
+		case r == 103: // ['g','g']
+			return 42
+		case r == 104: // ['h','h']
+			return 43
+		case r == 105: // ['i','i']
+			return 44
+		case r == 107: // ['k','k']
+			return 45
+		case r == 109: // ['m','m']
+			return 46
+		case r == 110: // ['n','n']
+			return 47
+		case r == 111: // ['o','o']
+			return 48
+		case r == 112: // ['p','p']
+			return 49
+		case r == 114: // ['r','r']
+			return 50
+		case r == 115: // ['s','s']
+			return 51
+		case r == 116: // ['t','t']
+			return 52
+		case r == 118: // ['v','v']
+			return 53
 		}
 		return NoState
 	},
 	// S17
 	func(r rune) int {
 		switch {
+		case 49 <= r && r <= 57: // ['1','9']
+			return 54
+		case 97 <= r && r <= 103: // ['a','g']
+			return 55
 		}
 		return NoState
 	},
@@ -197,408 +251,448 @@ var TransTab = TransitionTable{
 	// S19
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 30
-		default:
-			return 19
+		case r == 48: // ['0','0']
+			return 56
+		case 49 <= r && r <= 57: // ['1','9']
+			return 57
 		}
+		return NoState
 	},
 	// S20
 	func(r rune) int {
 		switch {
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 58
+		case 97 <= r && r <= 122: // ['a','z']
+			return 58
 		}
 		return NoState
 	},
 	// S21
 	func(r rune) int {
 		switch {
-		case r == 115: // ['s','s']
-			return 31
 		}
 		return NoState
 	},
 	// S22
 	func(r rune) int {
 		switch {
-		case r == 97: // ['a','a']
-			return 32
 		}
 		return NoState
 	},
 	// S23
 	func(r rune) int {
 		switch {
-		case r == 104: // ['h','h']
-			return 33
-		case r == 111: // ['o','o']
-			return 34
 		}
 		return NoState
 	},
 	// S24
 	func(r rune) int {
 		switch {
-		case r == 110: // ['n','n']
-			return 35
 		}
 		return NoState
 	},
 	// S25
 	func(r rune) int {
 		switch {
-		case r == 101: // ['e','e']
-			return 36
 		}
 		return NoState
 	},
 	// S26
 	func(r rune) int {
 		switch {
-		case r == 108: // ['l','l']
-			return 37
-		case r == 114: // ['r','r']
-			return 38
+		case r == 35: // ['#','#']
+			return 59
+		case r == 43: // ['+','+']
+			return 59
+		case r == 47: // ['/','/']
+			return 59
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case r == 58: // [':',':']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 60
+		case 97 <= r && r <= 122: // ['a','z']
+			return 60
 		}
 		return NoState
 	},
 	// S27
 	func(r rune) int {
 		switch {
-		case r == 116: // ['t','t']
-			return 39
 		}
 		return NoState
 	},
 	// S28
 	func(r rune) int {
 		switch {
-		case r == 101: // ['e','e']
-			return 40
-		case r == 105: // ['i','i']
-			return 41
+		case r == 32: // [' ',' ']
+			return 28
+		case r == 33: // ['!','!']
+			return 28
+		case r == 34: // ['"','"']
+			return 29
+		case 35 <= r && r <= 126: // ['#','~']
+			return 28
 		}
 		return NoState
 	},
 	// S29
 	func(r rune) int {
 		switch {
-		case r == 101: // ['e','e']
-			return 42
-		case r == 111: // ['o','o']
-			return 43
 		}
 		return NoState
 	},
 	// S30
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 30
-		case r == 47: // ['/','/']
-			return 44
-		default:
-			return 19
 		}
+		return NoState
 	},
 	// S31
 	func(r rune) int {
 		switch {
-		case r == 115: // ['s','s']
-			return 45
+		case 48 <= r && r <= 57: // ['0','9']
+			return 31
 		}
 		return NoState
 	},
 	// S32
 	func(r rune) int {
 		switch {
-		case r == 114: // ['r','r']
-			return 46
 		}
 		return NoState
 	},
 	// S33
 	func(r rune) int {
 		switch {
-		case r == 97: // ['a','a']
-			return 47
+		case r == 42: // ['*','*']
+			return 61
+		default:
+			return 33
 		}
-		return NoState
 	},
 	// S34
 	func(r rune) int {
 		switch {
-		case r == 110: // ['n','n']
-			return 48
+		case 0 <= r && r <= 9: // [\u0000,'\t']
+			return 62
+		case 11 <= r && r <= 1114111: // ['\v',\U0010ffff]
+			return 62
 		}
 		return NoState
 	},
 	// S35
 	func(r rune) int {
 		switch {
-		case r == 100: // ['d','d']
-			return 49
+		case r == 58: // [':',':']
+			return 63
 		}
 		return NoState
 	},
 	// S36
 	func(r rune) int {
 		switch {
-		case r == 121: // ['y','y']
-			return 50
+		case 48 <= r && r <= 57: // ['0','9']
+			return 36
+		case r == 58: // [':',':']
+			return 63
 		}
 		return NoState
 	},
 	// S37
 	func(r rune) int {
 		switch {
-		case r == 97: // ['a','a']
-			return 51
+		case r == 115: // ['s','s']
+			return 64
 		}
 		return NoState
 	},
 	// S38
 	func(r rune) int {
 		switch {
-		case r == 111: // ['o','o']
-			return 52
+		case r == 97: // ['a','a']
+			return 65
+		case r == 101: // ['e','e']
+			return 66
 		}
 		return NoState
 	},
 	// S39
 	func(r rune) int {
 		switch {
-		case r == 97: // ['a','a']
-			return 53
+		case r == 104: // ['h','h']
+			return 67
 		case r == 111: // ['o','o']
-			return 54
+			return 68
+		case r == 114: // ['r','r']
+			return 69
 		}
 		return NoState
 	},
 	// S40
 	func(r rune) int {
 		switch {
-		case r == 109: // ['m','m']
-			return 55
+		case r == 105: // ['i','i']
+			return 70
+		case r == 121: // ['y','y']
+			return 71
 		}
 		return NoState
 	},
 	// S41
 	func(r rune) int {
 		switch {
-		case r == 109: // ['m','m']
-			return 56
+		case r == 110: // ['n','n']
+			return 72
 		}
 		return NoState
 	},
 	// S42
 	func(r rune) int {
 		switch {
-		case r == 108: // ['l','l']
-			return 57
+		case r == 114: // ['r','r']
+			return 73
 		}
 		return NoState
 	},
 	// S43
 	func(r rune) int {
 		switch {
-		case r == 105: // ['i','i']
-			return 58
+		case r == 117: // ['u','u']
+			return 74
 		}
 		return NoState
 	},
 	// S44
 	func(r rune) int {
 		switch {
+		case r == 110: // ['n','n']
+			return 75
 		}
 		return NoState
 	},
 	// S45
 	func(r rune) int {
 		switch {
+		case r == 101: // ['e','e']
+			return 76
 		case r == 105: // ['i','i']
-			return 59
+			return 77
 		}
 		return NoState
 	},
 	// S46
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 60
-		case r == 32: // [' ',' ']
-			return 60
+		case r == 101: // ['e','e']
+			return 78
 		}
 		return NoState
 	},
 	// S47
 	func(r rune) int {
 		switch {
-		case r == 110: // ['n','n']
-			return 61
+		case r == 114: // ['r','r']
+			return 79
 		}
 		return NoState
 	},
 	// S48
 	func(r rune) int {
 		switch {
-		case r == 116: // ['t','t']
-			return 62
+		case r == 99: // ['c','c']
+			return 80
 		}
 		return NoState
 	},
 	// S49
 	func(r rune) int {
 		switch {
+		case r == 105: // ['i','i']
+			return 81
+		case r == 108: // ['l','l']
+			return 82
+		case r == 114: // ['r','r']
+			return 83
 		}
 		return NoState
 	},
 	// S50
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 63
-		case r == 32: // [' ',' ']
-			return 63
+		case r == 101: // ['e','e']
+			return 84
+		case r == 112: // ['p','p']
+			return 85
 		}
 		return NoState
 	},
 	// S51
 	func(r rune) int {
 		switch {
+		case r == 99: // ['c','c']
+			return 86
+		case r == 116: // ['t','t']
+			return 87
+		case r == 119: // ['w','w']
+			return 88
 		case r == 121: // ['y','y']
-			return 64
+			return 89
 		}
 		return NoState
 	},
 	// S52
 	func(r rune) int {
 		switch {
-		case r == 103: // ['g','g']
-			return 65
+		case r == 101: // ['e','e']
+			return 90
+		case r == 105: // ['i','i']
+			return 91
 		}
 		return NoState
 	},
 	// S53
 	func(r rune) int {
 		switch {
-		case r == 114: // ['r','r']
-			return 66
+		case r == 101: // ['e','e']
+			return 92
+		case r == 111: // ['o','o']
+			return 93
 		}
 		return NoState
 	},
 	// S54
 	func(r rune) int {
 		switch {
-		case r == 112: // ['p','p']
-			return 67
+		case 48 <= r && r <= 57: // ['0','9']
+			return 54
+		case r == 62: // ['>','>']
+			return 94
 		}
 		return NoState
 	},
 	// S55
 	func(r rune) int {
 		switch {
-		case r == 112: // ['p','p']
-			return 68
+		case r == 35: // ['#','#']
+			return 95
+		case r == 45: // ['-','-']
+			return 96
+		case 48 <= r && r <= 57: // ['0','9']
+			return 97
+		case r == 98: // ['b','b']
+			return 95
 		}
 		return NoState
 	},
 	// S56
 	func(r rune) int {
 		switch {
-		case r == 101: // ['e','e']
-			return 69
 		}
 		return NoState
 	},
 	// S57
 	func(r rune) int {
 		switch {
-		case r == 111: // ['o','o']
-			return 70
+		case 48 <= r && r <= 57: // ['0','9']
+			return 57
 		}
 		return NoState
 	},
 	// S58
 	func(r rune) int {
 		switch {
-		case r == 99: // ['c','c']
-			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 58
+		case r == 95: // ['_','_']
+			return 98
+		case 97 <= r && r <= 122: // ['a','z']
+			return 58
 		}
 		return NoState
 	},
 	// S59
 	func(r rune) int {
 		switch {
-		case r == 103: // ['g','g']
-			return 72
+		case r == 35: // ['#','#']
+			return 59
+		case r == 43: // ['+','+']
+			return 59
+		case r == 47: // ['/','/']
+			return 59
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case r == 58: // [':',':']
+			return 59
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 60
+		case 97 <= r && r <= 122: // ['a','z']
+			return 60
+		case r == 125: // ['}','}']
+			return 99
 		}
 		return NoState
 	},
 	// S60
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 60
-		case r == 32: // [' ',' ']
-			return 60
-		case r == 48: // ['0','0']
-			return 73
-		case 49 <= r && r <= 57: // ['1','9']
-			return 74
+		case r == 35: // ['#','#']
+			return 59
+		case r == 43: // ['+','+']
+			return 59
+		case r == 47: // ['/','/']
+			return 59
+		case 48 <= r && r <= 57: // ['0','9']
+			return 59
+		case r == 58: // [':',':']
+			return 59
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 75
+			return 60
 		case 97 <= r && r <= 122: // ['a','z']
-			return 75
+			return 60
+		case r == 125: // ['}','}']
+			return 99
 		}
 		return NoState
 	},
 	// S61
 	func(r rune) int {
 		switch {
-		case r == 110: // ['n','n']
-			return 76
+		case r == 42: // ['*','*']
+			return 61
+		case r == 47: // ['/','/']
+			return 100
+		default:
+			return 33
 		}
-		return NoState
 	},
 	// S62
 	func(r rune) int {
 		switch {
-		case r == 114: // ['r','r']
-			return 77
+		case 0 <= r && r <= 9: // [\u0000,'\t']
+			return 62
+		case 11 <= r && r <= 1114111: // ['\v',\U0010ffff]
+			return 62
 		}
 		return NoState
 	},
 	// S63
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 63
-		case r == 32: // [' ',' ']
-			return 63
-		case r == 65: // ['A','A']
-			return 78
-		case r == 66: // ['B','B']
-			return 79
-		case r == 67: // ['C','C']
-			return 80
-		case r == 68: // ['D','D']
-			return 81
-		case r == 69: // ['E','E']
-			return 82
-		case r == 70: // ['F','F']
-			return 83
-		case r == 71: // ['G','G']
-			return 84
+		case r == 48: // ['0','0']
+			return 101
+		case 49 <= r && r <= 57: // ['1','9']
+			return 102
 		}
 		return NoState
 	},
 	// S64
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 85
-		case r == 32: // [' ',' ']
-			return 85
+		case r == 115: // ['s','s']
+			return 103
 		}
 		return NoState
 	},
@@ -606,207 +700,169 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 114: // ['r','r']
-			return 86
+			return 104
 		}
 		return NoState
 	},
 	// S66
 	func(r rune) int {
 		switch {
-		case r == 116: // ['t','t']
-			return 87
+		case r == 110: // ['n','n']
+			return 105
 		}
 		return NoState
 	},
 	// S67
 	func(r rune) int {
 		switch {
+		case r == 97: // ['a','a']
+			return 106
 		}
 		return NoState
 	},
 	// S68
 	func(r rune) int {
 		switch {
-		case r == 111: // ['o','o']
-			return 88
+		case r == 110: // ['n','n']
+			return 107
 		}
 		return NoState
 	},
 	// S69
 	func(r rune) int {
 		switch {
+		case r == 101: // ['e','e']
+			return 108
 		}
 		return NoState
 	},
 	// S70
 	func(r rune) int {
 		switch {
-		case r == 99: // ['c','c']
-			return 89
+		case r == 109: // ['m','m']
+			return 109
 		}
 		return NoState
 	},
 	// S71
 	func(r rune) int {
 		switch {
-		case r == 101: // ['e','e']
-			return 90
+		case r == 110: // ['n','n']
+			return 110
 		}
 		return NoState
 	},
 	// S72
 	func(r rune) int {
 		switch {
-		case r == 110: // ['n','n']
-			return 91
+		case r == 100: // ['d','d']
+			return 111
 		}
 		return NoState
 	},
 	// S73
 	func(r rune) int {
 		switch {
-		case r == 48: // ['0','0']
-			return 73
-		case 49 <= r && r <= 57: // ['1','9']
-			return 92
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 75
-		case 97 <= r && r <= 122: // ['a','z']
-			return 75
+		case r == 111: // ['o','o']
+			return 112
 		}
 		return NoState
 	},
 	// S74
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 74
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 75
-		case 97 <= r && r <= 122: // ['a','z']
-			return 75
+		case r == 109: // ['m','m']
+			return 113
 		}
 		return NoState
 	},
 	// S75
 	func(r rune) int {
 		switch {
-		case r == 48: // ['0','0']
-			return 73
-		case 49 <= r && r <= 57: // ['1','9']
-			return 92
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 75
-		case 97 <= r && r <= 122: // ['a','z']
-			return 75
+		case r == 99: // ['c','c']
+			return 114
 		}
 		return NoState
 	},
 	// S76
 	func(r rune) int {
 		switch {
-		case r == 101: // ['e','e']
-			return 93
+		case r == 121: // ['y','y']
+			return 115
 		}
 		return NoState
 	},
 	// S77
 	func(r rune) int {
 		switch {
-		case r == 111: // ['o','o']
-			return 94
+		case r == 116: // ['t','t']
+			return 116
 		}
 		return NoState
 	},
 	// S78
 	func(r rune) int {
 		switch {
-		case r == 98: // ['b','b']
-			return 95
-		case r == 109: // ['m','m']
-			return 96
+		case r == 116: // ['t','t']
+			return 117
 		}
 		return NoState
 	},
 	// S79
 	func(r rune) int {
 		switch {
-		case r == 98: // ['b','b']
-			return 97
-		case r == 109: // ['m','m']
-			return 96
+		case r == 112: // ['p','p']
+			return 118
 		}
 		return NoState
 	},
 	// S80
 	func(r rune) int {
 		switch {
-		case r == 35: // ['#','#']
-			return 98
-		case r == 109: // ['m','m']
-			return 99
+		case r == 116: // ['t','t']
+			return 119
 		}
 		return NoState
 	},
 	// S81
 	func(r rune) int {
 		switch {
-		case r == 35: // ['#','#']
-			return 100
-		case r == 98: // ['b','b']
-			return 95
-		case r == 109: // ['m','m']
-			return 99
+		case r == 99: // ['c','c']
+			return 120
 		}
 		return NoState
 	},
 	// S82
 	func(r rune) int {
 		switch {
-		case r == 98: // ['b','b']
-			return 101
-		case r == 109: // ['m','m']
-			return 96
+		case r == 97: // ['a','a']
+			return 121
 		}
 		return NoState
 	},
 	// S83
 	func(r rune) int {
 		switch {
-		case r == 35: // ['#','#']
-			return 102
-		case r == 109: // ['m','m']
-			return 99
+		case r == 101: // ['e','e']
+			return 122
+		case r == 111: // ['o','o']
+			return 123
 		}
 		return NoState
 	},
 	// S84
 	func(r rune) int {
 		switch {
-		case r == 35: // ['#','#']
-			return 103
-		case r == 98: // ['b','b']
-			return 95
-		case r == 109: // ['m','m']
-			return 99
+		case r == 112: // ['p','p']
+			return 124
 		}
 		return NoState
 	},
 	// S85
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
-			return 85
-		case r == 32: // [' ',' ']
-			return 85
-		case r == 48: // ['0','0']
-			return 104
-		case 49 <= r && r <= 57: // ['1','9']
-			return 105
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 106
-		case 97 <= r && r <= 122: // ['a','z']
-			return 106
+		case r == 110: // ['n','n']
+			return 125
 		}
 		return NoState
 	},
@@ -814,95 +870,111 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 97: // ['a','a']
-			return 107
+			return 126
 		}
 		return NoState
 	},
 	// S87
 	func(r rune) int {
 		switch {
+		case r == 97: // ['a','a']
+			return 127
+		case r == 111: // ['o','o']
+			return 128
 		}
 		return NoState
 	},
 	// S88
 	func(r rune) int {
 		switch {
+		case r == 105: // ['i','i']
+			return 129
 		}
 		return NoState
 	},
 	// S89
 	func(r rune) int {
 		switch {
-		case r == 105: // ['i','i']
-			return 108
+		case r == 115: // ['s','s']
+			return 130
 		}
 		return NoState
 	},
 	// S90
 	func(r rune) int {
 		switch {
+		case r == 109: // ['m','m']
+			return 131
 		}
 		return NoState
 	},
 	// S91
 	func(r rune) int {
 		switch {
+		case r == 109: // ['m','m']
+			return 132
 		}
 		return NoState
 	},
 	// S92
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 92
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 75
-		case 97 <= r && r <= 122: // ['a','z']
-			return 75
+		case r == 108: // ['l','l']
+			return 133
 		}
 		return NoState
 	},
 	// S93
 	func(r rune) int {
 		switch {
+		case r == 105: // ['i','i']
+			return 134
 		case r == 108: // ['l','l']
-			return 109
+			return 135
 		}
 		return NoState
 	},
 	// S94
 	func(r rune) int {
 		switch {
-		case r == 108: // ['l','l']
-			return 110
 		}
 		return NoState
 	},
 	// S95
 	func(r rune) int {
 		switch {
+		case r == 45: // ['-','-']
+			return 96
+		case 48 <= r && r <= 57: // ['0','9']
+			return 97
 		}
 		return NoState
 	},
 	// S96
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 97
 		}
 		return NoState
 	},
 	// S97
 	func(r rune) int {
 		switch {
-		case r == 109: // ['m','m']
-			return 99
+		case r == 62: // ['>','>']
+			return 136
 		}
 		return NoState
 	},
 	// S98
 	func(r rune) int {
 		switch {
-		case r == 109: // ['m','m']
-			return 96
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 58
+		case r == 95: // ['_','_']
+			return 98
+		case 97 <= r && r <= 122: // ['a','z']
+			return 58
 		}
 		return NoState
 	},
@@ -915,132 +987,3452 @@ var TransTab = TransitionTable{
 	// S100
 	func(r rune) int {
 		switch {
-		case r == 109: // ['m','m']
-			return 96
 		}
 		return NoState
 	},
 	// S101
 	func(r rune) int {
 		switch {
-		case r == 109: // ['m','m']
-			return 99
 		}
 		return NoState
 	},
 	// S102
 	func(r rune) int {
 		switch {
-		case r == 109: // ['m','m']
-			return 96
+		case 48 <= r && r <= 57: // ['0','9']
+			return 102
 		}
 		return NoState
 	},
 	// S103
 	func(r rune) int {
 		switch {
-		case r == 109: // ['m','m']
-			return 96
+		case r == 105: // ['i','i']
+			return 137
 		}
 		return NoState
 	},
 	// S104
 	func(r rune) int {
 		switch {
-		case r == 48: // ['0','0']
-			return 104
-		case 49 <= r && r <= 57: // ['1','9']
-			return 111
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 106
-		case 97 <= r && r <= 122: // ['a','z']
-			return 106
+		case r == 9: // ['\t','\t']
+			return 138
+		case r == 32: // [' ',' ']
+			return 138
 		}
 		return NoState
 	},
 	// S105
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 105
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 106
-		case 97 <= r && r <= 122: // ['a','z']
-			return 106
+		case r == 100: // ['d','d']
+			return 139
 		}
 		return NoState
 	},
 	// S106
 	func(r rune) int {
 		switch {
-		case r == 48: // ['0','0']
-			return 104
-		case 49 <= r && r <= 57: // ['1','9']
-			return 111
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 106
-		case 97 <= r && r <= 122: // ['a','z']
-			return 106
+		case r == 110: // ['n','n']
+			return 140
 		}
 		return NoState
 	},
 	// S107
 	func(r rune) int {
 		switch {
-		case r == 109: // ['m','m']
-			return 112
+		case r == 116: // ['t','t']
+			return 141
 		}
 		return NoState
 	},
 	// S108
 	func(r rune) int {
 		switch {
-		case r == 116: // ['t','t']
-			return 113
+		case r == 115: // ['s','s']
+			return 142
 		}
 		return NoState
 	},
 	// S109
 	func(r rune) int {
 		switch {
+		case r == 9: // ['\t','\t']
+			return 143
+		case r == 32: // [' ',' ']
+			return 143
 		}
 		return NoState
 	},
 	// S110
 	func(r rune) int {
 		switch {
+		case r == 9: // ['\t','\t']
+			return 144
+		case r == 32: // [' ',' ']
+			return 144
+		case r == 97: // ['a','a']
+			return 145
 		}
 		return NoState
 	},
 	// S111
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 111
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 106
-		case 97 <= r && r <= 122: // ['a','z']
-			return 106
 		}
 		return NoState
 	},
 	// S112
 	func(r rune) int {
 		switch {
+		case r == 111: // ['o','o']
+			return 146
 		}
 		return NoState
 	},
 	// S113
 	func(r rune) int {
 		switch {
-		case r == 121: // ['y','y']
-			return 114
+		case r == 97: // ['a','a']
+			return 147
 		}
 		return NoState
 	},
 	// S114
 	func(r rune) int {
 		switch {
+		case r == 108: // ['l','l']
+			return 148
+		}
+		return NoState
+	},
+	// S115
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 149
+		case r == 32: // [' ',' ']
+			return 149
+		}
+		return NoState
+	},
+	// S116
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 150
+		case r == 32: // [' ',' ']
+			return 150
+		}
+		return NoState
+	},
+	// S117
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 151
+		}
+		return NoState
+	},
+	// S118
+	func(r rune) int {
+		switch {
+		case r == 110: // ['n','n']
+			return 152
+		}
+		return NoState
+	},
+	// S119
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 153
+		}
+		return NoState
+	},
+	// S120
+	func(r rune) int {
+		switch {
+		case r == 107: // ['k','k']
+			return 154
+		}
+		return NoState
+	},
+	// S121
+	func(r rune) int {
+		switch {
+		case r == 121: // ['y','y']
+			return 155
+		}
+		return NoState
+	},
+	// S122
+	func(r rune) int {
+		switch {
+		case r == 115: // ['s','s']
+			return 156
+		}
+		return NoState
+	},
+	// S123
+	func(r rune) int {
+		switch {
+		case r == 103: // ['g','g']
+			return 157
+		}
+		return NoState
+	},
+	// S124
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 158
+		}
+		return NoState
+	},
+	// S125
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S126
+	func(r rune) int {
+		switch {
+		case r == 108: // ['l','l']
+			return 159
+		}
+		return NoState
+	},
+	// S127
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 160
+		case r == 114: // ['r','r']
+			return 161
+		}
+		return NoState
+	},
+	// S128
+	func(r rune) int {
+		switch {
+		case r == 112: // ['p','p']
+			return 162
+		}
+		return NoState
+	},
+	// S129
+	func(r rune) int {
+		switch {
+		case r == 110: // ['n','n']
+			return 163
+		}
+		return NoState
+	},
+	// S130
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 164
+		}
+		return NoState
+	},
+	// S131
+	func(r rune) int {
+		switch {
+		case r == 112: // ['p','p']
+			return 165
+		}
+		return NoState
+	},
+	// S132
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 166
+		}
+		return NoState
+	},
+	// S133
+	func(r rune) int {
+		switch {
+		case r == 111: // ['o','o']
+			return 167
+		}
+		return NoState
+	},
+	// S134
+	func(r rune) int {
+		switch {
+		case r == 99: // ['c','c']
+			return 168
+		}
+		return NoState
+	},
+	// S135
+	func(r rune) int {
+		switch {
+		case r == 116: // ['t','t']
+			return 169
+		}
+		return NoState
+	},
+	// S136
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S137
+	func(r rune) int {
+		switch {
+		case r == 103: // ['g','g']
+			return 170
+		}
+		return NoState
+	},
+	// S138
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 138
+		case r == 32: // [' ',' ']
+			return 138
+		case r == 48: // ['0','0']
+			return 171
+		case 49 <= r && r <= 57: // ['1','9']
+			return 172
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 173
+		case 97 <= r && r <= 122: // ['a','z']
+			return 173
+		}
+		return NoState
+	},
+	// S139
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 174
+		case r == 32: // [' ',' ']
+			return 174
+		}
+		return NoState
+	},
+	// S140
+	func(r rune) int {
+		switch {
+		case r == 110: // ['n','n']
+			return 175
+		}
+		return NoState
+	},
+	// S141
+	func(r rune) int {
+		switch {
+		case r == 114: // ['r','r']
+			return 176
+		}
+		return NoState
+	},
+	// S142
+	func(r rune) int {
+		switch {
+		case r == 99: // ['c','c']
+			return 177
+		}
+		return NoState
+	},
+	// S143
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 143
+		case r == 32: // [' ',' ']
+			return 143
+		case r == 102: // ['f','f']
+			return 178
+		case r == 109: // ['m','m']
+			return 179
+		case r == 112: // ['p','p']
+			return 180
+		}
+		return NoState
+	},
+	// S144
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 144
+		case r == 32: // [' ',' ']
+			return 144
+		case r == 102: // ['f','f']
+			return 181
+		case r == 109: // ['m','m']
+			return 182
+		case r == 112: // ['p','p']
+			return 183
+		}
+		return NoState
+	},
+	// S145
+	func(r rune) int {
+		switch {
+		case r == 109: // ['m','m']
+			return 184
+		}
+		return NoState
+	},
+	// S146
+	func(r rune) int {
+		switch {
+		case r == 118: // ['v','v']
+			return 185
+		}
+		return NoState
+	},
+	// S147
+	func(r rune) int {
+		switch {
+		case r == 110: // ['n','n']
+			return 186
+		}
+		return NoState
+	},
+	// S148
+	func(r rune) int {
+		switch {
+		case r == 117: // ['u','u']
+			return 187
+		}
+		return NoState
+	},
+	// S149
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 149
+		case r == 32: // [' ',' ']
+			return 149
+		case r == 65: // ['A','A']
+			return 188
+		case r == 66: // ['B','B']
+			return 189
+		case r == 67: // ['C','C']
+			return 190
+		case r == 68: // ['D','D']
+			return 191
+		case r == 69: // ['E','E']
+			return 192
+		case r == 70: // ['F','F']
+			return 193
+		case r == 71: // ['G','G']
+			return 194
+		}
+		return NoState
+	},
+	// S150
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 150
+		case r == 32: // [' ',' ']
+			return 150
+		case r == 48: // ['0','0']
+			return 195
+		case 49 <= r && r <= 57: // ['1','9']
+			return 196
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 197
+		case 97 <= r && r <= 122: // ['a','z']
+			return 197
+		}
+		return NoState
+	},
+	// S151
+	func(r rune) int {
+		switch {
+		case r == 114: // ['r','r']
+			return 198
+		}
+		return NoState
+	},
+	// S152
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S153
+	func(r rune) int {
+		switch {
+		case r == 118: // ['v','v']
+			return 199
+		}
+		return NoState
+	},
+	// S154
+	func(r rune) int {
+		switch {
+		case r == 117: // ['u','u']
+			return 200
+		}
+		return NoState
+	},
+	// S155
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 201
+		case r == 32: // [' ',' ']
+			return 201
+		}
+		return NoState
+	},
+	// S156
+	func(r rune) int {
+		switch {
+		case r == 115: // ['s','s']
+			return 202
+		}
+		return NoState
+	},
+	// S157
+	func(r rune) int {
+		switch {
+		case r == 114: // ['r','r']
+			return 203
+		}
+		return NoState
+	},
+	// S158
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 204
+		}
+		return NoState
+	},
+	// S159
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 205
+		}
+		return NoState
+	},
+	// S160
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 206
+		}
+		return NoState
+	},
+	// S161
+	func(r rune) int {
+		switch {
+		case r == 116: // ['t','t']
+			return 207
+		}
+		return NoState
+	},
+	// S162
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S163
+	func(r rune) int {
+		switch {
+		case r == 103: // ['g','g']
+			return 208
+		}
+		return NoState
+	},
+	// S164
+	func(r rune) int {
+		switch {
+		case r == 120: // ['x','x']
+			return 209
+		}
+		return NoState
+	},
+	// S165
+	func(r rune) int {
+		switch {
+		case r == 111: // ['o','o']
+			return 210
+		}
+		return NoState
+	},
+	// S166
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S167
+	func(r rune) int {
+		switch {
+		case r == 99: // ['c','c']
+			return 211
+		}
+		return NoState
+	},
+	// S168
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 212
+		}
+		return NoState
+	},
+	// S169
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 213
+		}
+		return NoState
+	},
+	// S170
+	func(r rune) int {
+		switch {
+		case r == 110: // ['n','n']
+			return 214
+		}
+		return NoState
+	},
+	// S171
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 171
+		case 49 <= r && r <= 57: // ['1','9']
+			return 215
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 173
+		case 97 <= r && r <= 122: // ['a','z']
+			return 173
+		}
+		return NoState
+	},
+	// S172
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 172
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 173
+		case 97 <= r && r <= 122: // ['a','z']
+			return 173
+		}
+		return NoState
+	},
+	// S173
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 171
+		case 49 <= r && r <= 57: // ['1','9']
+			return 215
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 173
+		case 97 <= r && r <= 122: // ['a','z']
+			return 173
+		}
+		return NoState
+	},
+	// S174
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 174
+		case r == 32: // [' ',' ']
+			return 174
+		case r == 43: // ['+','+']
+			return 216
+		case r == 45: // ['-','-']
+			return 216
+		case r == 48: // ['0','0']
+			return 217
+		case 49 <= r && r <= 57: // ['1','9']
+			return 218
+		}
+		return NoState
+	},
+	// S175
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 219
+		}
+		return NoState
+	},
+	// S176
+	func(r rune) int {
+		switch {
+		case r == 111: // ['o','o']
+			return 220
+		}
+		return NoState
+	},
+	// S177
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 221
+		case r == 32: // [' ',' ']
+			return 221
+		}
+		return NoState
+	},
+	// S178
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 222
+		case r == 32: // [' ',' ']
+			return 222
+		case r == 102: // ['f','f']
+			return 223
+		}
+		return NoState
+	},
+	// S179
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 224
+		case r == 112: // ['p','p']
+			return 224
+		}
+		return NoState
+	},
+	// S180
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 222
+		case r == 32: // [' ',' ']
+			return 222
+		case r == 112: // ['p','p']
+			return 225
+		}
+		return NoState
+	},
+	// S181
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 226
+		}
+		return NoState
+	},
+	// S182
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 227
+		case r == 112: // ['p','p']
+			return 227
+		}
+		return NoState
+	},
+	// S183
+	func(r rune) int {
+		switch {
+		case r == 112: // ['p','p']
+			return 228
+		}
+		return NoState
+	},
+	// S184
+	func(r rune) int {
+		switch {
+		case r == 105: // ['i','i']
+			return 229
+		}
+		return NoState
+	},
+	// S185
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 230
+		}
+		return NoState
+	},
+	// S186
+	func(r rune) int {
+		switch {
+		case r == 105: // ['i','i']
+			return 231
+		}
+		return NoState
+	},
+	// S187
+	func(r rune) int {
+		switch {
+		case r == 100: // ['d','d']
+			return 232
+		}
+		return NoState
+	},
+	// S188
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 35: // ['#','#']
+			return 234
+		case r == 98: // ['b','b']
+			return 235
+		case r == 109: // ['m','m']
+			return 236
+		}
+		return NoState
+	},
+	// S189
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 35: // ['#','#']
+			return 234
+		case r == 98: // ['b','b']
+			return 237
+		case r == 109: // ['m','m']
+			return 236
+		}
+		return NoState
+	},
+	// S190
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 35: // ['#','#']
+			return 238
+		case r == 98: // ['b','b']
+			return 234
+		case r == 109: // ['m','m']
+			return 239
+		}
+		return NoState
+	},
+	// S191
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 35: // ['#','#']
+			return 240
+		case r == 98: // ['b','b']
+			return 235
+		case r == 109: // ['m','m']
+			return 239
+		}
+		return NoState
+	},
+	// S192
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 35: // ['#','#']
+			return 234
+		case r == 98: // ['b','b']
+			return 241
+		case r == 109: // ['m','m']
+			return 236
+		}
+		return NoState
+	},
+	// S193
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 35: // ['#','#']
+			return 242
+		case r == 98: // ['b','b']
+			return 234
+		case r == 109: // ['m','m']
+			return 239
+		}
+		return NoState
+	},
+	// S194
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 35: // ['#','#']
+			return 243
+		case r == 98: // ['b','b']
+			return 235
+		case r == 109: // ['m','m']
+			return 239
+		}
+		return NoState
+	},
+	// S195
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 195
+		case 49 <= r && r <= 57: // ['1','9']
+			return 244
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 197
+		case 97 <= r && r <= 122: // ['a','z']
+			return 197
+		}
+		return NoState
+	},
+	// S196
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 196
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 197
+		case 97 <= r && r <= 122: // ['a','z']
+			return 197
+		}
+		return NoState
+	},
+	// S197
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 195
+		case 49 <= r && r <= 57: // ['1','9']
+			return 244
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 197
+		case 97 <= r && r <= 122: // ['a','z']
+			return 197
+		}
+		return NoState
+	},
+	// S198
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S199
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 245
+		}
+		return NoState
+	},
+	// S200
+	func(r rune) int {
+		switch {
+		case r == 112: // ['p','p']
+			return 246
+		}
+		return NoState
+	},
+	// S201
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 201
+		case r == 32: // [' ',' ']
+			return 201
+		case r == 48: // ['0','0']
+			return 247
+		case 49 <= r && r <= 57: // ['1','9']
+			return 248
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 249
+		case 97 <= r && r <= 122: // ['a','z']
+			return 249
+		}
+		return NoState
+	},
+	// S202
+	func(r rune) int {
+		switch {
+		case r == 117: // ['u','u']
+			return 250
+		}
+		return NoState
+	},
+	// S203
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 251
+		}
+		return NoState
+	},
+	// S204
+	func(r rune) int {
+		switch {
+		case r == 116: // ['t','t']
+			return 252
+		}
+		return NoState
+	},
+	// S205
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 253
+		case r == 32: // [' ',' ']
+			return 253
+		}
+		return NoState
+	},
+	// S206
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 254
+		case r == 32: // [' ',' ']
+			return 254
+		}
+		return NoState
+	},
+	// S207
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S208
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 255
+		case r == 32: // [' ',' ']
+			return 255
+		}
+		return NoState
+	},
+	// S209
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 256
+		case r == 32: // [' ',' ']
+			return 256
+		}
+		return NoState
+	},
+	// S210
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S211
+	func(r rune) int {
+		switch {
+		case r == 105: // ['i','i']
+			return 257
+		}
+		return NoState
+	},
+	// S212
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S213
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S214
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S215
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 215
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 173
+		case 97 <= r && r <= 122: // ['a','z']
+			return 173
+		}
+		return NoState
+	},
+	// S216
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 217
+		case 49 <= r && r <= 57: // ['1','9']
+			return 218
+		}
+		return NoState
+	},
+	// S217
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S218
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 218
+		}
+		return NoState
+	},
+	// S219
+	func(r rune) int {
+		switch {
+		case r == 108: // ['l','l']
+			return 258
+		}
+		return NoState
+	},
+	// S220
+	func(r rune) int {
+		switch {
+		case r == 108: // ['l','l']
+			return 259
+		}
+		return NoState
+	},
+	// S221
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 221
+		case r == 32: // [' ',' ']
+			return 221
+		case r == 102: // ['f','f']
+			return 260
+		case r == 109: // ['m','m']
+			return 261
+		case r == 112: // ['p','p']
+			return 262
+		}
+		return NoState
+	},
+	// S222
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 222
+		case r == 32: // [' ',' ']
+			return 222
+		case r == 48: // ['0','0']
+			return 263
+		case 49 <= r && r <= 57: // ['1','9']
+			return 264
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 265
+		case 97 <= r && r <= 122: // ['a','z']
+			return 265
+		}
+		return NoState
+	},
+	// S223
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 222
+		case r == 32: // [' ',' ']
+			return 222
+		case r == 102: // ['f','f']
+			return 224
+		}
+		return NoState
+	},
+	// S224
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 222
+		case r == 32: // [' ',' ']
+			return 222
+		}
+		return NoState
+	},
+	// S225
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 222
+		case r == 32: // [' ',' ']
+			return 222
+		case r == 112: // ['p','p']
+			return 224
+		}
+		return NoState
+	},
+	// S226
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 227
+		}
+		return NoState
+	},
+	// S227
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S228
+	func(r rune) int {
+		switch {
+		case r == 112: // ['p','p']
+			return 227
+		}
+		return NoState
+	},
+	// S229
+	func(r rune) int {
+		switch {
+		case r == 99: // ['c','c']
+			return 266
+		}
+		return NoState
+	},
+	// S230
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 267
+		case r == 32: // [' ',' ']
+			return 267
+		}
+		return NoState
+	},
+	// S231
+	func(r rune) int {
+		switch {
+		case r == 122: // ['z','z']
+			return 268
+		}
+		return NoState
+	},
+	// S232
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 269
+		}
+		return NoState
+	},
+	// S233
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 48: // ['0','0']
+			return 270
+		case 49 <= r && r <= 57: // ['1','9']
+			return 271
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 272
+		case 97 <= r && r <= 122: // ['a','z']
+			return 272
+		}
+		return NoState
+	},
+	// S234
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 273
+		case r == 32: // [' ',' ']
+			return 273
+		}
+		return NoState
+	},
+	// S235
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		}
+		return NoState
+	},
+	// S236
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 274
+		case r == 32: // [' ',' ']
+			return 274
+		}
+		return NoState
+	},
+	// S237
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 109: // ['m','m']
+			return 239
+		}
+		return NoState
+	},
+	// S238
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 273
+		case r == 32: // [' ',' ']
+			return 273
+		case r == 109: // ['m','m']
+			return 236
+		}
+		return NoState
+	},
+	// S239
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 274
+		case r == 32: // [' ',' ']
+			return 274
+		}
+		return NoState
+	},
+	// S240
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 273
+		case r == 32: // [' ',' ']
+			return 273
+		case r == 109: // ['m','m']
+			return 236
+		}
+		return NoState
+	},
+	// S241
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 109: // ['m','m']
+			return 239
+		}
+		return NoState
+	},
+	// S242
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 233
+		case r == 32: // [' ',' ']
+			return 233
+		case r == 109: // ['m','m']
+			return 236
+		}
+		return NoState
+	},
+	// S243
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 273
+		case r == 32: // [' ',' ']
+			return 273
+		case r == 109: // ['m','m']
+			return 236
+		}
+		return NoState
+	},
+	// S244
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 244
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 197
+		case 97 <= r && r <= 122: // ['a','z']
+			return 197
+		}
+		return NoState
+	},
+	// S245
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 275
+		case r == 32: // [' ',' ']
+			return 275
+		}
+		return NoState
+	},
+	// S246
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S247
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 276
+		case r == 32: // [' ',' ']
+			return 276
+		case r == 48: // ['0','0']
+			return 247
+		case 49 <= r && r <= 57: // ['1','9']
+			return 277
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 249
+		case 97 <= r && r <= 122: // ['a','z']
+			return 249
+		}
+		return NoState
+	},
+	// S248
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 276
+		case r == 32: // [' ',' ']
+			return 276
+		case 48 <= r && r <= 57: // ['0','9']
+			return 248
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 249
+		case 97 <= r && r <= 122: // ['a','z']
+			return 249
+		}
+		return NoState
+	},
+	// S249
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 276
+		case r == 32: // [' ',' ']
+			return 276
+		case r == 48: // ['0','0']
+			return 247
+		case 49 <= r && r <= 57: // ['1','9']
+			return 277
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 249
+		case 97 <= r && r <= 122: // ['a','z']
+			return 249
+		}
+		return NoState
+	},
+	// S250
+	func(r rune) int {
+		switch {
+		case r == 114: // ['r','r']
+			return 278
+		}
+		return NoState
+	},
+	// S251
+	func(r rune) int {
+		switch {
+		case r == 109: // ['m','m']
+			return 279
+		}
+		return NoState
+	},
+	// S252
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S253
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 253
+		case r == 32: // [' ',' ']
+			return 253
+		case r == 48: // ['0','0']
+			return 280
+		case 49 <= r && r <= 57: // ['1','9']
+			return 281
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 282
+		case 97 <= r && r <= 122: // ['a','z']
+			return 282
+		}
+		return NoState
+	},
+	// S254
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 254
+		case r == 32: // [' ',' ']
+			return 254
+		case r == 111: // ['o','o']
+			return 283
+		}
+		return NoState
+	},
+	// S255
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 255
+		case r == 32: // [' ',' ']
+			return 255
+		case r == 48: // ['0','0']
+			return 284
+		case 49 <= r && r <= 57: // ['1','9']
+			return 285
+		}
+		return NoState
+	},
+	// S256
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 256
+		case r == 32: // [' ',' ']
+			return 256
+		case 48 <= r && r <= 57: // ['0','9']
+			return 286
+		case 65 <= r && r <= 70: // ['A','F']
+			return 286
+		case 97 <= r && r <= 102: // ['a','f']
+			return 286
+		}
+		return NoState
+	},
+	// S257
+	func(r rune) int {
+		switch {
+		case r == 116: // ['t','t']
+			return 287
+		}
+		return NoState
+	},
+	// S258
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S259
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 288
+		case r == 32: // [' ',' ']
+			return 288
+		}
+		return NoState
+	},
+	// S260
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 289
+		case r == 32: // [' ',' ']
+			return 289
+		case r == 102: // ['f','f']
+			return 290
+		}
+		return NoState
+	},
+	// S261
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 291
+		case r == 112: // ['p','p']
+			return 291
+		}
+		return NoState
+	},
+	// S262
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 289
+		case r == 32: // [' ',' ']
+			return 289
+		case r == 112: // ['p','p']
+			return 292
+		}
+		return NoState
+	},
+	// S263
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 263
+		case 49 <= r && r <= 57: // ['1','9']
+			return 293
+		case r == 61: // ['=','=']
+			return 294
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 265
+		case 97 <= r && r <= 122: // ['a','z']
+			return 265
+		}
+		return NoState
+	},
+	// S264
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 264
+		case r == 61: // ['=','=']
+			return 294
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 265
+		case 97 <= r && r <= 122: // ['a','z']
+			return 265
+		}
+		return NoState
+	},
+	// S265
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 263
+		case 49 <= r && r <= 57: // ['1','9']
+			return 293
+		case r == 61: // ['=','=']
+			return 294
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 265
+		case 97 <= r && r <= 122: // ['a','z']
+			return 265
+		}
+		return NoState
+	},
+	// S266
+	func(r rune) int {
+		switch {
+		case r == 115: // ['s','s']
+			return 295
+		}
+		return NoState
+	},
+	// S267
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 267
+		case r == 32: // [' ',' ']
+			return 267
+		case r == 48: // ['0','0']
+			return 296
+		case 49 <= r && r <= 57: // ['1','9']
+			return 297
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 298
+		case 97 <= r && r <= 122: // ['a','z']
+			return 298
+		}
+		return NoState
+	},
+	// S268
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 299
+		}
+		return NoState
+	},
+	// S269
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S270
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 300
+		case r == 32: // [' ',' ']
+			return 300
+		case r == 48: // ['0','0']
+			return 270
+		case 49 <= r && r <= 57: // ['1','9']
+			return 301
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 272
+		case 97 <= r && r <= 122: // ['a','z']
+			return 272
+		}
+		return NoState
+	},
+	// S271
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 300
+		case r == 32: // [' ',' ']
+			return 300
+		case 48 <= r && r <= 57: // ['0','9']
+			return 271
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 272
+		case 97 <= r && r <= 122: // ['a','z']
+			return 272
+		}
+		return NoState
+	},
+	// S272
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 300
+		case r == 32: // [' ',' ']
+			return 300
+		case r == 48: // ['0','0']
+			return 270
+		case 49 <= r && r <= 57: // ['1','9']
+			return 301
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 272
+		case 97 <= r && r <= 122: // ['a','z']
+			return 272
+		}
+		return NoState
+	},
+	// S273
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 273
+		case r == 32: // [' ',' ']
+			return 273
+		case r == 48: // ['0','0']
+			return 270
+		case 49 <= r && r <= 57: // ['1','9']
+			return 271
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 272
+		case 97 <= r && r <= 122: // ['a','z']
+			return 272
+		}
+		return NoState
+	},
+	// S274
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 274
+		case r == 32: // [' ',' ']
+			return 274
+		}
+		return NoState
+	},
+	// S275
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 275
+		case r == 32: // [' ',' ']
+			return 275
+		case r == 43: // ['+','+']
+			return 302
+		case r == 45: // ['-','-']
+			return 302
+		case r == 48: // ['0','0']
+			return 303
+		case 49 <= r && r <= 57: // ['1','9']
+			return 304
+		}
+		return NoState
+	},
+	// S276
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 276
+		case r == 32: // [' ',' ']
+			return 276
+		case r == 48: // ['0','0']
+			return 305
+		case 49 <= r && r <= 57: // ['1','9']
+			return 306
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 307
+		case 97 <= r && r <= 122: // ['a','z']
+			return 307
+		}
+		return NoState
+	},
+	// S277
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 276
+		case r == 32: // [' ',' ']
+			return 276
+		case 48 <= r && r <= 57: // ['0','9']
+			return 277
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 249
+		case 97 <= r && r <= 122: // ['a','z']
+			return 249
+		}
+		return NoState
+	},
+	// S278
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 308
+		}
+		return NoState
+	},
+	// S279
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 309
+		case r == 32: // [' ',' ']
+			return 309
+		}
+		return NoState
+	},
+	// S280
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 310
+		case r == 32: // [' ',' ']
+			return 310
+		case r == 48: // ['0','0']
+			return 280
+		case 49 <= r && r <= 57: // ['1','9']
+			return 311
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 282
+		case 97 <= r && r <= 122: // ['a','z']
+			return 282
+		}
+		return NoState
+	},
+	// S281
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 310
+		case r == 32: // [' ',' ']
+			return 310
+		case 48 <= r && r <= 57: // ['0','9']
+			return 281
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 282
+		case 97 <= r && r <= 122: // ['a','z']
+			return 282
+		}
+		return NoState
+	},
+	// S282
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 310
+		case r == 32: // [' ',' ']
+			return 310
+		case r == 48: // ['0','0']
+			return 280
+		case 49 <= r && r <= 57: // ['1','9']
+			return 311
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 282
+		case 97 <= r && r <= 122: // ['a','z']
+			return 282
+		}
+		return NoState
+	},
+	// S283
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 312
+		case r == 110: // ['n','n']
+			return 313
+		}
+		return NoState
+	},
+	// S284
+	func(r rune) int {
+		switch {
+		case r == 37: // ['%','%']
+			return 314
+		}
+		return NoState
+	},
+	// S285
+	func(r rune) int {
+		switch {
+		case r == 37: // ['%','%']
+			return 314
+		case 48 <= r && r <= 57: // ['0','9']
+			return 285
+		}
+		return NoState
+	},
+	// S286
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 315
+		case 65 <= r && r <= 70: // ['A','F']
+			return 315
+		case 97 <= r && r <= 102: // ['a','f']
+			return 315
+		}
+		return NoState
+	},
+	// S287
+	func(r rune) int {
+		switch {
+		case r == 121: // ['y','y']
+			return 316
+		}
+		return NoState
+	},
+	// S288
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 288
+		case r == 32: // [' ',' ']
+			return 288
+		case r == 48: // ['0','0']
+			return 317
+		case 49 <= r && r <= 57: // ['1','9']
+			return 318
+		}
+		return NoState
+	},
+	// S289
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 289
+		case r == 32: // [' ',' ']
+			return 289
+		case r == 48: // ['0','0']
+			return 319
+		case 49 <= r && r <= 57: // ['1','9']
+			return 320
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 321
+		case 97 <= r && r <= 122: // ['a','z']
+			return 321
+		}
+		return NoState
+	},
+	// S290
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 289
+		case r == 32: // [' ',' ']
+			return 289
+		case r == 102: // ['f','f']
+			return 291
+		}
+		return NoState
+	},
+	// S291
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 289
+		case r == 32: // [' ',' ']
+			return 289
+		}
+		return NoState
+	},
+	// S292
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 289
+		case r == 32: // [' ',' ']
+			return 289
+		case r == 112: // ['p','p']
+			return 291
+		}
+		return NoState
+	},
+	// S293
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 293
+		case r == 61: // ['=','=']
+			return 294
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 265
+		case 97 <= r && r <= 122: // ['a','z']
+			return 265
+		}
+		return NoState
+	},
+	// S294
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 322
+		case r == 45: // ['-','-']
+			return 322
+		case r == 48: // ['0','0']
+			return 323
+		case 49 <= r && r <= 57: // ['1','9']
+			return 324
+		}
+		return NoState
+	},
+	// S295
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 325
+		case r == 32: // [' ',' ']
+			return 325
+		}
+		return NoState
+	},
+	// S296
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 296
+		case 49 <= r && r <= 57: // ['1','9']
+			return 326
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 298
+		case 97 <= r && r <= 122: // ['a','z']
+			return 298
+		}
+		return NoState
+	},
+	// S297
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 297
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 298
+		case 97 <= r && r <= 122: // ['a','z']
+			return 298
+		}
+		return NoState
+	},
+	// S298
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 296
+		case 49 <= r && r <= 57: // ['1','9']
+			return 326
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 298
+		case 97 <= r && r <= 122: // ['a','z']
+			return 298
+		}
+		return NoState
+	},
+	// S299
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 327
+		case r == 32: // [' ',' ']
+			return 327
+		}
+		return NoState
+	},
+	// S300
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 300
+		case r == 32: // [' ',' ']
+			return 300
+		}
+		return NoState
+	},
+	// S301
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 300
+		case r == 32: // [' ',' ']
+			return 300
+		case 48 <= r && r <= 57: // ['0','9']
+			return 301
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 272
+		case 97 <= r && r <= 122: // ['a','z']
+			return 272
+		}
+		return NoState
+	},
+	// S302
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 303
+		case 49 <= r && r <= 57: // ['1','9']
+			return 304
+		}
+		return NoState
+	},
+	// S303
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S304
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 304
+		}
+		return NoState
+	},
+	// S305
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 305
+		case 49 <= r && r <= 57: // ['1','9']
+			return 328
+		case r == 61: // ['=','=']
+			return 329
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 307
+		case 97 <= r && r <= 122: // ['a','z']
+			return 307
+		}
+		return NoState
+	},
+	// S306
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 306
+		case r == 61: // ['=','=']
+			return 329
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 307
+		case 97 <= r && r <= 122: // ['a','z']
+			return 307
+		}
+		return NoState
+	},
+	// S307
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 305
+		case 49 <= r && r <= 57: // ['1','9']
+			return 328
+		case r == 61: // ['=','=']
+			return 329
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 307
+		case 97 <= r && r <= 122: // ['a','z']
+			return 307
+		}
+		return NoState
+	},
+	// S308
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S309
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 309
+		case r == 32: // [' ',' ']
+			return 309
+		case r == 48: // ['0','0']
+			return 330
+		case 49 <= r && r <= 57: // ['1','9']
+			return 331
+		}
+		return NoState
+	},
+	// S310
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 310
+		case r == 32: // [' ',' ']
+			return 310
+		case r == 48: // ['0','0']
+			return 332
+		case 49 <= r && r <= 57: // ['1','9']
+			return 333
+		}
+		return NoState
+	},
+	// S311
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 310
+		case r == 32: // [' ',' ']
+			return 310
+		case 48 <= r && r <= 57: // ['0','9']
+			return 311
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 282
+		case 97 <= r && r <= 122: // ['a','z']
+			return 282
+		}
+		return NoState
+	},
+	// S312
+	func(r rune) int {
+		switch {
+		case r == 102: // ['f','f']
+			return 313
+		}
+		return NoState
+	},
+	// S313
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S314
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 334
+		case r == 32: // [' ',' ']
+			return 334
+		}
+		return NoState
+	},
+	// S315
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 335
+		case r == 32: // [' ',' ']
+			return 335
+		}
+		return NoState
+	},
+	// S316
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S317
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 336
+		case r == 32: // [' ',' ']
+			return 336
+		}
+		return NoState
+	},
+	// S318
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 336
+		case r == 32: // [' ',' ']
+			return 336
+		case 48 <= r && r <= 57: // ['0','9']
+			return 318
+		}
+		return NoState
+	},
+	// S319
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 319
+		case 49 <= r && r <= 57: // ['1','9']
+			return 337
+		case r == 61: // ['=','=']
+			return 338
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 321
+		case 97 <= r && r <= 122: // ['a','z']
+			return 321
+		}
+		return NoState
+	},
+	// S320
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 320
+		case r == 61: // ['=','=']
+			return 338
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 321
+		case 97 <= r && r <= 122: // ['a','z']
+			return 321
+		}
+		return NoState
+	},
+	// S321
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 319
+		case 49 <= r && r <= 57: // ['1','9']
+			return 337
+		case r == 61: // ['=','=']
+			return 338
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 321
+		case 97 <= r && r <= 122: // ['a','z']
+			return 321
+		}
+		return NoState
+	},
+	// S322
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 323
+		case 49 <= r && r <= 57: // ['1','9']
+			return 324
+		}
+		return NoState
+	},
+	// S323
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 222
+		case r == 32: // [' ',' ']
+			return 222
+		}
+		return NoState
+	},
+	// S324
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 222
+		case r == 32: // [' ',' ']
+			return 222
+		case 48 <= r && r <= 57: // ['0','9']
+			return 324
+		}
+		return NoState
+	},
+	// S325
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 325
+		case r == 32: // [' ',' ']
+			return 325
+		case r == 48: // ['0','0']
+			return 339
+		case 49 <= r && r <= 57: // ['1','9']
+			return 340
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 341
+		case 97 <= r && r <= 122: // ['a','z']
+			return 341
+		}
+		return NoState
+	},
+	// S326
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 326
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 298
+		case 97 <= r && r <= 122: // ['a','z']
+			return 298
+		}
+		return NoState
+	},
+	// S327
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 327
+		case r == 32: // [' ',' ']
+			return 327
+		case r == 48: // ['0','0']
+			return 342
+		case 49 <= r && r <= 57: // ['1','9']
+			return 343
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 344
+		case 97 <= r && r <= 122: // ['a','z']
+			return 344
+		}
+		return NoState
+	},
+	// S328
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 328
+		case r == 61: // ['=','=']
+			return 329
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 307
+		case 97 <= r && r <= 122: // ['a','z']
+			return 307
+		}
+		return NoState
+	},
+	// S329
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 345
+		case r == 45: // ['-','-']
+			return 345
+		case r == 48: // ['0','0']
+			return 346
+		case 49 <= r && r <= 57: // ['1','9']
+			return 347
+		}
+		return NoState
+	},
+	// S330
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 348
+		case r == 32: // [' ',' ']
+			return 348
+		}
+		return NoState
+	},
+	// S331
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 348
+		case r == 32: // [' ',' ']
+			return 348
+		case 48 <= r && r <= 57: // ['0','9']
+			return 331
+		}
+		return NoState
+	},
+	// S332
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 310
+		case r == 32: // [' ',' ']
+			return 310
+		}
+		return NoState
+	},
+	// S333
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 310
+		case r == 32: // [' ',' ']
+			return 310
+		case 48 <= r && r <= 57: // ['0','9']
+			return 333
+		}
+		return NoState
+	},
+	// S334
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 334
+		case r == 32: // [' ',' ']
+			return 334
+		case r == 48: // ['0','0']
+			return 349
+		case 49 <= r && r <= 57: // ['1','9']
+			return 350
+		}
+		return NoState
+	},
+	// S335
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 335
+		case r == 32: // [' ',' ']
+			return 335
+		case 48 <= r && r <= 57: // ['0','9']
+			return 351
+		case 65 <= r && r <= 70: // ['A','F']
+			return 351
+		case 97 <= r && r <= 102: // ['a','f']
+			return 351
+		}
+		return NoState
+	},
+	// S336
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 336
+		case r == 32: // [' ',' ']
+			return 336
+		case r == 48: // ['0','0']
+			return 352
+		case 49 <= r && r <= 57: // ['1','9']
+			return 353
+		}
+		return NoState
+	},
+	// S337
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 337
+		case r == 61: // ['=','=']
+			return 338
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 321
+		case 97 <= r && r <= 122: // ['a','z']
+			return 321
+		}
+		return NoState
+	},
+	// S338
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 354
+		case r == 45: // ['-','-']
+			return 354
+		case r == 48: // ['0','0']
+			return 355
+		case 49 <= r && r <= 57: // ['1','9']
+			return 356
+		}
+		return NoState
+	},
+	// S339
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 339
+		case 49 <= r && r <= 57: // ['1','9']
+			return 357
+		case r == 61: // ['=','=']
+			return 358
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 341
+		case 97 <= r && r <= 122: // ['a','z']
+			return 341
+		}
+		return NoState
+	},
+	// S340
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 340
+		case r == 61: // ['=','=']
+			return 358
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 341
+		case 97 <= r && r <= 122: // ['a','z']
+			return 341
+		}
+		return NoState
+	},
+	// S341
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 339
+		case 49 <= r && r <= 57: // ['1','9']
+			return 357
+		case r == 61: // ['=','=']
+			return 358
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 341
+		case 97 <= r && r <= 122: // ['a','z']
+			return 341
+		}
+		return NoState
+	},
+	// S342
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 342
+		case 49 <= r && r <= 57: // ['1','9']
+			return 359
+		case r == 61: // ['=','=']
+			return 360
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 344
+		case 97 <= r && r <= 122: // ['a','z']
+			return 344
+		}
+		return NoState
+	},
+	// S343
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 343
+		case r == 61: // ['=','=']
+			return 360
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 344
+		case 97 <= r && r <= 122: // ['a','z']
+			return 344
+		}
+		return NoState
+	},
+	// S344
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 342
+		case 49 <= r && r <= 57: // ['1','9']
+			return 359
+		case r == 61: // ['=','=']
+			return 360
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 344
+		case 97 <= r && r <= 122: // ['a','z']
+			return 344
+		}
+		return NoState
+	},
+	// S345
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 346
+		case 49 <= r && r <= 57: // ['1','9']
+			return 347
+		}
+		return NoState
+	},
+	// S346
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 276
+		case r == 32: // [' ',' ']
+			return 276
+		}
+		return NoState
+	},
+	// S347
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 276
+		case r == 32: // [' ',' ']
+			return 276
+		case 48 <= r && r <= 57: // ['0','9']
+			return 347
+		}
+		return NoState
+	},
+	// S348
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 348
+		case r == 32: // [' ',' ']
+			return 348
+		case r == 98: // ['b','b']
+			return 361
+		}
+		return NoState
+	},
+	// S349
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 362
+		case r == 32: // [' ',' ']
+			return 362
+		}
+		return NoState
+	},
+	// S350
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 362
+		case r == 32: // [' ',' ']
+			return 362
+		case 48 <= r && r <= 57: // ['0','9']
+			return 350
+		}
+		return NoState
+	},
+	// S351
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 315
+		case 65 <= r && r <= 70: // ['A','F']
+			return 315
+		case 97 <= r && r <= 102: // ['a','f']
+			return 315
+		}
+		return NoState
+	},
+	// S352
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 363
+		case r == 32: // [' ',' ']
+			return 363
+		case r == 45: // ['-','-']
+			return 364
+		}
+		return NoState
+	},
+	// S353
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 363
+		case r == 32: // [' ',' ']
+			return 363
+		case r == 45: // ['-','-']
+			return 364
+		case 48 <= r && r <= 57: // ['0','9']
+			return 353
+		}
+		return NoState
+	},
+	// S354
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 355
+		case 49 <= r && r <= 57: // ['1','9']
+			return 356
+		}
+		return NoState
+	},
+	// S355
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 289
+		case r == 32: // [' ',' ']
+			return 289
+		}
+		return NoState
+	},
+	// S356
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 289
+		case r == 32: // [' ',' ']
+			return 289
+		case 48 <= r && r <= 57: // ['0','9']
+			return 356
+		}
+		return NoState
+	},
+	// S357
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 357
+		case r == 61: // ['=','=']
+			return 358
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 341
+		case 97 <= r && r <= 122: // ['a','z']
+			return 341
+		}
+		return NoState
+	},
+	// S358
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 365
+		case r == 45: // ['-','-']
+			return 365
+		case r == 48: // ['0','0']
+			return 366
+		case 49 <= r && r <= 57: // ['1','9']
+			return 367
+		}
+		return NoState
+	},
+	// S359
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 359
+		case r == 61: // ['=','=']
+			return 360
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 344
+		case 97 <= r && r <= 122: // ['a','z']
+			return 344
+		}
+		return NoState
+	},
+	// S360
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 368
+		case r == 45: // ['-','-']
+			return 368
+		case r == 48: // ['0','0']
+			return 369
+		case 49 <= r && r <= 57: // ['1','9']
+			return 370
+		}
+		return NoState
+	},
+	// S361
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 371
+		}
+		return NoState
+	},
+	// S362
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 362
+		case r == 32: // [' ',' ']
+			return 362
+		}
+		return NoState
+	},
+	// S363
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 363
+		case r == 32: // [' ',' ']
+			return 363
+		case r == 45: // ['-','-']
+			return 364
+		}
+		return NoState
+	},
+	// S364
+	func(r rune) int {
+		switch {
+		case r == 62: // ['>','>']
+			return 372
+		}
+		return NoState
+	},
+	// S365
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 366
+		case 49 <= r && r <= 57: // ['1','9']
+			return 367
+		}
+		return NoState
+	},
+	// S366
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 373
+		case r == 32: // [' ',' ']
+			return 373
+		}
+		return NoState
+	},
+	// S367
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 373
+		case r == 32: // [' ',' ']
+			return 373
+		case 48 <= r && r <= 57: // ['0','9']
+			return 367
+		}
+		return NoState
+	},
+	// S368
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 369
+		case 49 <= r && r <= 57: // ['1','9']
+			return 370
+		}
+		return NoState
+	},
+	// S369
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 374
+		case r == 32: // [' ',' ']
+			return 374
+		}
+		return NoState
+	},
+	// S370
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 374
+		case r == 32: // [' ',' ']
+			return 374
+		case 48 <= r && r <= 57: // ['0','9']
+			return 370
+		}
+		return NoState
+	},
+	// S371
+	func(r rune) int {
+		switch {
+		case r == 110: // ['n','n']
+			return 375
+		}
+		return NoState
+	},
+	// S372
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 376
+		case r == 32: // [' ',' ']
+			return 376
+		case r == 48: // ['0','0']
+			return 377
+		case 49 <= r && r <= 57: // ['1','9']
+			return 378
+		}
+		return NoState
+	},
+	// S373
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 373
+		case r == 32: // [' ',' ']
+			return 373
+		case r == 48: // ['0','0']
+			return 379
+		case 49 <= r && r <= 57: // ['1','9']
+			return 380
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 381
+		case 97 <= r && r <= 122: // ['a','z']
+			return 381
+		}
+		return NoState
+	},
+	// S374
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 374
+		case r == 32: // [' ',' ']
+			return 374
+		case r == 48: // ['0','0']
+			return 382
+		case 49 <= r && r <= 57: // ['1','9']
+			return 383
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 384
+		case 97 <= r && r <= 122: // ['a','z']
+			return 384
+		}
+		return NoState
+	},
+	// S375
+	func(r rune) int {
+		switch {
+		case r == 107: // ['k','k']
+			return 385
+		}
+		return NoState
+	},
+	// S376
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 376
+		case r == 32: // [' ',' ']
+			return 376
+		case r == 48: // ['0','0']
+			return 377
+		case 49 <= r && r <= 57: // ['1','9']
+			return 378
+		}
+		return NoState
+	},
+	// S377
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 386
+		case r == 32: // [' ',' ']
+			return 386
+		}
+		return NoState
+	},
+	// S378
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 386
+		case r == 32: // [' ',' ']
+			return 386
+		case 48 <= r && r <= 57: // ['0','9']
+			return 378
+		}
+		return NoState
+	},
+	// S379
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 379
+		case 49 <= r && r <= 57: // ['1','9']
+			return 387
+		case r == 61: // ['=','=']
+			return 388
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 381
+		case 97 <= r && r <= 122: // ['a','z']
+			return 381
+		}
+		return NoState
+	},
+	// S380
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 380
+		case r == 61: // ['=','=']
+			return 388
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 381
+		case 97 <= r && r <= 122: // ['a','z']
+			return 381
+		}
+		return NoState
+	},
+	// S381
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 379
+		case 49 <= r && r <= 57: // ['1','9']
+			return 387
+		case r == 61: // ['=','=']
+			return 388
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 381
+		case 97 <= r && r <= 122: // ['a','z']
+			return 381
+		}
+		return NoState
+	},
+	// S382
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 382
+		case 49 <= r && r <= 57: // ['1','9']
+			return 389
+		case r == 61: // ['=','=']
+			return 390
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 384
+		case 97 <= r && r <= 122: // ['a','z']
+			return 384
+		}
+		return NoState
+	},
+	// S383
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 383
+		case r == 61: // ['=','=']
+			return 390
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 384
+		case 97 <= r && r <= 122: // ['a','z']
+			return 384
+		}
+		return NoState
+	},
+	// S384
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 382
+		case 49 <= r && r <= 57: // ['1','9']
+			return 389
+		case r == 61: // ['=','=']
+			return 390
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 384
+		case 97 <= r && r <= 122: // ['a','z']
+			return 384
+		}
+		return NoState
+	},
+	// S385
+	func(r rune) int {
+		switch {
+		case r == 61: // ['=','=']
+			return 391
+		}
+		return NoState
+	},
+	// S386
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 386
+		case r == 32: // [' ',' ']
+			return 386
+		case r == 101: // ['e','e']
+			return 392
+		case r == 108: // ['l','l']
+			return 393
+		}
+		return NoState
+	},
+	// S387
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 387
+		case r == 61: // ['=','=']
+			return 388
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 381
+		case 97 <= r && r <= 122: // ['a','z']
+			return 381
+		}
+		return NoState
+	},
+	// S388
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 394
+		case r == 45: // ['-','-']
+			return 394
+		case r == 48: // ['0','0']
+			return 366
+		case 49 <= r && r <= 57: // ['1','9']
+			return 395
+		}
+		return NoState
+	},
+	// S389
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 389
+		case r == 61: // ['=','=']
+			return 390
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 384
+		case 97 <= r && r <= 122: // ['a','z']
+			return 384
+		}
+		return NoState
+	},
+	// S390
+	func(r rune) int {
+		switch {
+		case r == 43: // ['+','+']
+			return 396
+		case r == 45: // ['-','-']
+			return 396
+		case r == 48: // ['0','0']
+			return 369
+		case 49 <= r && r <= 57: // ['1','9']
+			return 397
+		}
+		return NoState
+	},
+	// S391
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 398
+		case 49 <= r && r <= 57: // ['1','9']
+			return 399
+		}
+		return NoState
+	},
+	// S392
+	func(r rune) int {
+		switch {
+		case r == 120: // ['x','x']
+			return 400
+		}
+		return NoState
+	},
+	// S393
+	func(r rune) int {
+		switch {
+		case r == 105: // ['i','i']
+			return 401
+		case r == 111: // ['o','o']
+			return 402
+		}
+		return NoState
+	},
+	// S394
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 366
+		case 49 <= r && r <= 57: // ['1','9']
+			return 395
+		}
+		return NoState
+	},
+	// S395
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 373
+		case r == 32: // [' ',' ']
+			return 373
+		case 48 <= r && r <= 57: // ['0','9']
+			return 395
+		}
+		return NoState
+	},
+	// S396
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 369
+		case 49 <= r && r <= 57: // ['1','9']
+			return 397
+		}
+		return NoState
+	},
+	// S397
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 374
+		case r == 32: // [' ',' ']
+			return 374
+		case 48 <= r && r <= 57: // ['0','9']
+			return 397
+		}
+		return NoState
+	},
+	// S398
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 403
+		case r == 32: // [' ',' ']
+			return 403
+		case r == 58: // [':',':']
+			return 404
+		}
+		return NoState
+	},
+	// S399
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 403
+		case r == 32: // [' ',' ']
+			return 403
+		case 48 <= r && r <= 57: // ['0','9']
+			return 399
+		case r == 58: // [':',':']
+			return 404
+		}
+		return NoState
+	},
+	// S400
+	func(r rune) int {
+		switch {
+		case r == 112: // ['p','p']
+			return 405
+		}
+		return NoState
+	},
+	// S401
+	func(r rune) int {
+		switch {
+		case r == 110: // ['n','n']
+			return 406
+		}
+		return NoState
+	},
+	// S402
+	func(r rune) int {
+		switch {
+		case r == 103: // ['g','g']
+			return 405
+		}
+		return NoState
+	},
+	// S403
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 403
+		case r == 32: // [' ',' ']
+			return 403
+		}
+		return NoState
+	},
+	// S404
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 407
+		case 49 <= r && r <= 57: // ['1','9']
+			return 408
+		}
+		return NoState
+	},
+	// S405
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 409
+		case r == 32: // [' ',' ']
+			return 409
+		}
+		return NoState
+	},
+	// S406
+	func(r rune) int {
+		switch {
+		case r == 101: // ['e','e']
+			return 410
+		}
+		return NoState
+	},
+	// S407
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 403
+		case r == 32: // [' ',' ']
+			return 403
+		}
+		return NoState
+	},
+	// S408
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 403
+		case r == 32: // [' ',' ']
+			return 403
+		case 48 <= r && r <= 57: // ['0','9']
+			return 408
+		}
+		return NoState
+	},
+	// S409
+	func(r rune) int {
+		switch {
+		case r == 9: // ['\t','\t']
+			return 409
+		case r == 32: // [' ',' ']
+			return 409
+		}
+		return NoState
+	},
+	// S410
+	func(r rune) int {
+		switch {
+		case r == 97: // ['a','a']
+			return 411
+		}
+		return NoState
+	},
+	// S411
+	func(r rune) int {
+		switch {
+		case r == 114: // ['r','r']
+			return 405
 		}
 		return NoState
 	},
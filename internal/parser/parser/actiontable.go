@@ -18,14 +18,20 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			shift(3),  // terminator
+			reduce(2), // lineComment, reduce: RepeatTerminator
 			reduce(2), // cmdBar, reduce: RepeatTerminator
 			nil,       // cmdEnd
+			reduce(2), // chord, reduce: RepeatTerminator
+			reduce(2), // pitch, reduce: RepeatTerminator
+			reduce(2), // degree, reduce: RepeatTerminator
 			reduce(2), // bracketBegin, reduce: RepeatTerminator
 			nil,       // bracketEnd
 			reduce(2), // symbol, reduce: RepeatTerminator
 			reduce(2), // rest, reduce: RepeatTerminator
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -34,18 +40,47 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			reduce(2), // cmdRepeat, reduce: RepeatTerminator
 			reduce(2), // cmdAssign, reduce: RepeatTerminator
+			reduce(2), // cmdKit, reduce: RepeatTerminator
 			reduce(2), // cmdPlay, reduce: RepeatTerminator
 			reduce(2), // cmdTempo, reduce: RepeatTerminator
+			nil,       // arrow
 			reduce(2), // cmdKey, reduce: RepeatTerminator
+			reduce(2), // cmdScale, reduce: RepeatTerminator
 			reduce(2), // cmdTime, reduce: RepeatTerminator
+			reduce(2), // cmdMeter, reduce: RepeatTerminator
 			reduce(2), // cmdVelocity, reduce: RepeatTerminator
+			reduce(2), // cmdOctave, reduce: RepeatTerminator
 			reduce(2), // cmdChannel, reduce: RepeatTerminator
 			reduce(2), // cmdVoice, reduce: RepeatTerminator
 			reduce(2), // cmdProgram, reduce: RepeatTerminator
+			reduce(2), // cmdProgramName, reduce: RepeatTerminator
+			nil,       // string
 			reduce(2), // cmdControl, reduce: RepeatTerminator
+			reduce(2), // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2), // cmdBend, reduce: RepeatTerminator
+			reduce(2), // cmdPressure, reduce: RepeatTerminator
+			reduce(2), // cmdSysex, reduce: RepeatTerminator
+			reduce(2), // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2), // cmdRPN, reduce: RepeatTerminator
+			reduce(2), // cmdNRPN, reduce: RepeatTerminator
+			reduce(2), // cmdSwing, reduce: RepeatTerminator
+			reduce(2), // cmdGroove, reduce: RepeatTerminator
+			reduce(2), // cmdHumanize, reduce: RepeatTerminator
 			reduce(2), // cmdStart, reduce: RepeatTerminator
 			reduce(2), // cmdStop, reduce: RepeatTerminator
+			reduce(2), // cmdPickup, reduce: RepeatTerminator
+			reduce(2), // cmdStaff, reduce: RepeatTerminator
+			reduce(2), // cmdInclude, reduce: RepeatTerminator
+			reduce(2), // cmdVolta, reduce: RepeatTerminator
+			reduce(2), // cmdDyn, reduce: RepeatTerminator
+			reduce(2), // cmdDynamics, reduce: RepeatTerminator
+			reduce(2), // cmdCresc, reduce: RepeatTerminator
+			reduce(2), // cmdDim, reduce: RepeatTerminator
 			reduce(2), // blockComment, reduce: RepeatTerminator
 		},
 	},
@@ -56,14 +91,20 @@ var actionTab = actionTable{
 			accept(true), // ␚
 			nil,          // empty
 			nil,          // terminator
+			nil,          // lineComment
 			nil,          // cmdBar
 			nil,          // cmdEnd
+			nil,          // chord
+			nil,          // pitch
+			nil,          // degree
 			nil,          // bracketBegin
 			nil,          // bracketEnd
 			nil,          // symbol
 			nil,          // rest
 			nil,          // propSharp
 			nil,          // propFlat
+			nil,          // propOctaveUp
+			nil,          // propOctaveDown
 			nil,          // propStaccato
 			nil,          // propAccent
 			nil,          // propMarcato
@@ -72,18 +113,47 @@ var actionTab = actionTable{
 			nil,          // propDot
 			nil,          // propTuplet
 			nil,          // propLetRing
+			nil,          // propTie
+			nil,          // propAftertouch
+			nil,          // propChance
+			nil,          // cmdRepeat
 			nil,          // cmdAssign
+			nil,          // cmdKit
 			nil,          // cmdPlay
 			nil,          // cmdTempo
+			nil,          // arrow
 			nil,          // cmdKey
+			nil,          // cmdScale
 			nil,          // cmdTime
+			nil,          // cmdMeter
 			nil,          // cmdVelocity
+			nil,          // cmdOctave
 			nil,          // cmdChannel
 			nil,          // cmdVoice
 			nil,          // cmdProgram
+			nil,          // cmdProgramName
+			nil,          // string
 			nil,          // cmdControl
+			nil,          // cmdControlRamp
+			nil,          // cmdBend
+			nil,          // cmdPressure
+			nil,          // cmdSysex
+			nil,          // cmdSysexFile
+			nil,          // cmdRPN
+			nil,          // cmdNRPN
+			nil,          // cmdSwing
+			nil,          // cmdGroove
+			nil,          // cmdHumanize
 			nil,          // cmdStart
 			nil,          // cmdStop
+			nil,          // cmdPickup
+			nil,          // cmdStaff
+			nil,          // cmdInclude
+			nil,          // cmdVolta
+			nil,          // cmdDyn
+			nil,          // cmdDynamics
+			nil,          // cmdCresc
+			nil,          // cmdDim
 			nil,          // blockComment
 		},
 	},
@@ -94,14 +164,20 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			nil,       // terminator
-			shift(10), // cmdBar
+			shift(6),  // lineComment
+			shift(12), // cmdBar
 			nil,       // cmdEnd
-			shift(14), // bracketBegin
+			shift(15), // chord
+			shift(16), // pitch
+			shift(17), // degree
+			shift(19), // bracketBegin
 			nil,       // bracketEnd
-			shift(15), // symbol
-			shift(16), // rest
+			shift(20), // symbol
+			shift(21), // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -110,19 +186,48 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
-			shift(17), // cmdAssign
-			shift(18), // cmdPlay
-			shift(19), // cmdTempo
-			shift(20), // cmdKey
-			shift(21), // cmdTime
-			shift(22), // cmdVelocity
-			shift(23), // cmdChannel
-			shift(24), // cmdVoice
-			shift(25), // cmdProgram
-			shift(26), // cmdControl
-			shift(27), // cmdStart
-			shift(28), // cmdStop
-			shift(29), // blockComment
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			shift(22), // cmdRepeat
+			shift(23), // cmdAssign
+			shift(24), // cmdKit
+			shift(25), // cmdPlay
+			shift(26), // cmdTempo
+			nil,       // arrow
+			shift(27), // cmdKey
+			shift(28), // cmdScale
+			shift(29), // cmdTime
+			shift(30), // cmdMeter
+			shift(31), // cmdVelocity
+			shift(32), // cmdOctave
+			shift(33), // cmdChannel
+			shift(34), // cmdVoice
+			shift(35), // cmdProgram
+			shift(36), // cmdProgramName
+			nil,       // string
+			shift(37), // cmdControl
+			shift(38), // cmdControlRamp
+			shift(39), // cmdBend
+			shift(40), // cmdPressure
+			shift(41), // cmdSysex
+			shift(42), // cmdSysexFile
+			shift(43), // cmdRPN
+			shift(44), // cmdNRPN
+			shift(45), // cmdSwing
+			shift(46), // cmdGroove
+			shift(47), // cmdHumanize
+			shift(48), // cmdStart
+			shift(49), // cmdStop
+			shift(50), // cmdPickup
+			shift(51), // cmdStaff
+			shift(52), // cmdInclude
+			shift(53), // cmdVolta
+			shift(54), // cmdDyn
+			shift(55), // cmdDynamics
+			shift(56), // cmdCresc
+			shift(57), // cmdDim
+			shift(58), // blockComment
 		},
 	},
 	actionRow{ // S3
@@ -132,14 +237,20 @@ var actionTab = actionTable{
 			nil,       // ␚
 			nil,       // empty
 			shift(3),  // terminator
+			reduce(2), // lineComment, reduce: RepeatTerminator
 			reduce(2), // cmdBar, reduce: RepeatTerminator
 			nil,       // cmdEnd
+			reduce(2), // chord, reduce: RepeatTerminator
+			reduce(2), // pitch, reduce: RepeatTerminator
+			reduce(2), // degree, reduce: RepeatTerminator
 			reduce(2), // bracketBegin, reduce: RepeatTerminator
 			nil,       // bracketEnd
 			reduce(2), // symbol, reduce: RepeatTerminator
 			reduce(2), // rest, reduce: RepeatTerminator
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -148,18 +259,47 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			reduce(2), // cmdRepeat, reduce: RepeatTerminator
 			reduce(2), // cmdAssign, reduce: RepeatTerminator
+			reduce(2), // cmdKit, reduce: RepeatTerminator
 			reduce(2), // cmdPlay, reduce: RepeatTerminator
 			reduce(2), // cmdTempo, reduce: RepeatTerminator
+			nil,       // arrow
 			reduce(2), // cmdKey, reduce: RepeatTerminator
+			reduce(2), // cmdScale, reduce: RepeatTerminator
 			reduce(2), // cmdTime, reduce: RepeatTerminator
+			reduce(2), // cmdMeter, reduce: RepeatTerminator
 			reduce(2), // cmdVelocity, reduce: RepeatTerminator
+			reduce(2), // cmdOctave, reduce: RepeatTerminator
 			reduce(2), // cmdChannel, reduce: RepeatTerminator
 			reduce(2), // cmdVoice, reduce: RepeatTerminator
 			reduce(2), // cmdProgram, reduce: RepeatTerminator
+			reduce(2), // cmdProgramName, reduce: RepeatTerminator
+			nil,       // string
 			reduce(2), // cmdControl, reduce: RepeatTerminator
+			reduce(2), // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2), // cmdBend, reduce: RepeatTerminator
+			reduce(2), // cmdPressure, reduce: RepeatTerminator
+			reduce(2), // cmdSysex, reduce: RepeatTerminator
+			reduce(2), // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2), // cmdRPN, reduce: RepeatTerminator
+			reduce(2), // cmdNRPN, reduce: RepeatTerminator
+			reduce(2), // cmdSwing, reduce: RepeatTerminator
+			reduce(2), // cmdGroove, reduce: RepeatTerminator
+			reduce(2), // cmdHumanize, reduce: RepeatTerminator
 			reduce(2), // cmdStart, reduce: RepeatTerminator
 			reduce(2), // cmdStop, reduce: RepeatTerminator
+			reduce(2), // cmdPickup, reduce: RepeatTerminator
+			reduce(2), // cmdStaff, reduce: RepeatTerminator
+			reduce(2), // cmdInclude, reduce: RepeatTerminator
+			reduce(2), // cmdVolta, reduce: RepeatTerminator
+			reduce(2), // cmdDyn, reduce: RepeatTerminator
+			reduce(2), // cmdDynamics, reduce: RepeatTerminator
+			reduce(2), // cmdCresc, reduce: RepeatTerminator
+			reduce(2), // cmdDim, reduce: RepeatTerminator
 			reduce(2), // blockComment, reduce: RepeatTerminator
 		},
 	},
@@ -170,14 +310,20 @@ var actionTab = actionTable{
 			reduce(1), // ␚, reduce: SourceFile
 			nil,       // empty
 			nil,       // terminator
+			nil,       // lineComment
 			nil,       // cmdBar
 			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
 			nil,       // bracketBegin
 			nil,       // bracketEnd
 			nil,       // symbol
 			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -186,18 +332,47 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
@@ -207,15 +382,21 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			reduce(2), // ␚, reduce: RepeatTerminator
 			nil,       // empty
-			shift(32), // terminator
+			shift(61), // terminator
+			shift(62), // lineComment
 			nil,       // cmdBar
 			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
 			nil,       // bracketBegin
 			nil,       // bracketEnd
 			nil,       // symbol
 			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -224,112 +405,144 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
 	actionRow{ // S6
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(6), // ␚, reduce: Decl
-			nil,       // empty
-			reduce(6), // terminator, reduce: Decl
-			nil,       // cmdBar
-			nil,       // cmdEnd
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
+			nil,        // INVALID
+			reduce(82), // ␚, reduce: Comment
+			nil,        // empty
+			reduce(82), // terminator, reduce: Comment
+			reduce(82), // lineComment, reduce: Comment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
 		},
 	},
 	actionRow{ // S7
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(7), // ␚, reduce: Decl
-			nil,       // empty
-			reduce(7), // terminator, reduce: Decl
-			nil,       // cmdBar
-			nil,       // cmdEnd
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
-		},
-	},
-	actionRow{ // S8
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			reduce(8), // ␚, reduce: Decl
 			nil,       // empty
 			reduce(8), // terminator, reduce: Decl
+			reduce(8), // lineComment, reduce: Decl
 			nil,       // cmdBar
 			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
 			nil,       // bracketBegin
 			nil,       // bracketEnd
 			nil,       // symbol
 			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -338,36 +551,71 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
-	actionRow{ // S9
+	actionRow{ // S8
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			reduce(9), // ␚, reduce: Decl
 			nil,       // empty
 			reduce(9), // terminator, reduce: Decl
+			reduce(9), // lineComment, reduce: Decl
 			nil,       // cmdBar
 			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
 			nil,       // bracketBegin
 			nil,       // bracketEnd
 			nil,       // symbol
 			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -376,74 +624,71 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
-	actionRow{ // S10
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			shift(3),  // terminator
-			reduce(2), // cmdBar, reduce: RepeatTerminator
-			nil,       // cmdEnd
-			reduce(2), // bracketBegin, reduce: RepeatTerminator
-			nil,       // bracketEnd
-			reduce(2), // symbol, reduce: RepeatTerminator
-			reduce(2), // rest, reduce: RepeatTerminator
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			reduce(2), // cmdAssign, reduce: RepeatTerminator
-			reduce(2), // cmdPlay, reduce: RepeatTerminator
-			reduce(2), // cmdTempo, reduce: RepeatTerminator
-			reduce(2), // cmdKey, reduce: RepeatTerminator
-			reduce(2), // cmdTime, reduce: RepeatTerminator
-			reduce(2), // cmdVelocity, reduce: RepeatTerminator
-			reduce(2), // cmdChannel, reduce: RepeatTerminator
-			reduce(2), // cmdVoice, reduce: RepeatTerminator
-			reduce(2), // cmdProgram, reduce: RepeatTerminator
-			reduce(2), // cmdControl, reduce: RepeatTerminator
-			reduce(2), // cmdStart, reduce: RepeatTerminator
-			reduce(2), // cmdStop, reduce: RepeatTerminator
-			reduce(2), // blockComment, reduce: RepeatTerminator
-		},
-	},
-	actionRow{ // S11
+	actionRow{ // S9
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(11), // ␚, reduce: NoteList
+			reduce(10), // ␚, reduce: Decl
 			nil,        // empty
-			reduce(11), // terminator, reduce: NoteList
+			reduce(10), // terminator, reduce: Decl
+			reduce(10), // lineComment, reduce: Decl
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			shift(14),  // bracketBegin
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			shift(15),  // symbol
-			shift(16),  // rest
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -452,74 +697,144 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S12
+	actionRow{ // S10
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(18), // ␚, reduce: PropertyList
+			reduce(11), // ␚, reduce: Decl
 			nil,        // empty
-			reduce(18), // terminator, reduce: PropertyList
+			reduce(11), // terminator, reduce: Decl
+			reduce(11), // lineComment, reduce: Decl
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(18), // bracketBegin, reduce: PropertyList
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(18), // symbol, reduce: PropertyList
-			reduce(18), // rest, reduce: PropertyList
-			shift(37),  // propSharp
-			shift(38),  // propFlat
-			shift(39),  // propStaccato
-			shift(40),  // propAccent
-			shift(41),  // propMarcato
-			shift(42),  // propGhost
-			shift(43),  // uint
-			shift(44),  // propDot
-			shift(45),  // propTuplet
-			shift(46),  // propLetRing
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S13
+	actionRow{ // S11
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(14), // ␚, reduce: NoteObject
+			reduce(12), // ␚, reduce: Decl
 			nil,        // empty
-			reduce(14), // terminator, reduce: NoteObject
+			reduce(12), // terminator, reduce: Decl
+			reduce(12), // lineComment, reduce: Decl
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(14), // bracketBegin, reduce: NoteObject
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(14), // symbol, reduce: NoteObject
-			reduce(14), // rest, reduce: NoteObject
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -528,36 +843,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S14
+	actionRow{ // S12
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
-			nil,       // terminator
-			nil,       // cmdBar
+			shift(3),  // terminator
+			reduce(2), // lineComment, reduce: RepeatTerminator
+			reduce(2), // cmdBar, reduce: RepeatTerminator
 			nil,       // cmdEnd
-			shift(51), // bracketBegin
+			reduce(2), // chord, reduce: RepeatTerminator
+			reduce(2), // pitch, reduce: RepeatTerminator
+			reduce(2), // degree, reduce: RepeatTerminator
+			reduce(2), // bracketBegin, reduce: RepeatTerminator
 			nil,       // bracketEnd
-			shift(52), // symbol
-			shift(53), // rest
+			reduce(2), // symbol, reduce: RepeatTerminator
+			reduce(2), // rest, reduce: RepeatTerminator
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -566,226 +916,436 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			reduce(2), // cmdRepeat, reduce: RepeatTerminator
+			reduce(2), // cmdAssign, reduce: RepeatTerminator
+			reduce(2), // cmdKit, reduce: RepeatTerminator
+			reduce(2), // cmdPlay, reduce: RepeatTerminator
+			reduce(2), // cmdTempo, reduce: RepeatTerminator
+			nil,       // arrow
+			reduce(2), // cmdKey, reduce: RepeatTerminator
+			reduce(2), // cmdScale, reduce: RepeatTerminator
+			reduce(2), // cmdTime, reduce: RepeatTerminator
+			reduce(2), // cmdMeter, reduce: RepeatTerminator
+			reduce(2), // cmdVelocity, reduce: RepeatTerminator
+			reduce(2), // cmdOctave, reduce: RepeatTerminator
+			reduce(2), // cmdChannel, reduce: RepeatTerminator
+			reduce(2), // cmdVoice, reduce: RepeatTerminator
+			reduce(2), // cmdProgram, reduce: RepeatTerminator
+			reduce(2), // cmdProgramName, reduce: RepeatTerminator
+			nil,       // string
+			reduce(2), // cmdControl, reduce: RepeatTerminator
+			reduce(2), // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2), // cmdBend, reduce: RepeatTerminator
+			reduce(2), // cmdPressure, reduce: RepeatTerminator
+			reduce(2), // cmdSysex, reduce: RepeatTerminator
+			reduce(2), // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2), // cmdRPN, reduce: RepeatTerminator
+			reduce(2), // cmdNRPN, reduce: RepeatTerminator
+			reduce(2), // cmdSwing, reduce: RepeatTerminator
+			reduce(2), // cmdGroove, reduce: RepeatTerminator
+			reduce(2), // cmdHumanize, reduce: RepeatTerminator
+			reduce(2), // cmdStart, reduce: RepeatTerminator
+			reduce(2), // cmdStop, reduce: RepeatTerminator
+			reduce(2), // cmdPickup, reduce: RepeatTerminator
+			reduce(2), // cmdStaff, reduce: RepeatTerminator
+			reduce(2), // cmdInclude, reduce: RepeatTerminator
+			reduce(2), // cmdVolta, reduce: RepeatTerminator
+			reduce(2), // cmdDyn, reduce: RepeatTerminator
+			reduce(2), // cmdDynamics, reduce: RepeatTerminator
+			reduce(2), // cmdCresc, reduce: RepeatTerminator
+			reduce(2), // cmdDim, reduce: RepeatTerminator
+			reduce(2), // blockComment, reduce: RepeatTerminator
 		},
 	},
-	actionRow{ // S15
+	actionRow{ // S13
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(16), // ␚, reduce: NoteSymbol
+			reduce(14), // ␚, reduce: NoteList
 			nil,        // empty
-			reduce(16), // terminator, reduce: NoteSymbol
+			reduce(14), // terminator, reduce: NoteList
+			reduce(14), // lineComment, reduce: NoteList
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(16), // bracketBegin, reduce: NoteSymbol
+			shift(15),  // chord
+			shift(16),  // pitch
+			shift(17),  // degree
+			shift(19),  // bracketBegin
 			nil,        // bracketEnd
-			reduce(16), // symbol, reduce: NoteSymbol
-			reduce(16), // rest, reduce: NoteSymbol
-			reduce(16), // propSharp, reduce: NoteSymbol
-			reduce(16), // propFlat, reduce: NoteSymbol
-			reduce(16), // propStaccato, reduce: NoteSymbol
-			reduce(16), // propAccent, reduce: NoteSymbol
-			reduce(16), // propMarcato, reduce: NoteSymbol
-			reduce(16), // propGhost, reduce: NoteSymbol
-			reduce(16), // uint, reduce: NoteSymbol
-			reduce(16), // propDot, reduce: NoteSymbol
-			reduce(16), // propTuplet, reduce: NoteSymbol
-			reduce(16), // propLetRing, reduce: NoteSymbol
+			shift(20),  // symbol
+			shift(21),  // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S16
+	actionRow{ // S14
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(17), // ␚, reduce: NoteSymbol
+			reduce(24), // ␚, reduce: PropertyList
 			nil,        // empty
-			reduce(17), // terminator, reduce: NoteSymbol
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(17), // bracketBegin, reduce: NoteSymbol
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
 			nil,        // bracketEnd
-			reduce(17), // symbol, reduce: NoteSymbol
-			reduce(17), // rest, reduce: NoteSymbol
-			reduce(17), // propSharp, reduce: NoteSymbol
-			reduce(17), // propFlat, reduce: NoteSymbol
-			reduce(17), // propStaccato, reduce: NoteSymbol
-			reduce(17), // propAccent, reduce: NoteSymbol
-			reduce(17), // propMarcato, reduce: NoteSymbol
-			reduce(17), // propGhost, reduce: NoteSymbol
-			reduce(17), // uint, reduce: NoteSymbol
-			reduce(17), // propDot, reduce: NoteSymbol
-			reduce(17), // propTuplet, reduce: NoteSymbol
-			reduce(17), // propLetRing, reduce: NoteSymbol
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(67),  // propSharp
+			shift(68),  // propFlat
+			shift(69),  // propOctaveUp
+			shift(70),  // propOctaveDown
+			shift(71),  // propStaccato
+			shift(72),  // propAccent
+			shift(73),  // propMarcato
+			shift(74),  // propGhost
+			shift(75),  // uint
+			shift(76),  // propDot
+			shift(77),  // propTuplet
+			shift(78),  // propLetRing
+			shift(79),  // propTie
+			shift(80),  // propAftertouch
+			shift(81),  // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S17
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			nil,       // cmdBar
-			nil,       // cmdEnd
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			shift(54), // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
-		},
-	},
-	actionRow{ // S18
+	actionRow{ // S15
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(31), // ␚, reduce: Command
+			reduce(24), // ␚, reduce: PropertyList
 			nil,        // empty
-			reduce(31), // terminator, reduce: Command
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			nil,        // bracketBegin
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			nil,        // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(67),  // propSharp
+			shift(68),  // propFlat
+			shift(69),  // propOctaveUp
+			shift(70),  // propOctaveDown
+			shift(71),  // propStaccato
+			shift(72),  // propAccent
+			shift(73),  // propMarcato
+			shift(74),  // propGhost
+			shift(75),  // uint
+			shift(76),  // propDot
+			shift(77),  // propTuplet
+			shift(78),  // propLetRing
+			shift(79),  // propTie
+			shift(80),  // propAftertouch
+			shift(81),  // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S19
+	actionRow{ // S16
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			nil,       // cmdBar
-			nil,       // cmdEnd
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			shift(55), // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
+			nil,        // INVALID
+			reduce(24), // ␚, reduce: PropertyList
+			nil,        // empty
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			nil,        // bracketEnd
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(67),  // propSharp
+			shift(68),  // propFlat
+			shift(69),  // propOctaveUp
+			shift(70),  // propOctaveDown
+			shift(71),  // propStaccato
+			shift(72),  // propAccent
+			shift(73),  // propMarcato
+			shift(74),  // propGhost
+			shift(75),  // uint
+			shift(76),  // propDot
+			shift(77),  // propTuplet
+			shift(78),  // propLetRing
+			shift(79),  // propTie
+			shift(80),  // propAftertouch
+			shift(81),  // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
 		},
 	},
-	actionRow{ // S20
+	actionRow{ // S17
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(33), // ␚, reduce: Command
+			reduce(24), // ␚, reduce: PropertyList
 			nil,        // empty
-			reduce(33), // terminator, reduce: Command
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			nil,        // bracketBegin
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(67),  // propSharp
+			shift(68),  // propFlat
+			shift(69),  // propOctaveUp
+			shift(70),  // propOctaveDown
+			shift(71),  // propStaccato
+			shift(72),  // propAccent
+			shift(73),  // propMarcato
+			shift(74),  // propGhost
+			shift(75),  // uint
+			shift(76),  // propDot
+			shift(77),  // propTuplet
+			shift(78),  // propLetRing
+			shift(79),  // propTie
+			shift(80),  // propAftertouch
+			shift(81),  // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S18
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(20), // ␚, reduce: NoteObject
+			nil,        // empty
+			reduce(20), // terminator, reduce: NoteObject
+			reduce(20), // lineComment, reduce: NoteObject
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(20), // chord, reduce: NoteObject
+			reduce(20), // pitch, reduce: NoteObject
+			reduce(20), // degree, reduce: NoteObject
+			reduce(20), // bracketBegin, reduce: NoteObject
+			nil,        // bracketEnd
+			reduce(20), // symbol, reduce: NoteObject
+			reduce(20), // rest, reduce: NoteObject
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -794,264 +1354,436 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S21
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			nil,       // cmdBar
-			nil,       // cmdEnd
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			shift(56), // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
-		},
-	},
-	actionRow{ // S22
+	actionRow{ // S19
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // terminator
+			nil,       // lineComment
 			nil,       // cmdBar
 			nil,       // cmdEnd
-			nil,       // bracketBegin
+			shift(88), // chord
+			shift(89), // pitch
+			shift(90), // degree
+			shift(92), // bracketBegin
 			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
+			shift(93), // symbol
+			shift(94), // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
 			nil,       // propGhost
-			shift(57), // uint
+			nil,       // uint
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
-	actionRow{ // S23
+	actionRow{ // S20
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			nil,       // cmdBar
-			nil,       // cmdEnd
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			shift(58), // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
+			nil,        // INVALID
+			reduce(22), // ␚, reduce: NoteSymbol
+			nil,        // empty
+			reduce(22), // terminator, reduce: NoteSymbol
+			reduce(22), // lineComment, reduce: NoteSymbol
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(22), // chord, reduce: NoteSymbol
+			reduce(22), // pitch, reduce: NoteSymbol
+			reduce(22), // degree, reduce: NoteSymbol
+			reduce(22), // bracketBegin, reduce: NoteSymbol
+			nil,        // bracketEnd
+			reduce(22), // symbol, reduce: NoteSymbol
+			reduce(22), // rest, reduce: NoteSymbol
+			reduce(22), // propSharp, reduce: NoteSymbol
+			reduce(22), // propFlat, reduce: NoteSymbol
+			reduce(22), // propOctaveUp, reduce: NoteSymbol
+			reduce(22), // propOctaveDown, reduce: NoteSymbol
+			reduce(22), // propStaccato, reduce: NoteSymbol
+			reduce(22), // propAccent, reduce: NoteSymbol
+			reduce(22), // propMarcato, reduce: NoteSymbol
+			reduce(22), // propGhost, reduce: NoteSymbol
+			reduce(22), // uint, reduce: NoteSymbol
+			reduce(22), // propDot, reduce: NoteSymbol
+			reduce(22), // propTuplet, reduce: NoteSymbol
+			reduce(22), // propLetRing, reduce: NoteSymbol
+			reduce(22), // propTie, reduce: NoteSymbol
+			reduce(22), // propAftertouch, reduce: NoteSymbol
+			reduce(22), // propChance, reduce: NoteSymbol
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
 		},
 	},
-	actionRow{ // S24
+	actionRow{ // S21
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			nil,       // cmdBar
-			nil,       // cmdEnd
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			shift(59), // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
+			nil,        // INVALID
+			reduce(23), // ␚, reduce: NoteSymbol
+			nil,        // empty
+			reduce(23), // terminator, reduce: NoteSymbol
+			reduce(23), // lineComment, reduce: NoteSymbol
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(23), // chord, reduce: NoteSymbol
+			reduce(23), // pitch, reduce: NoteSymbol
+			reduce(23), // degree, reduce: NoteSymbol
+			reduce(23), // bracketBegin, reduce: NoteSymbol
+			nil,        // bracketEnd
+			reduce(23), // symbol, reduce: NoteSymbol
+			reduce(23), // rest, reduce: NoteSymbol
+			reduce(23), // propSharp, reduce: NoteSymbol
+			reduce(23), // propFlat, reduce: NoteSymbol
+			reduce(23), // propOctaveUp, reduce: NoteSymbol
+			reduce(23), // propOctaveDown, reduce: NoteSymbol
+			reduce(23), // propStaccato, reduce: NoteSymbol
+			reduce(23), // propAccent, reduce: NoteSymbol
+			reduce(23), // propMarcato, reduce: NoteSymbol
+			reduce(23), // propGhost, reduce: NoteSymbol
+			reduce(23), // uint, reduce: NoteSymbol
+			reduce(23), // propDot, reduce: NoteSymbol
+			reduce(23), // propTuplet, reduce: NoteSymbol
+			reduce(23), // propLetRing, reduce: NoteSymbol
+			reduce(23), // propTie, reduce: NoteSymbol
+			reduce(23), // propAftertouch, reduce: NoteSymbol
+			reduce(23), // propChance, reduce: NoteSymbol
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
 		},
 	},
-	actionRow{ // S25
+	actionRow{ // S22
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // terminator
+			nil,       // lineComment
 			nil,       // cmdBar
 			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
 			nil,       // bracketBegin
 			nil,       // bracketEnd
 			nil,       // symbol
 			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
 			nil,       // propGhost
-			shift(60), // uint
+			shift(95), // uint
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
-	actionRow{ // S26
+	actionRow{ // S23
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // terminator
+			nil,       // lineComment
 			nil,       // cmdBar
 			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
 			nil,       // bracketBegin
 			nil,       // bracketEnd
-			nil,       // symbol
+			shift(96), // symbol
 			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
 			nil,       // propGhost
-			shift(61), // uint
+			nil,       // uint
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
-	actionRow{ // S27
+	actionRow{ // S24
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(40), // ␚, reduce: Command
+			reduce(43), // ␚, reduce: Command
 			nil,        // empty
-			reduce(40), // terminator, reduce: Command
+			reduce(43), // terminator, reduce: Command
+			reduce(43), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -1060,36 +1792,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S28
+	actionRow{ // S25
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(41), // ␚, reduce: Command
+			reduce(44), // ␚, reduce: Command
 			nil,        // empty
-			reduce(41), // terminator, reduce: Command
+			reduce(44), // terminator, reduce: Command
+			reduce(44), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -1098,36 +1865,144 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S29
+	actionRow{ // S26
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(42), // ␚, reduce: Comment
-			nil,        // empty
-			reduce(42), // terminator, reduce: Comment
-			nil,        // cmdBar
-			nil,        // cmdEnd
-			nil,        // bracketBegin
-			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // terminator
+			nil,       // lineComment
+			nil,       // cmdBar
+			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
+			nil,       // bracketBegin
+			nil,       // bracketEnd
+			nil,       // symbol
+			nil,       // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			shift(97), // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
+		},
+	},
+	actionRow{ // S27
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(48), // ␚, reduce: Command
+			nil,        // empty
+			reduce(48), // terminator, reduce: Command
+			reduce(48), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -1136,226 +2011,363 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S30
+	actionRow{ // S28
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			reduce(3), // cmdBar, reduce: RepeatTerminator
-			nil,       // cmdEnd
-			reduce(3), // bracketBegin, reduce: RepeatTerminator
-			nil,       // bracketEnd
-			reduce(3), // symbol, reduce: RepeatTerminator
-			reduce(3), // rest, reduce: RepeatTerminator
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			reduce(3), // cmdAssign, reduce: RepeatTerminator
-			reduce(3), // cmdPlay, reduce: RepeatTerminator
-			reduce(3), // cmdTempo, reduce: RepeatTerminator
-			reduce(3), // cmdKey, reduce: RepeatTerminator
-			reduce(3), // cmdTime, reduce: RepeatTerminator
-			reduce(3), // cmdVelocity, reduce: RepeatTerminator
-			reduce(3), // cmdChannel, reduce: RepeatTerminator
-			reduce(3), // cmdVoice, reduce: RepeatTerminator
-			reduce(3), // cmdProgram, reduce: RepeatTerminator
-			reduce(3), // cmdControl, reduce: RepeatTerminator
-			reduce(3), // cmdStart, reduce: RepeatTerminator
-			reduce(3), // cmdStop, reduce: RepeatTerminator
-			reduce(3), // blockComment, reduce: RepeatTerminator
+			nil,        // INVALID
+			reduce(49), // ␚, reduce: Command
+			nil,        // empty
+			reduce(49), // terminator, reduce: Command
+			reduce(49), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
 		},
 	},
-	actionRow{ // S31
+	actionRow{ // S29
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(5), // ␚, reduce: DeclList
+			nil,       // ␚
 			nil,       // empty
 			nil,       // terminator
+			nil,       // lineComment
 			nil,       // cmdBar
 			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
 			nil,       // bracketBegin
 			nil,       // bracketEnd
 			nil,       // symbol
 			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
 			nil,       // propGhost
-			nil,       // uint
+			shift(98), // uint
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
-	actionRow{ // S32
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(2), // ␚, reduce: RepeatTerminator
-			nil,       // empty
-			shift(63), // terminator
-			reduce(2), // cmdBar, reduce: RepeatTerminator
-			nil,       // cmdEnd
-			reduce(2), // bracketBegin, reduce: RepeatTerminator
-			nil,       // bracketEnd
-			reduce(2), // symbol, reduce: RepeatTerminator
-			reduce(2), // rest, reduce: RepeatTerminator
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			reduce(2), // cmdAssign, reduce: RepeatTerminator
-			reduce(2), // cmdPlay, reduce: RepeatTerminator
-			reduce(2), // cmdTempo, reduce: RepeatTerminator
-			reduce(2), // cmdKey, reduce: RepeatTerminator
-			reduce(2), // cmdTime, reduce: RepeatTerminator
-			reduce(2), // cmdVelocity, reduce: RepeatTerminator
-			reduce(2), // cmdChannel, reduce: RepeatTerminator
-			reduce(2), // cmdVoice, reduce: RepeatTerminator
-			reduce(2), // cmdProgram, reduce: RepeatTerminator
-			reduce(2), // cmdControl, reduce: RepeatTerminator
-			reduce(2), // cmdStart, reduce: RepeatTerminator
-			reduce(2), // cmdStop, reduce: RepeatTerminator
-			reduce(2), // blockComment, reduce: RepeatTerminator
-		},
-	},
-	actionRow{ // S33
+	actionRow{ // S30
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // terminator
-			shift(70), // cmdBar
+			nil,       // lineComment
+			nil,       // cmdBar
 			nil,       // cmdEnd
-			shift(74), // bracketBegin
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
+			nil,       // bracketBegin
 			nil,       // bracketEnd
-			shift(75), // symbol
-			shift(76), // rest
+			nil,       // symbol
+			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
 			nil,       // propGhost
-			nil,       // uint
+			shift(99), // uint
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
-			shift(77), // cmdAssign
-			shift(78), // cmdPlay
-			shift(79), // cmdTempo
-			shift(80), // cmdKey
-			shift(81), // cmdTime
-			shift(82), // cmdVelocity
-			shift(83), // cmdChannel
-			shift(84), // cmdVoice
-			shift(85), // cmdProgram
-			shift(86), // cmdControl
-			shift(87), // cmdStart
-			shift(88), // cmdStop
-			shift(89), // blockComment
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
 		},
 	},
-	actionRow{ // S34
+	actionRow{ // S31
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(12), // ␚, reduce: NoteList
+			nil,        // ␚
 			nil,        // empty
-			reduce(12), // terminator, reduce: NoteList
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			nil,        // uint
+			shift(100), // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S35
+	actionRow{ // S32
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(13), // ␚, reduce: NoteObject
+			reduce(53), // ␚, reduce: Command
 			nil,        // empty
-			reduce(13), // terminator, reduce: NoteObject
+			reduce(53), // terminator, reduce: Command
+			reduce(53), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(13), // bracketBegin, reduce: NoteObject
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(13), // symbol, reduce: NoteObject
-			reduce(13), // rest, reduce: NoteObject
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -1364,568 +2376,1020 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S36
+	actionRow{ // S33
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(18), // ␚, reduce: PropertyList
+			nil,        // ␚
 			nil,        // empty
-			reduce(18), // terminator, reduce: PropertyList
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(18), // bracketBegin, reduce: PropertyList
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(18), // symbol, reduce: PropertyList
-			reduce(18), // rest, reduce: PropertyList
-			shift(37),  // propSharp
-			shift(38),  // propFlat
-			shift(39),  // propStaccato
-			shift(40),  // propAccent
-			shift(41),  // propMarcato
-			shift(42),  // propGhost
-			shift(43),  // uint
-			shift(44),  // propDot
-			shift(45),  // propTuplet
-			shift(46),  // propLetRing
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(101), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S37
+	actionRow{ // S34
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(20), // ␚, reduce: Property
+			nil,        // ␚
 			nil,        // empty
-			reduce(20), // terminator, reduce: Property
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(20), // bracketBegin, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(20), // symbol, reduce: Property
-			reduce(20), // rest, reduce: Property
-			reduce(20), // propSharp, reduce: Property
-			reduce(20), // propFlat, reduce: Property
-			reduce(20), // propStaccato, reduce: Property
-			reduce(20), // propAccent, reduce: Property
-			reduce(20), // propMarcato, reduce: Property
-			reduce(20), // propGhost, reduce: Property
-			reduce(20), // uint, reduce: Property
-			reduce(20), // propDot, reduce: Property
-			reduce(20), // propTuplet, reduce: Property
-			reduce(20), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(102), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S38
+	actionRow{ // S35
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(21), // ␚, reduce: Property
+			reduce(56), // ␚, reduce: Command
 			nil,        // empty
-			reduce(21), // terminator, reduce: Property
+			reduce(56), // terminator, reduce: Command
+			reduce(56), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(21), // bracketBegin, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(21), // symbol, reduce: Property
-			reduce(21), // rest, reduce: Property
-			reduce(21), // propSharp, reduce: Property
-			reduce(21), // propFlat, reduce: Property
-			reduce(21), // propStaccato, reduce: Property
-			reduce(21), // propAccent, reduce: Property
-			reduce(21), // propMarcato, reduce: Property
-			reduce(21), // propGhost, reduce: Property
-			reduce(21), // uint, reduce: Property
-			reduce(21), // propDot, reduce: Property
-			reduce(21), // propTuplet, reduce: Property
-			reduce(21), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S39
+	actionRow{ // S36
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(22), // ␚, reduce: Property
+			nil,        // ␚
 			nil,        // empty
-			reduce(22), // terminator, reduce: Property
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(22), // bracketBegin, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(22), // symbol, reduce: Property
-			reduce(22), // rest, reduce: Property
-			reduce(22), // propSharp, reduce: Property
-			reduce(22), // propFlat, reduce: Property
-			reduce(22), // propStaccato, reduce: Property
-			reduce(22), // propAccent, reduce: Property
-			reduce(22), // propMarcato, reduce: Property
-			reduce(22), // propGhost, reduce: Property
-			reduce(22), // uint, reduce: Property
-			reduce(22), // propDot, reduce: Property
-			reduce(22), // propTuplet, reduce: Property
-			reduce(22), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			shift(103), // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S40
+	actionRow{ // S37
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(23), // ␚, reduce: Property
+			reduce(58), // ␚, reduce: Command
 			nil,        // empty
-			reduce(23), // terminator, reduce: Property
+			reduce(58), // terminator, reduce: Command
+			reduce(58), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(23), // bracketBegin, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(23), // symbol, reduce: Property
-			reduce(23), // rest, reduce: Property
-			reduce(23), // propSharp, reduce: Property
-			reduce(23), // propFlat, reduce: Property
-			reduce(23), // propStaccato, reduce: Property
-			reduce(23), // propAccent, reduce: Property
-			reduce(23), // propMarcato, reduce: Property
-			reduce(23), // propGhost, reduce: Property
-			reduce(23), // uint, reduce: Property
-			reduce(23), // propDot, reduce: Property
-			reduce(23), // propTuplet, reduce: Property
-			reduce(23), // propLetRing, reduce: Property
-			nil,        // cmdAssign
-			nil,        // cmdPlay
-			nil,        // cmdTempo
-			nil,        // cmdKey
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S41
+	actionRow{ // S38
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(24), // ␚, reduce: Property
+			reduce(59), // ␚, reduce: Command
 			nil,        // empty
-			reduce(24), // terminator, reduce: Property
+			reduce(59), // terminator, reduce: Command
+			reduce(59), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(24), // bracketBegin, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(24), // symbol, reduce: Property
-			reduce(24), // rest, reduce: Property
-			reduce(24), // propSharp, reduce: Property
-			reduce(24), // propFlat, reduce: Property
-			reduce(24), // propStaccato, reduce: Property
-			reduce(24), // propAccent, reduce: Property
-			reduce(24), // propMarcato, reduce: Property
-			reduce(24), // propGhost, reduce: Property
-			reduce(24), // uint, reduce: Property
-			reduce(24), // propDot, reduce: Property
-			reduce(24), // propTuplet, reduce: Property
-			reduce(24), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S42
+	actionRow{ // S39
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(25), // ␚, reduce: Property
+			reduce(60), // ␚, reduce: Command
 			nil,        // empty
-			reduce(25), // terminator, reduce: Property
+			reduce(60), // terminator, reduce: Command
+			reduce(60), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(25), // bracketBegin, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(25), // symbol, reduce: Property
-			reduce(25), // rest, reduce: Property
-			reduce(25), // propSharp, reduce: Property
-			reduce(25), // propFlat, reduce: Property
-			reduce(25), // propStaccato, reduce: Property
-			reduce(25), // propAccent, reduce: Property
-			reduce(25), // propMarcato, reduce: Property
-			reduce(25), // propGhost, reduce: Property
-			reduce(25), // uint, reduce: Property
-			reduce(25), // propDot, reduce: Property
-			reduce(25), // propTuplet, reduce: Property
-			reduce(25), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S43
+	actionRow{ // S40
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(26), // ␚, reduce: Property
+			nil,        // ␚
 			nil,        // empty
-			reduce(26), // terminator, reduce: Property
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(26), // bracketBegin, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(26), // symbol, reduce: Property
-			reduce(26), // rest, reduce: Property
-			reduce(26), // propSharp, reduce: Property
-			reduce(26), // propFlat, reduce: Property
-			reduce(26), // propStaccato, reduce: Property
-			reduce(26), // propAccent, reduce: Property
-			reduce(26), // propMarcato, reduce: Property
-			reduce(26), // propGhost, reduce: Property
-			reduce(26), // uint, reduce: Property
-			reduce(26), // propDot, reduce: Property
-			reduce(26), // propTuplet, reduce: Property
-			reduce(26), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(104), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S44
+	actionRow{ // S41
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(27), // ␚, reduce: Property
+			reduce(62), // ␚, reduce: Command
 			nil,        // empty
-			reduce(27), // terminator, reduce: Property
+			reduce(62), // terminator, reduce: Command
+			reduce(62), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(27), // bracketBegin, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(27), // symbol, reduce: Property
-			reduce(27), // rest, reduce: Property
-			reduce(27), // propSharp, reduce: Property
-			reduce(27), // propFlat, reduce: Property
-			reduce(27), // propStaccato, reduce: Property
-			reduce(27), // propAccent, reduce: Property
-			reduce(27), // propMarcato, reduce: Property
-			reduce(27), // propGhost, reduce: Property
-			reduce(27), // uint, reduce: Property
-			reduce(27), // propDot, reduce: Property
-			reduce(27), // propTuplet, reduce: Property
-			reduce(27), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S45
+	actionRow{ // S42
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(28), // ␚, reduce: Property
+			nil,        // ␚
 			nil,        // empty
-			reduce(28), // terminator, reduce: Property
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(28), // bracketBegin, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(28), // symbol, reduce: Property
-			reduce(28), // rest, reduce: Property
-			reduce(28), // propSharp, reduce: Property
-			reduce(28), // propFlat, reduce: Property
-			reduce(28), // propStaccato, reduce: Property
-			reduce(28), // propAccent, reduce: Property
-			reduce(28), // propMarcato, reduce: Property
-			reduce(28), // propGhost, reduce: Property
-			reduce(28), // uint, reduce: Property
-			reduce(28), // propDot, reduce: Property
-			reduce(28), // propTuplet, reduce: Property
-			reduce(28), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			shift(105), // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S46
+	actionRow{ // S43
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(29), // ␚, reduce: Property
+			nil,        // ␚
 			nil,        // empty
-			reduce(29), // terminator, reduce: Property
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(29), // bracketBegin, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(29), // symbol, reduce: Property
-			reduce(29), // rest, reduce: Property
-			reduce(29), // propSharp, reduce: Property
-			reduce(29), // propFlat, reduce: Property
-			reduce(29), // propStaccato, reduce: Property
-			reduce(29), // propAccent, reduce: Property
-			reduce(29), // propMarcato, reduce: Property
-			reduce(29), // propGhost, reduce: Property
-			reduce(29), // uint, reduce: Property
-			reduce(29), // propDot, reduce: Property
-			reduce(29), // propTuplet, reduce: Property
-			reduce(29), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(106), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S47
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			nil,       // cmdBar
-			nil,       // cmdEnd
-			nil,       // bracketBegin
-			shift(91), // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
-		},
-	},
-	actionRow{ // S48
+	actionRow{ // S44
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
 			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			shift(51),  // bracketBegin
-			reduce(11), // bracketEnd, reduce: NoteList
-			shift(52),  // symbol
-			shift(53),  // rest
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			nil,        // uint
+			shift(107), // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S49
+	actionRow{ // S45
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(68), // ␚, reduce: Command
 			nil,        // empty
-			nil,        // terminator
+			reduce(68), // terminator, reduce: Command
+			reduce(68), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(18), // bracketBegin, reduce: PropertyList
-			reduce(18), // bracketEnd, reduce: PropertyList
-			reduce(18), // symbol, reduce: PropertyList
-			reduce(18), // rest, reduce: PropertyList
-			shift(95),  // propSharp
-			shift(96),  // propFlat
-			shift(97),  // propStaccato
-			shift(98),  // propAccent
-			shift(99),  // propMarcato
-			shift(100), // propGhost
-			shift(101), // uint
-			shift(102), // propDot
-			shift(103), // propTuplet
-			shift(104), // propLetRing
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S50
+	actionRow{ // S46
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(69), // ␚, reduce: Command
 			nil,        // empty
-			nil,        // terminator
+			reduce(69), // terminator, reduce: Command
+			reduce(69), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(14), // bracketBegin, reduce: NoteObject
-			reduce(14), // bracketEnd, reduce: NoteObject
-			reduce(14), // symbol, reduce: NoteObject
-			reduce(14), // rest, reduce: NoteObject
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -1934,188 +3398,290 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S51
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			nil,       // cmdBar
-			nil,       // cmdEnd
-			shift(51), // bracketBegin
-			nil,       // bracketEnd
-			shift(52), // symbol
-			shift(53), // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
-		},
-	},
-	actionRow{ // S52
+	actionRow{ // S47
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(70), // ␚, reduce: Command
 			nil,        // empty
-			nil,        // terminator
+			reduce(70), // terminator, reduce: Command
+			reduce(70), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(16), // bracketBegin, reduce: NoteSymbol
-			reduce(16), // bracketEnd, reduce: NoteSymbol
-			reduce(16), // symbol, reduce: NoteSymbol
-			reduce(16), // rest, reduce: NoteSymbol
-			reduce(16), // propSharp, reduce: NoteSymbol
-			reduce(16), // propFlat, reduce: NoteSymbol
-			reduce(16), // propStaccato, reduce: NoteSymbol
-			reduce(16), // propAccent, reduce: NoteSymbol
-			reduce(16), // propMarcato, reduce: NoteSymbol
-			reduce(16), // propGhost, reduce: NoteSymbol
-			reduce(16), // uint, reduce: NoteSymbol
-			reduce(16), // propDot, reduce: NoteSymbol
-			reduce(16), // propTuplet, reduce: NoteSymbol
-			reduce(16), // propLetRing, reduce: NoteSymbol
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S53
+	actionRow{ // S48
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(71), // ␚, reduce: Command
 			nil,        // empty
-			nil,        // terminator
+			reduce(71), // terminator, reduce: Command
+			reduce(71), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(17), // bracketBegin, reduce: NoteSymbol
-			reduce(17), // bracketEnd, reduce: NoteSymbol
-			reduce(17), // symbol, reduce: NoteSymbol
-			reduce(17), // rest, reduce: NoteSymbol
-			reduce(17), // propSharp, reduce: NoteSymbol
-			reduce(17), // propFlat, reduce: NoteSymbol
-			reduce(17), // propStaccato, reduce: NoteSymbol
-			reduce(17), // propAccent, reduce: NoteSymbol
-			reduce(17), // propMarcato, reduce: NoteSymbol
-			reduce(17), // propGhost, reduce: NoteSymbol
-			reduce(17), // uint, reduce: NoteSymbol
-			reduce(17), // propDot, reduce: NoteSymbol
-			reduce(17), // propTuplet, reduce: NoteSymbol
-			reduce(17), // propLetRing, reduce: NoteSymbol
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S54
+	actionRow{ // S49
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(72), // ␚, reduce: Command
 			nil,        // empty
-			nil,        // terminator
+			reduce(72), // terminator, reduce: Command
+			reduce(72), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			shift(106), // uint
+			nil,        // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S55
+	actionRow{ // S50
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(32), // ␚, reduce: Command
+			reduce(73), // ␚, reduce: Command
 			nil,        // empty
-			reduce(32), // terminator, reduce: Command
+			reduce(73), // terminator, reduce: Command
+			reduce(73), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -2124,74 +3690,144 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S56
+	actionRow{ // S51
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(74), // ␚, reduce: Command
 			nil,        // empty
-			nil,        // terminator
+			reduce(74), // terminator, reduce: Command
+			reduce(74), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			shift(107), // uint
+			nil,        // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S57
+	actionRow{ // S52
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(35), // ␚, reduce: Command
+			nil,        // ␚
 			nil,        // empty
-			reduce(35), // terminator, reduce: Command
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -2200,74 +3836,144 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			shift(108), // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S58
+	actionRow{ // S53
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(36), // ␚, reduce: Command
+			nil,        // ␚
 			nil,        // empty
-			reduce(36), // terminator, reduce: Command
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			nil,        // uint
+			shift(109), // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S59
+	actionRow{ // S54
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(37), // ␚, reduce: Command
+			reduce(77), // ␚, reduce: Command
 			nil,        // empty
-			reduce(37), // terminator, reduce: Command
+			reduce(77), // terminator, reduce: Command
+			reduce(77), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -2276,36 +3982,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S60
+	actionRow{ // S55
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(38), // ␚, reduce: Command
+			reduce(78), // ␚, reduce: Command
 			nil,        // empty
-			reduce(38), // terminator, reduce: Command
+			reduce(78), // terminator, reduce: Command
+			reduce(78), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -2314,150 +4055,144 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S61
+	actionRow{ // S56
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(79), // ␚, reduce: Command
 			nil,        // empty
-			nil,        // terminator
+			reduce(79), // terminator, reduce: Command
+			reduce(79), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			shift(108), // uint
+			nil,        // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S62
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(3), // ␚, reduce: RepeatTerminator
-			nil,       // empty
-			nil,       // terminator
-			shift(10), // cmdBar
-			nil,       // cmdEnd
-			shift(14), // bracketBegin
-			nil,       // bracketEnd
-			shift(15), // symbol
-			shift(16), // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			shift(17), // cmdAssign
-			shift(18), // cmdPlay
-			shift(19), // cmdTempo
-			shift(20), // cmdKey
-			shift(21), // cmdTime
-			shift(22), // cmdVelocity
-			shift(23), // cmdChannel
-			shift(24), // cmdVoice
-			shift(25), // cmdProgram
-			shift(26), // cmdControl
-			shift(27), // cmdStart
-			shift(28), // cmdStop
-			shift(29), // blockComment
-		},
-	},
-	actionRow{ // S63
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(2), // ␚, reduce: RepeatTerminator
-			nil,       // empty
-			shift(63), // terminator
-			reduce(2), // cmdBar, reduce: RepeatTerminator
-			nil,       // cmdEnd
-			reduce(2), // bracketBegin, reduce: RepeatTerminator
-			nil,       // bracketEnd
-			reduce(2), // symbol, reduce: RepeatTerminator
-			reduce(2), // rest, reduce: RepeatTerminator
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			reduce(2), // cmdAssign, reduce: RepeatTerminator
-			reduce(2), // cmdPlay, reduce: RepeatTerminator
-			reduce(2), // cmdTempo, reduce: RepeatTerminator
-			reduce(2), // cmdKey, reduce: RepeatTerminator
-			reduce(2), // cmdTime, reduce: RepeatTerminator
-			reduce(2), // cmdVelocity, reduce: RepeatTerminator
-			reduce(2), // cmdChannel, reduce: RepeatTerminator
-			reduce(2), // cmdVoice, reduce: RepeatTerminator
-			reduce(2), // cmdProgram, reduce: RepeatTerminator
-			reduce(2), // cmdControl, reduce: RepeatTerminator
-			reduce(2), // cmdStart, reduce: RepeatTerminator
-			reduce(2), // cmdStop, reduce: RepeatTerminator
-			reduce(2), // blockComment, reduce: RepeatTerminator
-		},
-	},
-	actionRow{ // S64
+	actionRow{ // S57
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(80), // ␚, reduce: Command
 			nil,        // empty
-			nil,        // terminator
+			reduce(80), // terminator, reduce: Command
+			reduce(80), // lineComment, reduce: Command
 			nil,        // cmdBar
-			shift(111), // cmdEnd
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -2466,36 +4201,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S65
+	actionRow{ // S58
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(81), // ␚, reduce: Comment
 			nil,        // empty
-			shift(113), // terminator
+			reduce(81), // terminator, reduce: Comment
+			reduce(81), // lineComment, reduce: Comment
 			nil,        // cmdBar
-			reduce(2),  // cmdEnd, reduce: RepeatTerminator
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -2504,74 +4274,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S66
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			reduce(6), // terminator, reduce: Decl
-			nil,       // cmdBar
-			reduce(6), // cmdEnd, reduce: Decl
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
-		},
-	},
-	actionRow{ // S67
+	actionRow{ // S59
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
-			reduce(7), // terminator, reduce: Decl
-			nil,       // cmdBar
-			reduce(7), // cmdEnd, reduce: Decl
-			nil,       // bracketBegin
+			nil,       // terminator
+			reduce(3), // lineComment, reduce: RepeatTerminator
+			reduce(3), // cmdBar, reduce: RepeatTerminator
+			nil,       // cmdEnd
+			reduce(3), // chord, reduce: RepeatTerminator
+			reduce(3), // pitch, reduce: RepeatTerminator
+			reduce(3), // degree, reduce: RepeatTerminator
+			reduce(3), // bracketBegin, reduce: RepeatTerminator
 			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
+			reduce(3), // symbol, reduce: RepeatTerminator
+			reduce(3), // rest, reduce: RepeatTerminator
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -2580,36 +4347,71 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			reduce(3), // cmdRepeat, reduce: RepeatTerminator
+			reduce(3), // cmdAssign, reduce: RepeatTerminator
+			reduce(3), // cmdKit, reduce: RepeatTerminator
+			reduce(3), // cmdPlay, reduce: RepeatTerminator
+			reduce(3), // cmdTempo, reduce: RepeatTerminator
+			nil,       // arrow
+			reduce(3), // cmdKey, reduce: RepeatTerminator
+			reduce(3), // cmdScale, reduce: RepeatTerminator
+			reduce(3), // cmdTime, reduce: RepeatTerminator
+			reduce(3), // cmdMeter, reduce: RepeatTerminator
+			reduce(3), // cmdVelocity, reduce: RepeatTerminator
+			reduce(3), // cmdOctave, reduce: RepeatTerminator
+			reduce(3), // cmdChannel, reduce: RepeatTerminator
+			reduce(3), // cmdVoice, reduce: RepeatTerminator
+			reduce(3), // cmdProgram, reduce: RepeatTerminator
+			reduce(3), // cmdProgramName, reduce: RepeatTerminator
+			nil,       // string
+			reduce(3), // cmdControl, reduce: RepeatTerminator
+			reduce(3), // cmdControlRamp, reduce: RepeatTerminator
+			reduce(3), // cmdBend, reduce: RepeatTerminator
+			reduce(3), // cmdPressure, reduce: RepeatTerminator
+			reduce(3), // cmdSysex, reduce: RepeatTerminator
+			reduce(3), // cmdSysexFile, reduce: RepeatTerminator
+			reduce(3), // cmdRPN, reduce: RepeatTerminator
+			reduce(3), // cmdNRPN, reduce: RepeatTerminator
+			reduce(3), // cmdSwing, reduce: RepeatTerminator
+			reduce(3), // cmdGroove, reduce: RepeatTerminator
+			reduce(3), // cmdHumanize, reduce: RepeatTerminator
+			reduce(3), // cmdStart, reduce: RepeatTerminator
+			reduce(3), // cmdStop, reduce: RepeatTerminator
+			reduce(3), // cmdPickup, reduce: RepeatTerminator
+			reduce(3), // cmdStaff, reduce: RepeatTerminator
+			reduce(3), // cmdInclude, reduce: RepeatTerminator
+			reduce(3), // cmdVolta, reduce: RepeatTerminator
+			reduce(3), // cmdDyn, reduce: RepeatTerminator
+			reduce(3), // cmdDynamics, reduce: RepeatTerminator
+			reduce(3), // cmdCresc, reduce: RepeatTerminator
+			reduce(3), // cmdDim, reduce: RepeatTerminator
+			reduce(3), // blockComment, reduce: RepeatTerminator
 		},
 	},
-	actionRow{ // S68
+	actionRow{ // S60
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // ␚
+			reduce(6), // ␚, reduce: DeclList
 			nil,       // empty
-			reduce(8), // terminator, reduce: Decl
+			nil,       // terminator
+			nil,       // lineComment
 			nil,       // cmdBar
-			reduce(8), // cmdEnd, reduce: Decl
+			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
 			nil,       // bracketBegin
 			nil,       // bracketEnd
 			nil,       // symbol
 			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -2618,112 +4420,363 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
-	actionRow{ // S69
+	actionRow{ // S61
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			reduce(9), // terminator, reduce: Decl
-			nil,       // cmdBar
-			reduce(9), // cmdEnd, reduce: Decl
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
+			nil,        // INVALID
+			reduce(2),  // ␚, reduce: RepeatTerminator
+			nil,        // empty
+			shift(111), // terminator
+			reduce(2),  // lineComment, reduce: RepeatTerminator
+			reduce(2),  // cmdBar, reduce: RepeatTerminator
+			nil,        // cmdEnd
+			reduce(2),  // chord, reduce: RepeatTerminator
+			reduce(2),  // pitch, reduce: RepeatTerminator
+			reduce(2),  // degree, reduce: RepeatTerminator
+			reduce(2),  // bracketBegin, reduce: RepeatTerminator
+			nil,        // bracketEnd
+			reduce(2),  // symbol, reduce: RepeatTerminator
+			reduce(2),  // rest, reduce: RepeatTerminator
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			reduce(2),  // cmdRepeat, reduce: RepeatTerminator
+			reduce(2),  // cmdAssign, reduce: RepeatTerminator
+			reduce(2),  // cmdKit, reduce: RepeatTerminator
+			reduce(2),  // cmdPlay, reduce: RepeatTerminator
+			reduce(2),  // cmdTempo, reduce: RepeatTerminator
+			nil,        // arrow
+			reduce(2),  // cmdKey, reduce: RepeatTerminator
+			reduce(2),  // cmdScale, reduce: RepeatTerminator
+			reduce(2),  // cmdTime, reduce: RepeatTerminator
+			reduce(2),  // cmdMeter, reduce: RepeatTerminator
+			reduce(2),  // cmdVelocity, reduce: RepeatTerminator
+			reduce(2),  // cmdOctave, reduce: RepeatTerminator
+			reduce(2),  // cmdChannel, reduce: RepeatTerminator
+			reduce(2),  // cmdVoice, reduce: RepeatTerminator
+			reduce(2),  // cmdProgram, reduce: RepeatTerminator
+			reduce(2),  // cmdProgramName, reduce: RepeatTerminator
+			nil,        // string
+			reduce(2),  // cmdControl, reduce: RepeatTerminator
+			reduce(2),  // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2),  // cmdBend, reduce: RepeatTerminator
+			reduce(2),  // cmdPressure, reduce: RepeatTerminator
+			reduce(2),  // cmdSysex, reduce: RepeatTerminator
+			reduce(2),  // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2),  // cmdRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdNRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdSwing, reduce: RepeatTerminator
+			reduce(2),  // cmdGroove, reduce: RepeatTerminator
+			reduce(2),  // cmdHumanize, reduce: RepeatTerminator
+			reduce(2),  // cmdStart, reduce: RepeatTerminator
+			reduce(2),  // cmdStop, reduce: RepeatTerminator
+			reduce(2),  // cmdPickup, reduce: RepeatTerminator
+			reduce(2),  // cmdStaff, reduce: RepeatTerminator
+			reduce(2),  // cmdInclude, reduce: RepeatTerminator
+			reduce(2),  // cmdVolta, reduce: RepeatTerminator
+			reduce(2),  // cmdDyn, reduce: RepeatTerminator
+			reduce(2),  // cmdDynamics, reduce: RepeatTerminator
+			reduce(2),  // cmdCresc, reduce: RepeatTerminator
+			reduce(2),  // cmdDim, reduce: RepeatTerminator
+			reduce(2),  // blockComment, reduce: RepeatTerminator
 		},
 	},
-	actionRow{ // S70
+	actionRow{ // S62
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			shift(3),  // terminator
-			reduce(2), // cmdBar, reduce: RepeatTerminator
-			nil,       // cmdEnd
-			reduce(2), // bracketBegin, reduce: RepeatTerminator
-			nil,       // bracketEnd
-			reduce(2), // symbol, reduce: RepeatTerminator
-			reduce(2), // rest, reduce: RepeatTerminator
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			reduce(2), // cmdAssign, reduce: RepeatTerminator
-			reduce(2), // cmdPlay, reduce: RepeatTerminator
-			reduce(2), // cmdTempo, reduce: RepeatTerminator
-			reduce(2), // cmdKey, reduce: RepeatTerminator
-			reduce(2), // cmdTime, reduce: RepeatTerminator
-			reduce(2), // cmdVelocity, reduce: RepeatTerminator
-			reduce(2), // cmdChannel, reduce: RepeatTerminator
-			reduce(2), // cmdVoice, reduce: RepeatTerminator
-			reduce(2), // cmdProgram, reduce: RepeatTerminator
-			reduce(2), // cmdControl, reduce: RepeatTerminator
-			reduce(2), // cmdStart, reduce: RepeatTerminator
-			reduce(2), // cmdStop, reduce: RepeatTerminator
-			reduce(2), // blockComment, reduce: RepeatTerminator
+			nil,        // INVALID
+			reduce(2),  // ␚, reduce: RepeatTerminator
+			nil,        // empty
+			shift(113), // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
 		},
 	},
-	actionRow{ // S71
+	actionRow{ // S63
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(11), // terminator, reduce: NoteList
+			nil,        // terminator
+			shift(116), // lineComment
+			shift(122), // cmdBar
+			nil,        // cmdEnd
+			shift(125), // chord
+			shift(126), // pitch
+			shift(127), // degree
+			shift(129), // bracketBegin
+			nil,        // bracketEnd
+			shift(130), // symbol
+			shift(131), // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			shift(132), // cmdRepeat
+			shift(133), // cmdAssign
+			shift(134), // cmdKit
+			shift(135), // cmdPlay
+			shift(136), // cmdTempo
+			nil,        // arrow
+			shift(137), // cmdKey
+			shift(138), // cmdScale
+			shift(139), // cmdTime
+			shift(140), // cmdMeter
+			shift(141), // cmdVelocity
+			shift(142), // cmdOctave
+			shift(143), // cmdChannel
+			shift(144), // cmdVoice
+			shift(145), // cmdProgram
+			shift(146), // cmdProgramName
+			nil,        // string
+			shift(147), // cmdControl
+			shift(148), // cmdControlRamp
+			shift(149), // cmdBend
+			shift(150), // cmdPressure
+			shift(151), // cmdSysex
+			shift(152), // cmdSysexFile
+			shift(153), // cmdRPN
+			shift(154), // cmdNRPN
+			shift(155), // cmdSwing
+			shift(156), // cmdGroove
+			shift(157), // cmdHumanize
+			shift(158), // cmdStart
+			shift(159), // cmdStop
+			shift(160), // cmdPickup
+			shift(161), // cmdStaff
+			shift(162), // cmdInclude
+			shift(163), // cmdVolta
+			shift(164), // cmdDyn
+			shift(165), // cmdDynamics
+			shift(166), // cmdCresc
+			shift(167), // cmdDim
+			shift(168), // blockComment
+		},
+	},
+	actionRow{ // S64
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(15), // ␚, reduce: NoteList
+			nil,        // empty
+			reduce(15), // terminator, reduce: NoteList
+			reduce(15), // lineComment, reduce: NoteList
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S65
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(16), // ␚, reduce: NoteObject
+			nil,        // empty
+			reduce(16), // terminator, reduce: NoteObject
+			reduce(16), // lineComment, reduce: NoteObject
 			nil,        // cmdBar
-			reduce(11), // cmdEnd, reduce: NoteList
-			shift(74),  // bracketBegin
+			nil,        // cmdEnd
+			reduce(16), // chord, reduce: NoteObject
+			reduce(16), // pitch, reduce: NoteObject
+			reduce(16), // degree, reduce: NoteObject
+			reduce(16), // bracketBegin, reduce: NoteObject
 			nil,        // bracketEnd
-			shift(75),  // symbol
-			shift(76),  // rest
+			reduce(16), // symbol, reduce: NoteObject
+			reduce(16), // rest, reduce: NoteObject
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -2732,74 +4785,10072 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S66
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(24), // ␚, reduce: PropertyList
+			nil,        // empty
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			nil,        // bracketEnd
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(67),  // propSharp
+			shift(68),  // propFlat
+			shift(69),  // propOctaveUp
+			shift(70),  // propOctaveDown
+			shift(71),  // propStaccato
+			shift(72),  // propAccent
+			shift(73),  // propMarcato
+			shift(74),  // propGhost
+			shift(75),  // uint
+			shift(76),  // propDot
+			shift(77),  // propTuplet
+			shift(78),  // propLetRing
+			shift(79),  // propTie
+			shift(80),  // propAftertouch
+			shift(81),  // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S67
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(26), // ␚, reduce: Property
+			nil,        // empty
+			reduce(26), // terminator, reduce: Property
+			reduce(26), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(26), // chord, reduce: Property
+			reduce(26), // pitch, reduce: Property
+			reduce(26), // degree, reduce: Property
+			reduce(26), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(26), // symbol, reduce: Property
+			reduce(26), // rest, reduce: Property
+			reduce(26), // propSharp, reduce: Property
+			reduce(26), // propFlat, reduce: Property
+			reduce(26), // propOctaveUp, reduce: Property
+			reduce(26), // propOctaveDown, reduce: Property
+			reduce(26), // propStaccato, reduce: Property
+			reduce(26), // propAccent, reduce: Property
+			reduce(26), // propMarcato, reduce: Property
+			reduce(26), // propGhost, reduce: Property
+			reduce(26), // uint, reduce: Property
+			reduce(26), // propDot, reduce: Property
+			reduce(26), // propTuplet, reduce: Property
+			reduce(26), // propLetRing, reduce: Property
+			reduce(26), // propTie, reduce: Property
+			reduce(26), // propAftertouch, reduce: Property
+			reduce(26), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S72
+	actionRow{ // S68
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(27), // ␚, reduce: Property
+			nil,        // empty
+			reduce(27), // terminator, reduce: Property
+			reduce(27), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(27), // chord, reduce: Property
+			reduce(27), // pitch, reduce: Property
+			reduce(27), // degree, reduce: Property
+			reduce(27), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(27), // symbol, reduce: Property
+			reduce(27), // rest, reduce: Property
+			reduce(27), // propSharp, reduce: Property
+			reduce(27), // propFlat, reduce: Property
+			reduce(27), // propOctaveUp, reduce: Property
+			reduce(27), // propOctaveDown, reduce: Property
+			reduce(27), // propStaccato, reduce: Property
+			reduce(27), // propAccent, reduce: Property
+			reduce(27), // propMarcato, reduce: Property
+			reduce(27), // propGhost, reduce: Property
+			reduce(27), // uint, reduce: Property
+			reduce(27), // propDot, reduce: Property
+			reduce(27), // propTuplet, reduce: Property
+			reduce(27), // propLetRing, reduce: Property
+			reduce(27), // propTie, reduce: Property
+			reduce(27), // propAftertouch, reduce: Property
+			reduce(27), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S69
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(28), // ␚, reduce: Property
+			nil,        // empty
+			reduce(28), // terminator, reduce: Property
+			reduce(28), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(28), // chord, reduce: Property
+			reduce(28), // pitch, reduce: Property
+			reduce(28), // degree, reduce: Property
+			reduce(28), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(28), // symbol, reduce: Property
+			reduce(28), // rest, reduce: Property
+			reduce(28), // propSharp, reduce: Property
+			reduce(28), // propFlat, reduce: Property
+			reduce(28), // propOctaveUp, reduce: Property
+			reduce(28), // propOctaveDown, reduce: Property
+			reduce(28), // propStaccato, reduce: Property
+			reduce(28), // propAccent, reduce: Property
+			reduce(28), // propMarcato, reduce: Property
+			reduce(28), // propGhost, reduce: Property
+			reduce(28), // uint, reduce: Property
+			reduce(28), // propDot, reduce: Property
+			reduce(28), // propTuplet, reduce: Property
+			reduce(28), // propLetRing, reduce: Property
+			reduce(28), // propTie, reduce: Property
+			reduce(28), // propAftertouch, reduce: Property
+			reduce(28), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S70
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(29), // ␚, reduce: Property
+			nil,        // empty
+			reduce(29), // terminator, reduce: Property
+			reduce(29), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(29), // chord, reduce: Property
+			reduce(29), // pitch, reduce: Property
+			reduce(29), // degree, reduce: Property
+			reduce(29), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(29), // symbol, reduce: Property
+			reduce(29), // rest, reduce: Property
+			reduce(29), // propSharp, reduce: Property
+			reduce(29), // propFlat, reduce: Property
+			reduce(29), // propOctaveUp, reduce: Property
+			reduce(29), // propOctaveDown, reduce: Property
+			reduce(29), // propStaccato, reduce: Property
+			reduce(29), // propAccent, reduce: Property
+			reduce(29), // propMarcato, reduce: Property
+			reduce(29), // propGhost, reduce: Property
+			reduce(29), // uint, reduce: Property
+			reduce(29), // propDot, reduce: Property
+			reduce(29), // propTuplet, reduce: Property
+			reduce(29), // propLetRing, reduce: Property
+			reduce(29), // propTie, reduce: Property
+			reduce(29), // propAftertouch, reduce: Property
+			reduce(29), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S71
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(30), // ␚, reduce: Property
+			nil,        // empty
+			reduce(30), // terminator, reduce: Property
+			reduce(30), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(30), // chord, reduce: Property
+			reduce(30), // pitch, reduce: Property
+			reduce(30), // degree, reduce: Property
+			reduce(30), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(30), // symbol, reduce: Property
+			reduce(30), // rest, reduce: Property
+			reduce(30), // propSharp, reduce: Property
+			reduce(30), // propFlat, reduce: Property
+			reduce(30), // propOctaveUp, reduce: Property
+			reduce(30), // propOctaveDown, reduce: Property
+			reduce(30), // propStaccato, reduce: Property
+			reduce(30), // propAccent, reduce: Property
+			reduce(30), // propMarcato, reduce: Property
+			reduce(30), // propGhost, reduce: Property
+			reduce(30), // uint, reduce: Property
+			reduce(30), // propDot, reduce: Property
+			reduce(30), // propTuplet, reduce: Property
+			reduce(30), // propLetRing, reduce: Property
+			reduce(30), // propTie, reduce: Property
+			reduce(30), // propAftertouch, reduce: Property
+			reduce(30), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S72
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(31), // ␚, reduce: Property
+			nil,        // empty
+			reduce(31), // terminator, reduce: Property
+			reduce(31), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(31), // chord, reduce: Property
+			reduce(31), // pitch, reduce: Property
+			reduce(31), // degree, reduce: Property
+			reduce(31), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(31), // symbol, reduce: Property
+			reduce(31), // rest, reduce: Property
+			reduce(31), // propSharp, reduce: Property
+			reduce(31), // propFlat, reduce: Property
+			reduce(31), // propOctaveUp, reduce: Property
+			reduce(31), // propOctaveDown, reduce: Property
+			reduce(31), // propStaccato, reduce: Property
+			reduce(31), // propAccent, reduce: Property
+			reduce(31), // propMarcato, reduce: Property
+			reduce(31), // propGhost, reduce: Property
+			reduce(31), // uint, reduce: Property
+			reduce(31), // propDot, reduce: Property
+			reduce(31), // propTuplet, reduce: Property
+			reduce(31), // propLetRing, reduce: Property
+			reduce(31), // propTie, reduce: Property
+			reduce(31), // propAftertouch, reduce: Property
+			reduce(31), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S73
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(32), // ␚, reduce: Property
+			nil,        // empty
+			reduce(32), // terminator, reduce: Property
+			reduce(32), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(32), // chord, reduce: Property
+			reduce(32), // pitch, reduce: Property
+			reduce(32), // degree, reduce: Property
+			reduce(32), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(32), // symbol, reduce: Property
+			reduce(32), // rest, reduce: Property
+			reduce(32), // propSharp, reduce: Property
+			reduce(32), // propFlat, reduce: Property
+			reduce(32), // propOctaveUp, reduce: Property
+			reduce(32), // propOctaveDown, reduce: Property
+			reduce(32), // propStaccato, reduce: Property
+			reduce(32), // propAccent, reduce: Property
+			reduce(32), // propMarcato, reduce: Property
+			reduce(32), // propGhost, reduce: Property
+			reduce(32), // uint, reduce: Property
+			reduce(32), // propDot, reduce: Property
+			reduce(32), // propTuplet, reduce: Property
+			reduce(32), // propLetRing, reduce: Property
+			reduce(32), // propTie, reduce: Property
+			reduce(32), // propAftertouch, reduce: Property
+			reduce(32), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S74
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(33), // ␚, reduce: Property
+			nil,        // empty
+			reduce(33), // terminator, reduce: Property
+			reduce(33), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(33), // chord, reduce: Property
+			reduce(33), // pitch, reduce: Property
+			reduce(33), // degree, reduce: Property
+			reduce(33), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(33), // symbol, reduce: Property
+			reduce(33), // rest, reduce: Property
+			reduce(33), // propSharp, reduce: Property
+			reduce(33), // propFlat, reduce: Property
+			reduce(33), // propOctaveUp, reduce: Property
+			reduce(33), // propOctaveDown, reduce: Property
+			reduce(33), // propStaccato, reduce: Property
+			reduce(33), // propAccent, reduce: Property
+			reduce(33), // propMarcato, reduce: Property
+			reduce(33), // propGhost, reduce: Property
+			reduce(33), // uint, reduce: Property
+			reduce(33), // propDot, reduce: Property
+			reduce(33), // propTuplet, reduce: Property
+			reduce(33), // propLetRing, reduce: Property
+			reduce(33), // propTie, reduce: Property
+			reduce(33), // propAftertouch, reduce: Property
+			reduce(33), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S75
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(34), // ␚, reduce: Property
+			nil,        // empty
+			reduce(34), // terminator, reduce: Property
+			reduce(34), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(34), // chord, reduce: Property
+			reduce(34), // pitch, reduce: Property
+			reduce(34), // degree, reduce: Property
+			reduce(34), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(34), // symbol, reduce: Property
+			reduce(34), // rest, reduce: Property
+			reduce(34), // propSharp, reduce: Property
+			reduce(34), // propFlat, reduce: Property
+			reduce(34), // propOctaveUp, reduce: Property
+			reduce(34), // propOctaveDown, reduce: Property
+			reduce(34), // propStaccato, reduce: Property
+			reduce(34), // propAccent, reduce: Property
+			reduce(34), // propMarcato, reduce: Property
+			reduce(34), // propGhost, reduce: Property
+			reduce(34), // uint, reduce: Property
+			reduce(34), // propDot, reduce: Property
+			reduce(34), // propTuplet, reduce: Property
+			reduce(34), // propLetRing, reduce: Property
+			reduce(34), // propTie, reduce: Property
+			reduce(34), // propAftertouch, reduce: Property
+			reduce(34), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S76
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(35), // ␚, reduce: Property
+			nil,        // empty
+			reduce(35), // terminator, reduce: Property
+			reduce(35), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(35), // chord, reduce: Property
+			reduce(35), // pitch, reduce: Property
+			reduce(35), // degree, reduce: Property
+			reduce(35), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(35), // symbol, reduce: Property
+			reduce(35), // rest, reduce: Property
+			reduce(35), // propSharp, reduce: Property
+			reduce(35), // propFlat, reduce: Property
+			reduce(35), // propOctaveUp, reduce: Property
+			reduce(35), // propOctaveDown, reduce: Property
+			reduce(35), // propStaccato, reduce: Property
+			reduce(35), // propAccent, reduce: Property
+			reduce(35), // propMarcato, reduce: Property
+			reduce(35), // propGhost, reduce: Property
+			reduce(35), // uint, reduce: Property
+			reduce(35), // propDot, reduce: Property
+			reduce(35), // propTuplet, reduce: Property
+			reduce(35), // propLetRing, reduce: Property
+			reduce(35), // propTie, reduce: Property
+			reduce(35), // propAftertouch, reduce: Property
+			reduce(35), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S77
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(36), // ␚, reduce: Property
+			nil,        // empty
+			reduce(36), // terminator, reduce: Property
+			reduce(36), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(36), // chord, reduce: Property
+			reduce(36), // pitch, reduce: Property
+			reduce(36), // degree, reduce: Property
+			reduce(36), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(36), // symbol, reduce: Property
+			reduce(36), // rest, reduce: Property
+			reduce(36), // propSharp, reduce: Property
+			reduce(36), // propFlat, reduce: Property
+			reduce(36), // propOctaveUp, reduce: Property
+			reduce(36), // propOctaveDown, reduce: Property
+			reduce(36), // propStaccato, reduce: Property
+			reduce(36), // propAccent, reduce: Property
+			reduce(36), // propMarcato, reduce: Property
+			reduce(36), // propGhost, reduce: Property
+			reduce(36), // uint, reduce: Property
+			reduce(36), // propDot, reduce: Property
+			reduce(36), // propTuplet, reduce: Property
+			reduce(36), // propLetRing, reduce: Property
+			reduce(36), // propTie, reduce: Property
+			reduce(36), // propAftertouch, reduce: Property
+			reduce(36), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S78
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(37), // ␚, reduce: Property
+			nil,        // empty
+			reduce(37), // terminator, reduce: Property
+			reduce(37), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(37), // chord, reduce: Property
+			reduce(37), // pitch, reduce: Property
+			reduce(37), // degree, reduce: Property
+			reduce(37), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(37), // symbol, reduce: Property
+			reduce(37), // rest, reduce: Property
+			reduce(37), // propSharp, reduce: Property
+			reduce(37), // propFlat, reduce: Property
+			reduce(37), // propOctaveUp, reduce: Property
+			reduce(37), // propOctaveDown, reduce: Property
+			reduce(37), // propStaccato, reduce: Property
+			reduce(37), // propAccent, reduce: Property
+			reduce(37), // propMarcato, reduce: Property
+			reduce(37), // propGhost, reduce: Property
+			reduce(37), // uint, reduce: Property
+			reduce(37), // propDot, reduce: Property
+			reduce(37), // propTuplet, reduce: Property
+			reduce(37), // propLetRing, reduce: Property
+			reduce(37), // propTie, reduce: Property
+			reduce(37), // propAftertouch, reduce: Property
+			reduce(37), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S79
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(38), // ␚, reduce: Property
+			nil,        // empty
+			reduce(38), // terminator, reduce: Property
+			reduce(38), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(38), // chord, reduce: Property
+			reduce(38), // pitch, reduce: Property
+			reduce(38), // degree, reduce: Property
+			reduce(38), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(38), // symbol, reduce: Property
+			reduce(38), // rest, reduce: Property
+			reduce(38), // propSharp, reduce: Property
+			reduce(38), // propFlat, reduce: Property
+			reduce(38), // propOctaveUp, reduce: Property
+			reduce(38), // propOctaveDown, reduce: Property
+			reduce(38), // propStaccato, reduce: Property
+			reduce(38), // propAccent, reduce: Property
+			reduce(38), // propMarcato, reduce: Property
+			reduce(38), // propGhost, reduce: Property
+			reduce(38), // uint, reduce: Property
+			reduce(38), // propDot, reduce: Property
+			reduce(38), // propTuplet, reduce: Property
+			reduce(38), // propLetRing, reduce: Property
+			reduce(38), // propTie, reduce: Property
+			reduce(38), // propAftertouch, reduce: Property
+			reduce(38), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S80
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(39), // ␚, reduce: Property
+			nil,        // empty
+			reduce(39), // terminator, reduce: Property
+			reduce(39), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(39), // chord, reduce: Property
+			reduce(39), // pitch, reduce: Property
+			reduce(39), // degree, reduce: Property
+			reduce(39), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(39), // symbol, reduce: Property
+			reduce(39), // rest, reduce: Property
+			reduce(39), // propSharp, reduce: Property
+			reduce(39), // propFlat, reduce: Property
+			reduce(39), // propOctaveUp, reduce: Property
+			reduce(39), // propOctaveDown, reduce: Property
+			reduce(39), // propStaccato, reduce: Property
+			reduce(39), // propAccent, reduce: Property
+			reduce(39), // propMarcato, reduce: Property
+			reduce(39), // propGhost, reduce: Property
+			reduce(39), // uint, reduce: Property
+			reduce(39), // propDot, reduce: Property
+			reduce(39), // propTuplet, reduce: Property
+			reduce(39), // propLetRing, reduce: Property
+			reduce(39), // propTie, reduce: Property
+			reduce(39), // propAftertouch, reduce: Property
+			reduce(39), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S81
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(40), // ␚, reduce: Property
+			nil,        // empty
+			reduce(40), // terminator, reduce: Property
+			reduce(40), // lineComment, reduce: Property
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(40), // chord, reduce: Property
+			reduce(40), // pitch, reduce: Property
+			reduce(40), // degree, reduce: Property
+			reduce(40), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(40), // symbol, reduce: Property
+			reduce(40), // rest, reduce: Property
+			reduce(40), // propSharp, reduce: Property
+			reduce(40), // propFlat, reduce: Property
+			reduce(40), // propOctaveUp, reduce: Property
+			reduce(40), // propOctaveDown, reduce: Property
+			reduce(40), // propStaccato, reduce: Property
+			reduce(40), // propAccent, reduce: Property
+			reduce(40), // propMarcato, reduce: Property
+			reduce(40), // propGhost, reduce: Property
+			reduce(40), // uint, reduce: Property
+			reduce(40), // propDot, reduce: Property
+			reduce(40), // propTuplet, reduce: Property
+			reduce(40), // propLetRing, reduce: Property
+			reduce(40), // propTie, reduce: Property
+			reduce(40), // propAftertouch, reduce: Property
+			reduce(40), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S82
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(17), // ␚, reduce: NoteObject
+			nil,        // empty
+			reduce(17), // terminator, reduce: NoteObject
+			reduce(17), // lineComment, reduce: NoteObject
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(17), // chord, reduce: NoteObject
+			reduce(17), // pitch, reduce: NoteObject
+			reduce(17), // degree, reduce: NoteObject
+			reduce(17), // bracketBegin, reduce: NoteObject
+			nil,        // bracketEnd
+			reduce(17), // symbol, reduce: NoteObject
+			reduce(17), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S83
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(18), // ␚, reduce: NoteObject
+			nil,        // empty
+			reduce(18), // terminator, reduce: NoteObject
+			reduce(18), // lineComment, reduce: NoteObject
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(18), // chord, reduce: NoteObject
+			reduce(18), // pitch, reduce: NoteObject
+			reduce(18), // degree, reduce: NoteObject
+			reduce(18), // bracketBegin, reduce: NoteObject
+			nil,        // bracketEnd
+			reduce(18), // symbol, reduce: NoteObject
+			reduce(18), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S84
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(19), // ␚, reduce: NoteObject
+			nil,        // empty
+			reduce(19), // terminator, reduce: NoteObject
+			reduce(19), // lineComment, reduce: NoteObject
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(19), // chord, reduce: NoteObject
+			reduce(19), // pitch, reduce: NoteObject
+			reduce(19), // degree, reduce: NoteObject
+			reduce(19), // bracketBegin, reduce: NoteObject
+			nil,        // bracketEnd
+			reduce(19), // symbol, reduce: NoteObject
+			reduce(19), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S85
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			shift(170), // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S86
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			shift(88),  // chord
+			shift(89),  // pitch
+			shift(90),  // degree
+			shift(92),  // bracketBegin
+			reduce(14), // bracketEnd, reduce: NoteList
+			shift(93),  // symbol
+			shift(94),  // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S87
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			reduce(24), // bracketEnd, reduce: PropertyList
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(174), // propSharp
+			shift(175), // propFlat
+			shift(176), // propOctaveUp
+			shift(177), // propOctaveDown
+			shift(178), // propStaccato
+			shift(179), // propAccent
+			shift(180), // propMarcato
+			shift(181), // propGhost
+			shift(182), // uint
+			shift(183), // propDot
+			shift(184), // propTuplet
+			shift(185), // propLetRing
+			shift(186), // propTie
+			shift(187), // propAftertouch
+			shift(188), // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S88
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			reduce(24), // bracketEnd, reduce: PropertyList
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(174), // propSharp
+			shift(175), // propFlat
+			shift(176), // propOctaveUp
+			shift(177), // propOctaveDown
+			shift(178), // propStaccato
+			shift(179), // propAccent
+			shift(180), // propMarcato
+			shift(181), // propGhost
+			shift(182), // uint
+			shift(183), // propDot
+			shift(184), // propTuplet
+			shift(185), // propLetRing
+			shift(186), // propTie
+			shift(187), // propAftertouch
+			shift(188), // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S89
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			reduce(24), // bracketEnd, reduce: PropertyList
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(174), // propSharp
+			shift(175), // propFlat
+			shift(176), // propOctaveUp
+			shift(177), // propOctaveDown
+			shift(178), // propStaccato
+			shift(179), // propAccent
+			shift(180), // propMarcato
+			shift(181), // propGhost
+			shift(182), // uint
+			shift(183), // propDot
+			shift(184), // propTuplet
+			shift(185), // propLetRing
+			shift(186), // propTie
+			shift(187), // propAftertouch
+			shift(188), // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S90
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			reduce(24), // bracketEnd, reduce: PropertyList
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(174), // propSharp
+			shift(175), // propFlat
+			shift(176), // propOctaveUp
+			shift(177), // propOctaveDown
+			shift(178), // propStaccato
+			shift(179), // propAccent
+			shift(180), // propMarcato
+			shift(181), // propGhost
+			shift(182), // uint
+			shift(183), // propDot
+			shift(184), // propTuplet
+			shift(185), // propLetRing
+			shift(186), // propTie
+			shift(187), // propAftertouch
+			shift(188), // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S91
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(20), // chord, reduce: NoteObject
+			reduce(20), // pitch, reduce: NoteObject
+			reduce(20), // degree, reduce: NoteObject
+			reduce(20), // bracketBegin, reduce: NoteObject
+			reduce(20), // bracketEnd, reduce: NoteObject
+			reduce(20), // symbol, reduce: NoteObject
+			reduce(20), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S92
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // terminator
+			nil,       // lineComment
+			nil,       // cmdBar
+			nil,       // cmdEnd
+			shift(88), // chord
+			shift(89), // pitch
+			shift(90), // degree
+			shift(92), // bracketBegin
+			nil,       // bracketEnd
+			shift(93), // symbol
+			shift(94), // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
+		},
+	},
+	actionRow{ // S93
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(22), // chord, reduce: NoteSymbol
+			reduce(22), // pitch, reduce: NoteSymbol
+			reduce(22), // degree, reduce: NoteSymbol
+			reduce(22), // bracketBegin, reduce: NoteSymbol
+			reduce(22), // bracketEnd, reduce: NoteSymbol
+			reduce(22), // symbol, reduce: NoteSymbol
+			reduce(22), // rest, reduce: NoteSymbol
+			reduce(22), // propSharp, reduce: NoteSymbol
+			reduce(22), // propFlat, reduce: NoteSymbol
+			reduce(22), // propOctaveUp, reduce: NoteSymbol
+			reduce(22), // propOctaveDown, reduce: NoteSymbol
+			reduce(22), // propStaccato, reduce: NoteSymbol
+			reduce(22), // propAccent, reduce: NoteSymbol
+			reduce(22), // propMarcato, reduce: NoteSymbol
+			reduce(22), // propGhost, reduce: NoteSymbol
+			reduce(22), // uint, reduce: NoteSymbol
+			reduce(22), // propDot, reduce: NoteSymbol
+			reduce(22), // propTuplet, reduce: NoteSymbol
+			reduce(22), // propLetRing, reduce: NoteSymbol
+			reduce(22), // propTie, reduce: NoteSymbol
+			reduce(22), // propAftertouch, reduce: NoteSymbol
+			reduce(22), // propChance, reduce: NoteSymbol
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S94
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(23), // chord, reduce: NoteSymbol
+			reduce(23), // pitch, reduce: NoteSymbol
+			reduce(23), // degree, reduce: NoteSymbol
+			reduce(23), // bracketBegin, reduce: NoteSymbol
+			reduce(23), // bracketEnd, reduce: NoteSymbol
+			reduce(23), // symbol, reduce: NoteSymbol
+			reduce(23), // rest, reduce: NoteSymbol
+			reduce(23), // propSharp, reduce: NoteSymbol
+			reduce(23), // propFlat, reduce: NoteSymbol
+			reduce(23), // propOctaveUp, reduce: NoteSymbol
+			reduce(23), // propOctaveDown, reduce: NoteSymbol
+			reduce(23), // propStaccato, reduce: NoteSymbol
+			reduce(23), // propAccent, reduce: NoteSymbol
+			reduce(23), // propMarcato, reduce: NoteSymbol
+			reduce(23), // propGhost, reduce: NoteSymbol
+			reduce(23), // uint, reduce: NoteSymbol
+			reduce(23), // propDot, reduce: NoteSymbol
+			reduce(23), // propTuplet, reduce: NoteSymbol
+			reduce(23), // propLetRing, reduce: NoteSymbol
+			reduce(23), // propTie, reduce: NoteSymbol
+			reduce(23), // propAftertouch, reduce: NoteSymbol
+			reduce(23), // propChance, reduce: NoteSymbol
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S95
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			shift(3),  // terminator
+			reduce(2), // lineComment, reduce: RepeatTerminator
+			reduce(2), // cmdBar, reduce: RepeatTerminator
+			nil,       // cmdEnd
+			reduce(2), // chord, reduce: RepeatTerminator
+			reduce(2), // pitch, reduce: RepeatTerminator
+			reduce(2), // degree, reduce: RepeatTerminator
+			reduce(2), // bracketBegin, reduce: RepeatTerminator
+			nil,       // bracketEnd
+			reduce(2), // symbol, reduce: RepeatTerminator
+			reduce(2), // rest, reduce: RepeatTerminator
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			reduce(2), // cmdRepeat, reduce: RepeatTerminator
+			reduce(2), // cmdAssign, reduce: RepeatTerminator
+			reduce(2), // cmdKit, reduce: RepeatTerminator
+			reduce(2), // cmdPlay, reduce: RepeatTerminator
+			reduce(2), // cmdTempo, reduce: RepeatTerminator
+			nil,       // arrow
+			reduce(2), // cmdKey, reduce: RepeatTerminator
+			reduce(2), // cmdScale, reduce: RepeatTerminator
+			reduce(2), // cmdTime, reduce: RepeatTerminator
+			reduce(2), // cmdMeter, reduce: RepeatTerminator
+			reduce(2), // cmdVelocity, reduce: RepeatTerminator
+			reduce(2), // cmdOctave, reduce: RepeatTerminator
+			reduce(2), // cmdChannel, reduce: RepeatTerminator
+			reduce(2), // cmdVoice, reduce: RepeatTerminator
+			reduce(2), // cmdProgram, reduce: RepeatTerminator
+			reduce(2), // cmdProgramName, reduce: RepeatTerminator
+			nil,       // string
+			reduce(2), // cmdControl, reduce: RepeatTerminator
+			reduce(2), // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2), // cmdBend, reduce: RepeatTerminator
+			reduce(2), // cmdPressure, reduce: RepeatTerminator
+			reduce(2), // cmdSysex, reduce: RepeatTerminator
+			reduce(2), // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2), // cmdRPN, reduce: RepeatTerminator
+			reduce(2), // cmdNRPN, reduce: RepeatTerminator
+			reduce(2), // cmdSwing, reduce: RepeatTerminator
+			reduce(2), // cmdGroove, reduce: RepeatTerminator
+			reduce(2), // cmdHumanize, reduce: RepeatTerminator
+			reduce(2), // cmdStart, reduce: RepeatTerminator
+			reduce(2), // cmdStop, reduce: RepeatTerminator
+			reduce(2), // cmdPickup, reduce: RepeatTerminator
+			reduce(2), // cmdStaff, reduce: RepeatTerminator
+			reduce(2), // cmdInclude, reduce: RepeatTerminator
+			reduce(2), // cmdVolta, reduce: RepeatTerminator
+			reduce(2), // cmdDyn, reduce: RepeatTerminator
+			reduce(2), // cmdDynamics, reduce: RepeatTerminator
+			reduce(2), // cmdCresc, reduce: RepeatTerminator
+			reduce(2), // cmdDim, reduce: RepeatTerminator
+			reduce(2), // blockComment, reduce: RepeatTerminator
+		},
+	},
+	actionRow{ // S96
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(194), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S97
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(45), // ␚, reduce: Command
+			nil,        // empty
+			reduce(45), // terminator, reduce: Command
+			reduce(45), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			shift(195), // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S98
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(196), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S99
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(197), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S100
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(52), // ␚, reduce: Command
+			nil,        // empty
+			reduce(52), // terminator, reduce: Command
+			reduce(52), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S101
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(54), // ␚, reduce: Command
+			nil,        // empty
+			reduce(54), // terminator, reduce: Command
+			reduce(54), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S102
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(55), // ␚, reduce: Command
+			nil,        // empty
+			reduce(55), // terminator, reduce: Command
+			reduce(55), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S103
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(57), // ␚, reduce: Command
+			nil,        // empty
+			reduce(57), // terminator, reduce: Command
+			reduce(57), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S104
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(61), // ␚, reduce: Command
+			nil,        // empty
+			reduce(61), // terminator, reduce: Command
+			reduce(61), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S105
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(63), // ␚, reduce: Command
+			nil,        // empty
+			reduce(63), // terminator, reduce: Command
+			reduce(63), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S106
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(198), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S107
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(199), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S108
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(75), // ␚, reduce: Command
+			nil,        // empty
+			reduce(75), // terminator, reduce: Command
+			reduce(75), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S109
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(76), // ␚, reduce: Command
+			nil,        // empty
+			reduce(76), // terminator, reduce: Command
+			reduce(76), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S110
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(3), // ␚, reduce: RepeatTerminator
+			nil,       // empty
+			nil,       // terminator
+			shift(6),  // lineComment
+			shift(12), // cmdBar
+			nil,       // cmdEnd
+			shift(15), // chord
+			shift(16), // pitch
+			shift(17), // degree
+			shift(19), // bracketBegin
+			nil,       // bracketEnd
+			shift(20), // symbol
+			shift(21), // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			shift(22), // cmdRepeat
+			shift(23), // cmdAssign
+			shift(24), // cmdKit
+			shift(25), // cmdPlay
+			shift(26), // cmdTempo
+			nil,       // arrow
+			shift(27), // cmdKey
+			shift(28), // cmdScale
+			shift(29), // cmdTime
+			shift(30), // cmdMeter
+			shift(31), // cmdVelocity
+			shift(32), // cmdOctave
+			shift(33), // cmdChannel
+			shift(34), // cmdVoice
+			shift(35), // cmdProgram
+			shift(36), // cmdProgramName
+			nil,       // string
+			shift(37), // cmdControl
+			shift(38), // cmdControlRamp
+			shift(39), // cmdBend
+			shift(40), // cmdPressure
+			shift(41), // cmdSysex
+			shift(42), // cmdSysexFile
+			shift(43), // cmdRPN
+			shift(44), // cmdNRPN
+			shift(45), // cmdSwing
+			shift(46), // cmdGroove
+			shift(47), // cmdHumanize
+			shift(48), // cmdStart
+			shift(49), // cmdStop
+			shift(50), // cmdPickup
+			shift(51), // cmdStaff
+			shift(52), // cmdInclude
+			shift(53), // cmdVolta
+			shift(54), // cmdDyn
+			shift(55), // cmdDynamics
+			shift(56), // cmdCresc
+			shift(57), // cmdDim
+			shift(58), // blockComment
+		},
+	},
+	actionRow{ // S111
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(2),  // ␚, reduce: RepeatTerminator
+			nil,        // empty
+			shift(111), // terminator
+			reduce(2),  // lineComment, reduce: RepeatTerminator
+			reduce(2),  // cmdBar, reduce: RepeatTerminator
+			nil,        // cmdEnd
+			reduce(2),  // chord, reduce: RepeatTerminator
+			reduce(2),  // pitch, reduce: RepeatTerminator
+			reduce(2),  // degree, reduce: RepeatTerminator
+			reduce(2),  // bracketBegin, reduce: RepeatTerminator
+			nil,        // bracketEnd
+			reduce(2),  // symbol, reduce: RepeatTerminator
+			reduce(2),  // rest, reduce: RepeatTerminator
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			reduce(2),  // cmdRepeat, reduce: RepeatTerminator
+			reduce(2),  // cmdAssign, reduce: RepeatTerminator
+			reduce(2),  // cmdKit, reduce: RepeatTerminator
+			reduce(2),  // cmdPlay, reduce: RepeatTerminator
+			reduce(2),  // cmdTempo, reduce: RepeatTerminator
+			nil,        // arrow
+			reduce(2),  // cmdKey, reduce: RepeatTerminator
+			reduce(2),  // cmdScale, reduce: RepeatTerminator
+			reduce(2),  // cmdTime, reduce: RepeatTerminator
+			reduce(2),  // cmdMeter, reduce: RepeatTerminator
+			reduce(2),  // cmdVelocity, reduce: RepeatTerminator
+			reduce(2),  // cmdOctave, reduce: RepeatTerminator
+			reduce(2),  // cmdChannel, reduce: RepeatTerminator
+			reduce(2),  // cmdVoice, reduce: RepeatTerminator
+			reduce(2),  // cmdProgram, reduce: RepeatTerminator
+			reduce(2),  // cmdProgramName, reduce: RepeatTerminator
+			nil,        // string
+			reduce(2),  // cmdControl, reduce: RepeatTerminator
+			reduce(2),  // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2),  // cmdBend, reduce: RepeatTerminator
+			reduce(2),  // cmdPressure, reduce: RepeatTerminator
+			reduce(2),  // cmdSysex, reduce: RepeatTerminator
+			reduce(2),  // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2),  // cmdRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdNRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdSwing, reduce: RepeatTerminator
+			reduce(2),  // cmdGroove, reduce: RepeatTerminator
+			reduce(2),  // cmdHumanize, reduce: RepeatTerminator
+			reduce(2),  // cmdStart, reduce: RepeatTerminator
+			reduce(2),  // cmdStop, reduce: RepeatTerminator
+			reduce(2),  // cmdPickup, reduce: RepeatTerminator
+			reduce(2),  // cmdStaff, reduce: RepeatTerminator
+			reduce(2),  // cmdInclude, reduce: RepeatTerminator
+			reduce(2),  // cmdVolta, reduce: RepeatTerminator
+			reduce(2),  // cmdDyn, reduce: RepeatTerminator
+			reduce(2),  // cmdDynamics, reduce: RepeatTerminator
+			reduce(2),  // cmdCresc, reduce: RepeatTerminator
+			reduce(2),  // cmdDim, reduce: RepeatTerminator
+			reduce(2),  // blockComment, reduce: RepeatTerminator
+		},
+	},
+	actionRow{ // S112
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(7), // ␚, reduce: DeclList
+			nil,       // empty
+			nil,       // terminator
+			nil,       // lineComment
+			nil,       // cmdBar
+			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
+			nil,       // bracketBegin
+			nil,       // bracketEnd
+			nil,       // symbol
+			nil,       // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
+		},
+	},
+	actionRow{ // S113
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(2),  // ␚, reduce: RepeatTerminator
+			nil,        // empty
+			shift(111), // terminator
+			reduce(2),  // lineComment, reduce: RepeatTerminator
+			reduce(2),  // cmdBar, reduce: RepeatTerminator
+			nil,        // cmdEnd
+			reduce(2),  // chord, reduce: RepeatTerminator
+			reduce(2),  // pitch, reduce: RepeatTerminator
+			reduce(2),  // degree, reduce: RepeatTerminator
+			reduce(2),  // bracketBegin, reduce: RepeatTerminator
+			nil,        // bracketEnd
+			reduce(2),  // symbol, reduce: RepeatTerminator
+			reduce(2),  // rest, reduce: RepeatTerminator
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			reduce(2),  // cmdRepeat, reduce: RepeatTerminator
+			reduce(2),  // cmdAssign, reduce: RepeatTerminator
+			reduce(2),  // cmdKit, reduce: RepeatTerminator
+			reduce(2),  // cmdPlay, reduce: RepeatTerminator
+			reduce(2),  // cmdTempo, reduce: RepeatTerminator
+			nil,        // arrow
+			reduce(2),  // cmdKey, reduce: RepeatTerminator
+			reduce(2),  // cmdScale, reduce: RepeatTerminator
+			reduce(2),  // cmdTime, reduce: RepeatTerminator
+			reduce(2),  // cmdMeter, reduce: RepeatTerminator
+			reduce(2),  // cmdVelocity, reduce: RepeatTerminator
+			reduce(2),  // cmdOctave, reduce: RepeatTerminator
+			reduce(2),  // cmdChannel, reduce: RepeatTerminator
+			reduce(2),  // cmdVoice, reduce: RepeatTerminator
+			reduce(2),  // cmdProgram, reduce: RepeatTerminator
+			reduce(2),  // cmdProgramName, reduce: RepeatTerminator
+			nil,        // string
+			reduce(2),  // cmdControl, reduce: RepeatTerminator
+			reduce(2),  // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2),  // cmdBend, reduce: RepeatTerminator
+			reduce(2),  // cmdPressure, reduce: RepeatTerminator
+			reduce(2),  // cmdSysex, reduce: RepeatTerminator
+			reduce(2),  // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2),  // cmdRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdNRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdSwing, reduce: RepeatTerminator
+			reduce(2),  // cmdGroove, reduce: RepeatTerminator
+			reduce(2),  // cmdHumanize, reduce: RepeatTerminator
+			reduce(2),  // cmdStart, reduce: RepeatTerminator
+			reduce(2),  // cmdStop, reduce: RepeatTerminator
+			reduce(2),  // cmdPickup, reduce: RepeatTerminator
+			reduce(2),  // cmdStaff, reduce: RepeatTerminator
+			reduce(2),  // cmdInclude, reduce: RepeatTerminator
+			reduce(2),  // cmdVolta, reduce: RepeatTerminator
+			reduce(2),  // cmdDyn, reduce: RepeatTerminator
+			reduce(2),  // cmdDynamics, reduce: RepeatTerminator
+			reduce(2),  // cmdCresc, reduce: RepeatTerminator
+			reduce(2),  // cmdDim, reduce: RepeatTerminator
+			reduce(2),  // blockComment, reduce: RepeatTerminator
+		},
+	},
+	actionRow{ // S114
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			shift(203), // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S115
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			shift(205), // terminator
+			shift(206), // lineComment
+			nil,        // cmdBar
+			reduce(2),  // cmdEnd, reduce: RepeatTerminator
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S116
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(82), // terminator, reduce: Comment
+			reduce(82), // lineComment, reduce: Comment
+			nil,        // cmdBar
+			reduce(82), // cmdEnd, reduce: Comment
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S117
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			reduce(8), // terminator, reduce: Decl
+			reduce(8), // lineComment, reduce: Decl
+			nil,       // cmdBar
+			reduce(8), // cmdEnd, reduce: Decl
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
+			nil,       // bracketBegin
+			nil,       // bracketEnd
+			nil,       // symbol
+			nil,       // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
+		},
+	},
+	actionRow{ // S118
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			reduce(9), // terminator, reduce: Decl
+			reduce(9), // lineComment, reduce: Decl
+			nil,       // cmdBar
+			reduce(9), // cmdEnd, reduce: Decl
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
+			nil,       // bracketBegin
+			nil,       // bracketEnd
+			nil,       // symbol
+			nil,       // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
+		},
+	},
+	actionRow{ // S119
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(10), // terminator, reduce: Decl
+			reduce(10), // lineComment, reduce: Decl
+			nil,        // cmdBar
+			reduce(10), // cmdEnd, reduce: Decl
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S120
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(11), // terminator, reduce: Decl
+			reduce(11), // lineComment, reduce: Decl
+			nil,        // cmdBar
+			reduce(11), // cmdEnd, reduce: Decl
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S121
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(12), // terminator, reduce: Decl
+			reduce(12), // lineComment, reduce: Decl
+			nil,        // cmdBar
+			reduce(12), // cmdEnd, reduce: Decl
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S122
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			shift(3),  // terminator
+			reduce(2), // lineComment, reduce: RepeatTerminator
+			reduce(2), // cmdBar, reduce: RepeatTerminator
+			nil,       // cmdEnd
+			reduce(2), // chord, reduce: RepeatTerminator
+			reduce(2), // pitch, reduce: RepeatTerminator
+			reduce(2), // degree, reduce: RepeatTerminator
+			reduce(2), // bracketBegin, reduce: RepeatTerminator
+			nil,       // bracketEnd
+			reduce(2), // symbol, reduce: RepeatTerminator
+			reduce(2), // rest, reduce: RepeatTerminator
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			reduce(2), // cmdRepeat, reduce: RepeatTerminator
+			reduce(2), // cmdAssign, reduce: RepeatTerminator
+			reduce(2), // cmdKit, reduce: RepeatTerminator
+			reduce(2), // cmdPlay, reduce: RepeatTerminator
+			reduce(2), // cmdTempo, reduce: RepeatTerminator
+			nil,       // arrow
+			reduce(2), // cmdKey, reduce: RepeatTerminator
+			reduce(2), // cmdScale, reduce: RepeatTerminator
+			reduce(2), // cmdTime, reduce: RepeatTerminator
+			reduce(2), // cmdMeter, reduce: RepeatTerminator
+			reduce(2), // cmdVelocity, reduce: RepeatTerminator
+			reduce(2), // cmdOctave, reduce: RepeatTerminator
+			reduce(2), // cmdChannel, reduce: RepeatTerminator
+			reduce(2), // cmdVoice, reduce: RepeatTerminator
+			reduce(2), // cmdProgram, reduce: RepeatTerminator
+			reduce(2), // cmdProgramName, reduce: RepeatTerminator
+			nil,       // string
+			reduce(2), // cmdControl, reduce: RepeatTerminator
+			reduce(2), // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2), // cmdBend, reduce: RepeatTerminator
+			reduce(2), // cmdPressure, reduce: RepeatTerminator
+			reduce(2), // cmdSysex, reduce: RepeatTerminator
+			reduce(2), // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2), // cmdRPN, reduce: RepeatTerminator
+			reduce(2), // cmdNRPN, reduce: RepeatTerminator
+			reduce(2), // cmdSwing, reduce: RepeatTerminator
+			reduce(2), // cmdGroove, reduce: RepeatTerminator
+			reduce(2), // cmdHumanize, reduce: RepeatTerminator
+			reduce(2), // cmdStart, reduce: RepeatTerminator
+			reduce(2), // cmdStop, reduce: RepeatTerminator
+			reduce(2), // cmdPickup, reduce: RepeatTerminator
+			reduce(2), // cmdStaff, reduce: RepeatTerminator
+			reduce(2), // cmdInclude, reduce: RepeatTerminator
+			reduce(2), // cmdVolta, reduce: RepeatTerminator
+			reduce(2), // cmdDyn, reduce: RepeatTerminator
+			reduce(2), // cmdDynamics, reduce: RepeatTerminator
+			reduce(2), // cmdCresc, reduce: RepeatTerminator
+			reduce(2), // cmdDim, reduce: RepeatTerminator
+			reduce(2), // blockComment, reduce: RepeatTerminator
+		},
+	},
+	actionRow{ // S123
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(14), // terminator, reduce: NoteList
+			reduce(14), // lineComment, reduce: NoteList
+			nil,        // cmdBar
+			reduce(14), // cmdEnd, reduce: NoteList
+			shift(125), // chord
+			shift(126), // pitch
+			shift(127), // degree
+			shift(129), // bracketBegin
+			nil,        // bracketEnd
+			shift(130), // symbol
+			shift(131), // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S124
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
+			nil,        // cmdBar
+			reduce(24), // cmdEnd, reduce: PropertyList
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			nil,        // bracketEnd
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(211), // propSharp
+			shift(212), // propFlat
+			shift(213), // propOctaveUp
+			shift(214), // propOctaveDown
+			shift(215), // propStaccato
+			shift(216), // propAccent
+			shift(217), // propMarcato
+			shift(218), // propGhost
+			shift(219), // uint
+			shift(220), // propDot
+			shift(221), // propTuplet
+			shift(222), // propLetRing
+			shift(223), // propTie
+			shift(224), // propAftertouch
+			shift(225), // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S125
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
+			nil,        // cmdBar
+			reduce(24), // cmdEnd, reduce: PropertyList
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			nil,        // bracketEnd
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(211), // propSharp
+			shift(212), // propFlat
+			shift(213), // propOctaveUp
+			shift(214), // propOctaveDown
+			shift(215), // propStaccato
+			shift(216), // propAccent
+			shift(217), // propMarcato
+			shift(218), // propGhost
+			shift(219), // uint
+			shift(220), // propDot
+			shift(221), // propTuplet
+			shift(222), // propLetRing
+			shift(223), // propTie
+			shift(224), // propAftertouch
+			shift(225), // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S126
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
+			nil,        // cmdBar
+			reduce(24), // cmdEnd, reduce: PropertyList
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			nil,        // bracketEnd
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(211), // propSharp
+			shift(212), // propFlat
+			shift(213), // propOctaveUp
+			shift(214), // propOctaveDown
+			shift(215), // propStaccato
+			shift(216), // propAccent
+			shift(217), // propMarcato
+			shift(218), // propGhost
+			shift(219), // uint
+			shift(220), // propDot
+			shift(221), // propTuplet
+			shift(222), // propLetRing
+			shift(223), // propTie
+			shift(224), // propAftertouch
+			shift(225), // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S127
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
+			nil,        // cmdBar
+			reduce(24), // cmdEnd, reduce: PropertyList
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			nil,        // bracketEnd
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(211), // propSharp
+			shift(212), // propFlat
+			shift(213), // propOctaveUp
+			shift(214), // propOctaveDown
+			shift(215), // propStaccato
+			shift(216), // propAccent
+			shift(217), // propMarcato
+			shift(218), // propGhost
+			shift(219), // uint
+			shift(220), // propDot
+			shift(221), // propTuplet
+			shift(222), // propLetRing
+			shift(223), // propTie
+			shift(224), // propAftertouch
+			shift(225), // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S128
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(20), // terminator, reduce: NoteObject
+			reduce(20), // lineComment, reduce: NoteObject
+			nil,        // cmdBar
+			reduce(20), // cmdEnd, reduce: NoteObject
+			reduce(20), // chord, reduce: NoteObject
+			reduce(20), // pitch, reduce: NoteObject
+			reduce(20), // degree, reduce: NoteObject
+			reduce(20), // bracketBegin, reduce: NoteObject
+			nil,        // bracketEnd
+			reduce(20), // symbol, reduce: NoteObject
+			reduce(20), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S129
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // terminator
+			nil,       // lineComment
+			nil,       // cmdBar
+			nil,       // cmdEnd
+			shift(88), // chord
+			shift(89), // pitch
+			shift(90), // degree
+			shift(92), // bracketBegin
+			nil,       // bracketEnd
+			shift(93), // symbol
+			shift(94), // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
+		},
+	},
+	actionRow{ // S130
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(22), // terminator, reduce: NoteSymbol
+			reduce(22), // lineComment, reduce: NoteSymbol
+			nil,        // cmdBar
+			reduce(22), // cmdEnd, reduce: NoteSymbol
+			reduce(22), // chord, reduce: NoteSymbol
+			reduce(22), // pitch, reduce: NoteSymbol
+			reduce(22), // degree, reduce: NoteSymbol
+			reduce(22), // bracketBegin, reduce: NoteSymbol
+			nil,        // bracketEnd
+			reduce(22), // symbol, reduce: NoteSymbol
+			reduce(22), // rest, reduce: NoteSymbol
+			reduce(22), // propSharp, reduce: NoteSymbol
+			reduce(22), // propFlat, reduce: NoteSymbol
+			reduce(22), // propOctaveUp, reduce: NoteSymbol
+			reduce(22), // propOctaveDown, reduce: NoteSymbol
+			reduce(22), // propStaccato, reduce: NoteSymbol
+			reduce(22), // propAccent, reduce: NoteSymbol
+			reduce(22), // propMarcato, reduce: NoteSymbol
+			reduce(22), // propGhost, reduce: NoteSymbol
+			reduce(22), // uint, reduce: NoteSymbol
+			reduce(22), // propDot, reduce: NoteSymbol
+			reduce(22), // propTuplet, reduce: NoteSymbol
+			reduce(22), // propLetRing, reduce: NoteSymbol
+			reduce(22), // propTie, reduce: NoteSymbol
+			reduce(22), // propAftertouch, reduce: NoteSymbol
+			reduce(22), // propChance, reduce: NoteSymbol
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S131
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(23), // terminator, reduce: NoteSymbol
+			reduce(23), // lineComment, reduce: NoteSymbol
+			nil,        // cmdBar
+			reduce(23), // cmdEnd, reduce: NoteSymbol
+			reduce(23), // chord, reduce: NoteSymbol
+			reduce(23), // pitch, reduce: NoteSymbol
+			reduce(23), // degree, reduce: NoteSymbol
+			reduce(23), // bracketBegin, reduce: NoteSymbol
+			nil,        // bracketEnd
+			reduce(23), // symbol, reduce: NoteSymbol
+			reduce(23), // rest, reduce: NoteSymbol
+			reduce(23), // propSharp, reduce: NoteSymbol
+			reduce(23), // propFlat, reduce: NoteSymbol
+			reduce(23), // propOctaveUp, reduce: NoteSymbol
+			reduce(23), // propOctaveDown, reduce: NoteSymbol
+			reduce(23), // propStaccato, reduce: NoteSymbol
+			reduce(23), // propAccent, reduce: NoteSymbol
+			reduce(23), // propMarcato, reduce: NoteSymbol
+			reduce(23), // propGhost, reduce: NoteSymbol
+			reduce(23), // uint, reduce: NoteSymbol
+			reduce(23), // propDot, reduce: NoteSymbol
+			reduce(23), // propTuplet, reduce: NoteSymbol
+			reduce(23), // propLetRing, reduce: NoteSymbol
+			reduce(23), // propTie, reduce: NoteSymbol
+			reduce(23), // propAftertouch, reduce: NoteSymbol
+			reduce(23), // propChance, reduce: NoteSymbol
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S132
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(230), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S133
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			shift(231), // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S134
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(43), // terminator, reduce: Command
+			reduce(43), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(43), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S135
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(44), // terminator, reduce: Command
+			reduce(44), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(44), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S136
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(232), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S137
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(48), // terminator, reduce: Command
+			reduce(48), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(48), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S138
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(49), // terminator, reduce: Command
+			reduce(49), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(49), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S139
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(233), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S140
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(234), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S141
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(235), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S142
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(53), // terminator, reduce: Command
+			reduce(53), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(53), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S143
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(236), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S144
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(237), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S145
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(56), // terminator, reduce: Command
+			reduce(56), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(56), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S146
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			shift(238), // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S147
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(58), // terminator, reduce: Command
+			reduce(58), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(58), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S148
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(59), // terminator, reduce: Command
+			reduce(59), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(59), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S149
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(60), // terminator, reduce: Command
+			reduce(60), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(60), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S150
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(239), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S151
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(62), // terminator, reduce: Command
+			reduce(62), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(62), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S152
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			shift(240), // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S153
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(241), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S154
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(242), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S155
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(68), // terminator, reduce: Command
+			reduce(68), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(68), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S156
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(69), // terminator, reduce: Command
+			reduce(69), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(69), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S157
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(70), // terminator, reduce: Command
+			reduce(70), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(70), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S158
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(71), // terminator, reduce: Command
+			reduce(71), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(71), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S159
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(72), // terminator, reduce: Command
+			reduce(72), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(72), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S160
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(73), // terminator, reduce: Command
+			reduce(73), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(73), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S161
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(74), // terminator, reduce: Command
+			reduce(74), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(74), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S162
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			shift(243), // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S163
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(244), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S164
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(77), // terminator, reduce: Command
+			reduce(77), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(77), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S165
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(78), // terminator, reduce: Command
+			reduce(78), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(78), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S166
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(79), // terminator, reduce: Command
+			reduce(79), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(79), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S167
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(80), // terminator, reduce: Command
+			reduce(80), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(80), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S168
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(81), // terminator, reduce: Comment
+			reduce(81), // lineComment, reduce: Comment
+			nil,        // cmdBar
+			reduce(81), // cmdEnd, reduce: Comment
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S169
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(25), // ␚, reduce: PropertyList
+			nil,        // empty
+			reduce(25), // terminator, reduce: PropertyList
+			reduce(25), // lineComment, reduce: PropertyList
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(25), // chord, reduce: PropertyList
+			reduce(25), // pitch, reduce: PropertyList
+			reduce(25), // degree, reduce: PropertyList
+			reduce(25), // bracketBegin, reduce: PropertyList
+			nil,        // bracketEnd
+			reduce(25), // symbol, reduce: PropertyList
+			reduce(25), // rest, reduce: PropertyList
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S170
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(24), // ␚, reduce: PropertyList
+			nil,        // empty
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			nil,        // bracketEnd
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(67),  // propSharp
+			shift(68),  // propFlat
+			shift(69),  // propOctaveUp
+			shift(70),  // propOctaveDown
+			shift(71),  // propStaccato
+			shift(72),  // propAccent
+			shift(73),  // propMarcato
+			shift(74),  // propGhost
+			shift(75),  // uint
+			shift(76),  // propDot
+			shift(77),  // propTuplet
+			shift(78),  // propLetRing
+			shift(79),  // propTie
+			shift(80),  // propAftertouch
+			shift(81),  // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S171
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			reduce(15), // bracketEnd, reduce: NoteList
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S172
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(16), // chord, reduce: NoteObject
+			reduce(16), // pitch, reduce: NoteObject
+			reduce(16), // degree, reduce: NoteObject
+			reduce(16), // bracketBegin, reduce: NoteObject
+			reduce(16), // bracketEnd, reduce: NoteObject
+			reduce(16), // symbol, reduce: NoteObject
+			reduce(16), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S173
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			reduce(24), // bracketEnd, reduce: PropertyList
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(174), // propSharp
+			shift(175), // propFlat
+			shift(176), // propOctaveUp
+			shift(177), // propOctaveDown
+			shift(178), // propStaccato
+			shift(179), // propAccent
+			shift(180), // propMarcato
+			shift(181), // propGhost
+			shift(182), // uint
+			shift(183), // propDot
+			shift(184), // propTuplet
+			shift(185), // propLetRing
+			shift(186), // propTie
+			shift(187), // propAftertouch
+			shift(188), // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S174
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(26), // chord, reduce: Property
+			reduce(26), // pitch, reduce: Property
+			reduce(26), // degree, reduce: Property
+			reduce(26), // bracketBegin, reduce: Property
+			reduce(26), // bracketEnd, reduce: Property
+			reduce(26), // symbol, reduce: Property
+			reduce(26), // rest, reduce: Property
+			reduce(26), // propSharp, reduce: Property
+			reduce(26), // propFlat, reduce: Property
+			reduce(26), // propOctaveUp, reduce: Property
+			reduce(26), // propOctaveDown, reduce: Property
+			reduce(26), // propStaccato, reduce: Property
+			reduce(26), // propAccent, reduce: Property
+			reduce(26), // propMarcato, reduce: Property
+			reduce(26), // propGhost, reduce: Property
+			reduce(26), // uint, reduce: Property
+			reduce(26), // propDot, reduce: Property
+			reduce(26), // propTuplet, reduce: Property
+			reduce(26), // propLetRing, reduce: Property
+			reduce(26), // propTie, reduce: Property
+			reduce(26), // propAftertouch, reduce: Property
+			reduce(26), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S175
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(27), // chord, reduce: Property
+			reduce(27), // pitch, reduce: Property
+			reduce(27), // degree, reduce: Property
+			reduce(27), // bracketBegin, reduce: Property
+			reduce(27), // bracketEnd, reduce: Property
+			reduce(27), // symbol, reduce: Property
+			reduce(27), // rest, reduce: Property
+			reduce(27), // propSharp, reduce: Property
+			reduce(27), // propFlat, reduce: Property
+			reduce(27), // propOctaveUp, reduce: Property
+			reduce(27), // propOctaveDown, reduce: Property
+			reduce(27), // propStaccato, reduce: Property
+			reduce(27), // propAccent, reduce: Property
+			reduce(27), // propMarcato, reduce: Property
+			reduce(27), // propGhost, reduce: Property
+			reduce(27), // uint, reduce: Property
+			reduce(27), // propDot, reduce: Property
+			reduce(27), // propTuplet, reduce: Property
+			reduce(27), // propLetRing, reduce: Property
+			reduce(27), // propTie, reduce: Property
+			reduce(27), // propAftertouch, reduce: Property
+			reduce(27), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S176
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(28), // chord, reduce: Property
+			reduce(28), // pitch, reduce: Property
+			reduce(28), // degree, reduce: Property
+			reduce(28), // bracketBegin, reduce: Property
+			reduce(28), // bracketEnd, reduce: Property
+			reduce(28), // symbol, reduce: Property
+			reduce(28), // rest, reduce: Property
+			reduce(28), // propSharp, reduce: Property
+			reduce(28), // propFlat, reduce: Property
+			reduce(28), // propOctaveUp, reduce: Property
+			reduce(28), // propOctaveDown, reduce: Property
+			reduce(28), // propStaccato, reduce: Property
+			reduce(28), // propAccent, reduce: Property
+			reduce(28), // propMarcato, reduce: Property
+			reduce(28), // propGhost, reduce: Property
+			reduce(28), // uint, reduce: Property
+			reduce(28), // propDot, reduce: Property
+			reduce(28), // propTuplet, reduce: Property
+			reduce(28), // propLetRing, reduce: Property
+			reduce(28), // propTie, reduce: Property
+			reduce(28), // propAftertouch, reduce: Property
+			reduce(28), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S177
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(29), // chord, reduce: Property
+			reduce(29), // pitch, reduce: Property
+			reduce(29), // degree, reduce: Property
+			reduce(29), // bracketBegin, reduce: Property
+			reduce(29), // bracketEnd, reduce: Property
+			reduce(29), // symbol, reduce: Property
+			reduce(29), // rest, reduce: Property
+			reduce(29), // propSharp, reduce: Property
+			reduce(29), // propFlat, reduce: Property
+			reduce(29), // propOctaveUp, reduce: Property
+			reduce(29), // propOctaveDown, reduce: Property
+			reduce(29), // propStaccato, reduce: Property
+			reduce(29), // propAccent, reduce: Property
+			reduce(29), // propMarcato, reduce: Property
+			reduce(29), // propGhost, reduce: Property
+			reduce(29), // uint, reduce: Property
+			reduce(29), // propDot, reduce: Property
+			reduce(29), // propTuplet, reduce: Property
+			reduce(29), // propLetRing, reduce: Property
+			reduce(29), // propTie, reduce: Property
+			reduce(29), // propAftertouch, reduce: Property
+			reduce(29), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S178
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(30), // chord, reduce: Property
+			reduce(30), // pitch, reduce: Property
+			reduce(30), // degree, reduce: Property
+			reduce(30), // bracketBegin, reduce: Property
+			reduce(30), // bracketEnd, reduce: Property
+			reduce(30), // symbol, reduce: Property
+			reduce(30), // rest, reduce: Property
+			reduce(30), // propSharp, reduce: Property
+			reduce(30), // propFlat, reduce: Property
+			reduce(30), // propOctaveUp, reduce: Property
+			reduce(30), // propOctaveDown, reduce: Property
+			reduce(30), // propStaccato, reduce: Property
+			reduce(30), // propAccent, reduce: Property
+			reduce(30), // propMarcato, reduce: Property
+			reduce(30), // propGhost, reduce: Property
+			reduce(30), // uint, reduce: Property
+			reduce(30), // propDot, reduce: Property
+			reduce(30), // propTuplet, reduce: Property
+			reduce(30), // propLetRing, reduce: Property
+			reduce(30), // propTie, reduce: Property
+			reduce(30), // propAftertouch, reduce: Property
+			reduce(30), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S179
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(31), // chord, reduce: Property
+			reduce(31), // pitch, reduce: Property
+			reduce(31), // degree, reduce: Property
+			reduce(31), // bracketBegin, reduce: Property
+			reduce(31), // bracketEnd, reduce: Property
+			reduce(31), // symbol, reduce: Property
+			reduce(31), // rest, reduce: Property
+			reduce(31), // propSharp, reduce: Property
+			reduce(31), // propFlat, reduce: Property
+			reduce(31), // propOctaveUp, reduce: Property
+			reduce(31), // propOctaveDown, reduce: Property
+			reduce(31), // propStaccato, reduce: Property
+			reduce(31), // propAccent, reduce: Property
+			reduce(31), // propMarcato, reduce: Property
+			reduce(31), // propGhost, reduce: Property
+			reduce(31), // uint, reduce: Property
+			reduce(31), // propDot, reduce: Property
+			reduce(31), // propTuplet, reduce: Property
+			reduce(31), // propLetRing, reduce: Property
+			reduce(31), // propTie, reduce: Property
+			reduce(31), // propAftertouch, reduce: Property
+			reduce(31), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S180
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(32), // chord, reduce: Property
+			reduce(32), // pitch, reduce: Property
+			reduce(32), // degree, reduce: Property
+			reduce(32), // bracketBegin, reduce: Property
+			reduce(32), // bracketEnd, reduce: Property
+			reduce(32), // symbol, reduce: Property
+			reduce(32), // rest, reduce: Property
+			reduce(32), // propSharp, reduce: Property
+			reduce(32), // propFlat, reduce: Property
+			reduce(32), // propOctaveUp, reduce: Property
+			reduce(32), // propOctaveDown, reduce: Property
+			reduce(32), // propStaccato, reduce: Property
+			reduce(32), // propAccent, reduce: Property
+			reduce(32), // propMarcato, reduce: Property
+			reduce(32), // propGhost, reduce: Property
+			reduce(32), // uint, reduce: Property
+			reduce(32), // propDot, reduce: Property
+			reduce(32), // propTuplet, reduce: Property
+			reduce(32), // propLetRing, reduce: Property
+			reduce(32), // propTie, reduce: Property
+			reduce(32), // propAftertouch, reduce: Property
+			reduce(32), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S181
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(33), // chord, reduce: Property
+			reduce(33), // pitch, reduce: Property
+			reduce(33), // degree, reduce: Property
+			reduce(33), // bracketBegin, reduce: Property
+			reduce(33), // bracketEnd, reduce: Property
+			reduce(33), // symbol, reduce: Property
+			reduce(33), // rest, reduce: Property
+			reduce(33), // propSharp, reduce: Property
+			reduce(33), // propFlat, reduce: Property
+			reduce(33), // propOctaveUp, reduce: Property
+			reduce(33), // propOctaveDown, reduce: Property
+			reduce(33), // propStaccato, reduce: Property
+			reduce(33), // propAccent, reduce: Property
+			reduce(33), // propMarcato, reduce: Property
+			reduce(33), // propGhost, reduce: Property
+			reduce(33), // uint, reduce: Property
+			reduce(33), // propDot, reduce: Property
+			reduce(33), // propTuplet, reduce: Property
+			reduce(33), // propLetRing, reduce: Property
+			reduce(33), // propTie, reduce: Property
+			reduce(33), // propAftertouch, reduce: Property
+			reduce(33), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S182
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(34), // chord, reduce: Property
+			reduce(34), // pitch, reduce: Property
+			reduce(34), // degree, reduce: Property
+			reduce(34), // bracketBegin, reduce: Property
+			reduce(34), // bracketEnd, reduce: Property
+			reduce(34), // symbol, reduce: Property
+			reduce(34), // rest, reduce: Property
+			reduce(34), // propSharp, reduce: Property
+			reduce(34), // propFlat, reduce: Property
+			reduce(34), // propOctaveUp, reduce: Property
+			reduce(34), // propOctaveDown, reduce: Property
+			reduce(34), // propStaccato, reduce: Property
+			reduce(34), // propAccent, reduce: Property
+			reduce(34), // propMarcato, reduce: Property
+			reduce(34), // propGhost, reduce: Property
+			reduce(34), // uint, reduce: Property
+			reduce(34), // propDot, reduce: Property
+			reduce(34), // propTuplet, reduce: Property
+			reduce(34), // propLetRing, reduce: Property
+			reduce(34), // propTie, reduce: Property
+			reduce(34), // propAftertouch, reduce: Property
+			reduce(34), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S183
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(35), // chord, reduce: Property
+			reduce(35), // pitch, reduce: Property
+			reduce(35), // degree, reduce: Property
+			reduce(35), // bracketBegin, reduce: Property
+			reduce(35), // bracketEnd, reduce: Property
+			reduce(35), // symbol, reduce: Property
+			reduce(35), // rest, reduce: Property
+			reduce(35), // propSharp, reduce: Property
+			reduce(35), // propFlat, reduce: Property
+			reduce(35), // propOctaveUp, reduce: Property
+			reduce(35), // propOctaveDown, reduce: Property
+			reduce(35), // propStaccato, reduce: Property
+			reduce(35), // propAccent, reduce: Property
+			reduce(35), // propMarcato, reduce: Property
+			reduce(35), // propGhost, reduce: Property
+			reduce(35), // uint, reduce: Property
+			reduce(35), // propDot, reduce: Property
+			reduce(35), // propTuplet, reduce: Property
+			reduce(35), // propLetRing, reduce: Property
+			reduce(35), // propTie, reduce: Property
+			reduce(35), // propAftertouch, reduce: Property
+			reduce(35), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S184
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(36), // chord, reduce: Property
+			reduce(36), // pitch, reduce: Property
+			reduce(36), // degree, reduce: Property
+			reduce(36), // bracketBegin, reduce: Property
+			reduce(36), // bracketEnd, reduce: Property
+			reduce(36), // symbol, reduce: Property
+			reduce(36), // rest, reduce: Property
+			reduce(36), // propSharp, reduce: Property
+			reduce(36), // propFlat, reduce: Property
+			reduce(36), // propOctaveUp, reduce: Property
+			reduce(36), // propOctaveDown, reduce: Property
+			reduce(36), // propStaccato, reduce: Property
+			reduce(36), // propAccent, reduce: Property
+			reduce(36), // propMarcato, reduce: Property
+			reduce(36), // propGhost, reduce: Property
+			reduce(36), // uint, reduce: Property
+			reduce(36), // propDot, reduce: Property
+			reduce(36), // propTuplet, reduce: Property
+			reduce(36), // propLetRing, reduce: Property
+			reduce(36), // propTie, reduce: Property
+			reduce(36), // propAftertouch, reduce: Property
+			reduce(36), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S185
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(37), // chord, reduce: Property
+			reduce(37), // pitch, reduce: Property
+			reduce(37), // degree, reduce: Property
+			reduce(37), // bracketBegin, reduce: Property
+			reduce(37), // bracketEnd, reduce: Property
+			reduce(37), // symbol, reduce: Property
+			reduce(37), // rest, reduce: Property
+			reduce(37), // propSharp, reduce: Property
+			reduce(37), // propFlat, reduce: Property
+			reduce(37), // propOctaveUp, reduce: Property
+			reduce(37), // propOctaveDown, reduce: Property
+			reduce(37), // propStaccato, reduce: Property
+			reduce(37), // propAccent, reduce: Property
+			reduce(37), // propMarcato, reduce: Property
+			reduce(37), // propGhost, reduce: Property
+			reduce(37), // uint, reduce: Property
+			reduce(37), // propDot, reduce: Property
+			reduce(37), // propTuplet, reduce: Property
+			reduce(37), // propLetRing, reduce: Property
+			reduce(37), // propTie, reduce: Property
+			reduce(37), // propAftertouch, reduce: Property
+			reduce(37), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S186
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(38), // chord, reduce: Property
+			reduce(38), // pitch, reduce: Property
+			reduce(38), // degree, reduce: Property
+			reduce(38), // bracketBegin, reduce: Property
+			reduce(38), // bracketEnd, reduce: Property
+			reduce(38), // symbol, reduce: Property
+			reduce(38), // rest, reduce: Property
+			reduce(38), // propSharp, reduce: Property
+			reduce(38), // propFlat, reduce: Property
+			reduce(38), // propOctaveUp, reduce: Property
+			reduce(38), // propOctaveDown, reduce: Property
+			reduce(38), // propStaccato, reduce: Property
+			reduce(38), // propAccent, reduce: Property
+			reduce(38), // propMarcato, reduce: Property
+			reduce(38), // propGhost, reduce: Property
+			reduce(38), // uint, reduce: Property
+			reduce(38), // propDot, reduce: Property
+			reduce(38), // propTuplet, reduce: Property
+			reduce(38), // propLetRing, reduce: Property
+			reduce(38), // propTie, reduce: Property
+			reduce(38), // propAftertouch, reduce: Property
+			reduce(38), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S187
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(39), // chord, reduce: Property
+			reduce(39), // pitch, reduce: Property
+			reduce(39), // degree, reduce: Property
+			reduce(39), // bracketBegin, reduce: Property
+			reduce(39), // bracketEnd, reduce: Property
+			reduce(39), // symbol, reduce: Property
+			reduce(39), // rest, reduce: Property
+			reduce(39), // propSharp, reduce: Property
+			reduce(39), // propFlat, reduce: Property
+			reduce(39), // propOctaveUp, reduce: Property
+			reduce(39), // propOctaveDown, reduce: Property
+			reduce(39), // propStaccato, reduce: Property
+			reduce(39), // propAccent, reduce: Property
+			reduce(39), // propMarcato, reduce: Property
+			reduce(39), // propGhost, reduce: Property
+			reduce(39), // uint, reduce: Property
+			reduce(39), // propDot, reduce: Property
+			reduce(39), // propTuplet, reduce: Property
+			reduce(39), // propLetRing, reduce: Property
+			reduce(39), // propTie, reduce: Property
+			reduce(39), // propAftertouch, reduce: Property
+			reduce(39), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S188
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(40), // chord, reduce: Property
+			reduce(40), // pitch, reduce: Property
+			reduce(40), // degree, reduce: Property
+			reduce(40), // bracketBegin, reduce: Property
+			reduce(40), // bracketEnd, reduce: Property
+			reduce(40), // symbol, reduce: Property
+			reduce(40), // rest, reduce: Property
+			reduce(40), // propSharp, reduce: Property
+			reduce(40), // propFlat, reduce: Property
+			reduce(40), // propOctaveUp, reduce: Property
+			reduce(40), // propOctaveDown, reduce: Property
+			reduce(40), // propStaccato, reduce: Property
+			reduce(40), // propAccent, reduce: Property
+			reduce(40), // propMarcato, reduce: Property
+			reduce(40), // propGhost, reduce: Property
+			reduce(40), // uint, reduce: Property
+			reduce(40), // propDot, reduce: Property
+			reduce(40), // propTuplet, reduce: Property
+			reduce(40), // propLetRing, reduce: Property
+			reduce(40), // propTie, reduce: Property
+			reduce(40), // propAftertouch, reduce: Property
+			reduce(40), // propChance, reduce: Property
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S189
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(17), // chord, reduce: NoteObject
+			reduce(17), // pitch, reduce: NoteObject
+			reduce(17), // degree, reduce: NoteObject
+			reduce(17), // bracketBegin, reduce: NoteObject
+			reduce(17), // bracketEnd, reduce: NoteObject
+			reduce(17), // symbol, reduce: NoteObject
+			reduce(17), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S190
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(18), // chord, reduce: NoteObject
+			reduce(18), // pitch, reduce: NoteObject
+			reduce(18), // degree, reduce: NoteObject
+			reduce(18), // bracketBegin, reduce: NoteObject
+			reduce(18), // bracketEnd, reduce: NoteObject
+			reduce(18), // symbol, reduce: NoteObject
+			reduce(18), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S191
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			reduce(19), // chord, reduce: NoteObject
+			reduce(19), // pitch, reduce: NoteObject
+			reduce(19), // degree, reduce: NoteObject
+			reduce(19), // bracketBegin, reduce: NoteObject
+			reduce(19), // bracketEnd, reduce: NoteObject
+			reduce(19), // symbol, reduce: NoteObject
+			reduce(19), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S192
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			shift(247), // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S193
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			shift(116), // lineComment
+			shift(122), // cmdBar
+			nil,        // cmdEnd
+			shift(125), // chord
+			shift(126), // pitch
+			shift(127), // degree
+			shift(129), // bracketBegin
+			nil,        // bracketEnd
+			shift(130), // symbol
+			shift(131), // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			shift(132), // cmdRepeat
+			shift(133), // cmdAssign
+			shift(134), // cmdKit
+			shift(135), // cmdPlay
+			shift(136), // cmdTempo
+			nil,        // arrow
+			shift(137), // cmdKey
+			shift(138), // cmdScale
+			shift(139), // cmdTime
+			shift(140), // cmdMeter
+			shift(141), // cmdVelocity
+			shift(142), // cmdOctave
+			shift(143), // cmdChannel
+			shift(144), // cmdVoice
+			shift(145), // cmdProgram
+			shift(146), // cmdProgramName
+			nil,        // string
+			shift(147), // cmdControl
+			shift(148), // cmdControlRamp
+			shift(149), // cmdBend
+			shift(150), // cmdPressure
+			shift(151), // cmdSysex
+			shift(152), // cmdSysexFile
+			shift(153), // cmdRPN
+			shift(154), // cmdNRPN
+			shift(155), // cmdSwing
+			shift(156), // cmdGroove
+			shift(157), // cmdHumanize
+			shift(158), // cmdStart
+			shift(159), // cmdStop
+			shift(160), // cmdPickup
+			shift(161), // cmdStaff
+			shift(162), // cmdInclude
+			shift(163), // cmdVolta
+			shift(164), // cmdDyn
+			shift(165), // cmdDynamics
+			shift(166), // cmdCresc
+			shift(167), // cmdDim
+			shift(168), // blockComment
+		},
+	},
+	actionRow{ // S194
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(42), // ␚, reduce: Command
+			nil,        // empty
+			reduce(42), // terminator, reduce: Command
+			reduce(42), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S195
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(249), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S196
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(50), // ␚, reduce: Command
+			nil,        // empty
+			reduce(50), // terminator, reduce: Command
+			reduce(50), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S197
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(51), // ␚, reduce: Command
+			nil,        // empty
+			reduce(51), // terminator, reduce: Command
+			reduce(51), // lineComment, reduce: Command
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S198
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(250), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S199
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(251), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S200
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(4), // ␚, reduce: DeclList
+			nil,       // empty
+			nil,       // terminator
+			nil,       // lineComment
+			nil,       // cmdBar
+			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
+			nil,       // bracketBegin
+			nil,       // bracketEnd
+			nil,       // symbol
+			nil,       // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
+		},
+	},
+	actionRow{ // S201
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(3), // ␚, reduce: RepeatTerminator
+			nil,       // empty
+			nil,       // terminator
+			reduce(3), // lineComment, reduce: RepeatTerminator
+			reduce(3), // cmdBar, reduce: RepeatTerminator
+			nil,       // cmdEnd
+			reduce(3), // chord, reduce: RepeatTerminator
+			reduce(3), // pitch, reduce: RepeatTerminator
+			reduce(3), // degree, reduce: RepeatTerminator
+			reduce(3), // bracketBegin, reduce: RepeatTerminator
+			nil,       // bracketEnd
+			reduce(3), // symbol, reduce: RepeatTerminator
+			reduce(3), // rest, reduce: RepeatTerminator
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			reduce(3), // cmdRepeat, reduce: RepeatTerminator
+			reduce(3), // cmdAssign, reduce: RepeatTerminator
+			reduce(3), // cmdKit, reduce: RepeatTerminator
+			reduce(3), // cmdPlay, reduce: RepeatTerminator
+			reduce(3), // cmdTempo, reduce: RepeatTerminator
+			nil,       // arrow
+			reduce(3), // cmdKey, reduce: RepeatTerminator
+			reduce(3), // cmdScale, reduce: RepeatTerminator
+			reduce(3), // cmdTime, reduce: RepeatTerminator
+			reduce(3), // cmdMeter, reduce: RepeatTerminator
+			reduce(3), // cmdVelocity, reduce: RepeatTerminator
+			reduce(3), // cmdOctave, reduce: RepeatTerminator
+			reduce(3), // cmdChannel, reduce: RepeatTerminator
+			reduce(3), // cmdVoice, reduce: RepeatTerminator
+			reduce(3), // cmdProgram, reduce: RepeatTerminator
+			reduce(3), // cmdProgramName, reduce: RepeatTerminator
+			nil,       // string
+			reduce(3), // cmdControl, reduce: RepeatTerminator
+			reduce(3), // cmdControlRamp, reduce: RepeatTerminator
+			reduce(3), // cmdBend, reduce: RepeatTerminator
+			reduce(3), // cmdPressure, reduce: RepeatTerminator
+			reduce(3), // cmdSysex, reduce: RepeatTerminator
+			reduce(3), // cmdSysexFile, reduce: RepeatTerminator
+			reduce(3), // cmdRPN, reduce: RepeatTerminator
+			reduce(3), // cmdNRPN, reduce: RepeatTerminator
+			reduce(3), // cmdSwing, reduce: RepeatTerminator
+			reduce(3), // cmdGroove, reduce: RepeatTerminator
+			reduce(3), // cmdHumanize, reduce: RepeatTerminator
+			reduce(3), // cmdStart, reduce: RepeatTerminator
+			reduce(3), // cmdStop, reduce: RepeatTerminator
+			reduce(3), // cmdPickup, reduce: RepeatTerminator
+			reduce(3), // cmdStaff, reduce: RepeatTerminator
+			reduce(3), // cmdInclude, reduce: RepeatTerminator
+			reduce(3), // cmdVolta, reduce: RepeatTerminator
+			reduce(3), // cmdDyn, reduce: RepeatTerminator
+			reduce(3), // cmdDynamics, reduce: RepeatTerminator
+			reduce(3), // cmdCresc, reduce: RepeatTerminator
+			reduce(3), // cmdDim, reduce: RepeatTerminator
+			reduce(3), // blockComment, reduce: RepeatTerminator
+		},
+	},
+	actionRow{ // S202
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // ␚
-			nil,        // empty
-			reduce(18), // terminator, reduce: PropertyList
-			nil,        // cmdBar
-			reduce(18), // cmdEnd, reduce: PropertyList
-			reduce(18), // bracketBegin, reduce: PropertyList
-			nil,        // bracketEnd
-			reduce(18), // symbol, reduce: PropertyList
-			reduce(18), // rest, reduce: PropertyList
-			shift(118), // propSharp
-			shift(119), // propFlat
-			shift(120), // propStaccato
-			shift(121), // propAccent
-			shift(122), // propMarcato
-			shift(123), // propGhost
-			shift(124), // uint
-			shift(125), // propDot
-			shift(126), // propTuplet
-			shift(127), // propLetRing
-			nil,        // cmdAssign
-			nil,        // cmdPlay
-			nil,        // cmdTempo
-			nil,        // cmdKey
-			nil,        // cmdTime
-			nil,        // cmdVelocity
-			nil,        // cmdChannel
-			nil,        // cmdVoice
-			nil,        // cmdProgram
-			nil,        // cmdControl
-			nil,        // cmdStart
-			nil,        // cmdStop
-			nil,        // blockComment
+			nil,       // INVALID
+			reduce(3), // ␚, reduce: RepeatTerminator
+			nil,       // empty
+			nil,       // terminator
+			shift(6),  // lineComment
+			shift(12), // cmdBar
+			nil,       // cmdEnd
+			shift(15), // chord
+			shift(16), // pitch
+			shift(17), // degree
+			shift(19), // bracketBegin
+			nil,       // bracketEnd
+			shift(20), // symbol
+			shift(21), // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			shift(22), // cmdRepeat
+			shift(23), // cmdAssign
+			shift(24), // cmdKit
+			shift(25), // cmdPlay
+			shift(26), // cmdTempo
+			nil,       // arrow
+			shift(27), // cmdKey
+			shift(28), // cmdScale
+			shift(29), // cmdTime
+			shift(30), // cmdMeter
+			shift(31), // cmdVelocity
+			shift(32), // cmdOctave
+			shift(33), // cmdChannel
+			shift(34), // cmdVoice
+			shift(35), // cmdProgram
+			shift(36), // cmdProgramName
+			nil,       // string
+			shift(37), // cmdControl
+			shift(38), // cmdControlRamp
+			shift(39), // cmdBend
+			shift(40), // cmdPressure
+			shift(41), // cmdSysex
+			shift(42), // cmdSysexFile
+			shift(43), // cmdRPN
+			shift(44), // cmdNRPN
+			shift(45), // cmdSwing
+			shift(46), // cmdGroove
+			shift(47), // cmdHumanize
+			shift(48), // cmdStart
+			shift(49), // cmdStop
+			shift(50), // cmdPickup
+			shift(51), // cmdStaff
+			shift(52), // cmdInclude
+			shift(53), // cmdVolta
+			shift(54), // cmdDyn
+			shift(55), // cmdDynamics
+			shift(56), // cmdCresc
+			shift(57), // cmdDim
+			shift(58), // blockComment
 		},
 	},
-	actionRow{ // S73
+	actionRow{ // S203
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(13), // ␚, reduce: Bar
 			nil,        // empty
-			reduce(14), // terminator, reduce: NoteObject
+			reduce(13), // terminator, reduce: Bar
+			reduce(13), // lineComment, reduce: Bar
 			nil,        // cmdBar
-			reduce(14), // cmdEnd, reduce: NoteObject
-			reduce(14), // bracketBegin, reduce: NoteObject
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(14), // symbol, reduce: NoteObject
-			reduce(14), // rest, reduce: NoteObject
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -2808,36 +14859,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S74
+	actionRow{ // S204
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // terminator
+			nil,       // lineComment
 			nil,       // cmdBar
-			nil,       // cmdEnd
-			shift(51), // bracketBegin
+			reduce(6), // cmdEnd, reduce: DeclList
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
+			nil,       // bracketBegin
 			nil,       // bracketEnd
-			shift(52), // symbol
-			shift(53), // rest
+			nil,       // symbol
+			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -2846,112 +14932,290 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
-	actionRow{ // S75
+	actionRow{ // S205
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(16), // terminator, reduce: NoteSymbol
-			nil,        // cmdBar
-			reduce(16), // cmdEnd, reduce: NoteSymbol
-			reduce(16), // bracketBegin, reduce: NoteSymbol
+			shift(254), // terminator
+			reduce(2),  // lineComment, reduce: RepeatTerminator
+			reduce(2),  // cmdBar, reduce: RepeatTerminator
+			reduce(2),  // cmdEnd, reduce: RepeatTerminator
+			reduce(2),  // chord, reduce: RepeatTerminator
+			reduce(2),  // pitch, reduce: RepeatTerminator
+			reduce(2),  // degree, reduce: RepeatTerminator
+			reduce(2),  // bracketBegin, reduce: RepeatTerminator
 			nil,        // bracketEnd
-			reduce(16), // symbol, reduce: NoteSymbol
-			reduce(16), // rest, reduce: NoteSymbol
-			reduce(16), // propSharp, reduce: NoteSymbol
-			reduce(16), // propFlat, reduce: NoteSymbol
-			reduce(16), // propStaccato, reduce: NoteSymbol
-			reduce(16), // propAccent, reduce: NoteSymbol
-			reduce(16), // propMarcato, reduce: NoteSymbol
-			reduce(16), // propGhost, reduce: NoteSymbol
-			reduce(16), // uint, reduce: NoteSymbol
-			reduce(16), // propDot, reduce: NoteSymbol
-			reduce(16), // propTuplet, reduce: NoteSymbol
-			reduce(16), // propLetRing, reduce: NoteSymbol
-			nil,        // cmdAssign
-			nil,        // cmdPlay
-			nil,        // cmdTempo
-			nil,        // cmdKey
-			nil,        // cmdTime
-			nil,        // cmdVelocity
-			nil,        // cmdChannel
-			nil,        // cmdVoice
-			nil,        // cmdProgram
-			nil,        // cmdControl
-			nil,        // cmdStart
-			nil,        // cmdStop
-			nil,        // blockComment
+			reduce(2),  // symbol, reduce: RepeatTerminator
+			reduce(2),  // rest, reduce: RepeatTerminator
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			reduce(2),  // cmdRepeat, reduce: RepeatTerminator
+			reduce(2),  // cmdAssign, reduce: RepeatTerminator
+			reduce(2),  // cmdKit, reduce: RepeatTerminator
+			reduce(2),  // cmdPlay, reduce: RepeatTerminator
+			reduce(2),  // cmdTempo, reduce: RepeatTerminator
+			nil,        // arrow
+			reduce(2),  // cmdKey, reduce: RepeatTerminator
+			reduce(2),  // cmdScale, reduce: RepeatTerminator
+			reduce(2),  // cmdTime, reduce: RepeatTerminator
+			reduce(2),  // cmdMeter, reduce: RepeatTerminator
+			reduce(2),  // cmdVelocity, reduce: RepeatTerminator
+			reduce(2),  // cmdOctave, reduce: RepeatTerminator
+			reduce(2),  // cmdChannel, reduce: RepeatTerminator
+			reduce(2),  // cmdVoice, reduce: RepeatTerminator
+			reduce(2),  // cmdProgram, reduce: RepeatTerminator
+			reduce(2),  // cmdProgramName, reduce: RepeatTerminator
+			nil,        // string
+			reduce(2),  // cmdControl, reduce: RepeatTerminator
+			reduce(2),  // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2),  // cmdBend, reduce: RepeatTerminator
+			reduce(2),  // cmdPressure, reduce: RepeatTerminator
+			reduce(2),  // cmdSysex, reduce: RepeatTerminator
+			reduce(2),  // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2),  // cmdRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdNRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdSwing, reduce: RepeatTerminator
+			reduce(2),  // cmdGroove, reduce: RepeatTerminator
+			reduce(2),  // cmdHumanize, reduce: RepeatTerminator
+			reduce(2),  // cmdStart, reduce: RepeatTerminator
+			reduce(2),  // cmdStop, reduce: RepeatTerminator
+			reduce(2),  // cmdPickup, reduce: RepeatTerminator
+			reduce(2),  // cmdStaff, reduce: RepeatTerminator
+			reduce(2),  // cmdInclude, reduce: RepeatTerminator
+			reduce(2),  // cmdVolta, reduce: RepeatTerminator
+			reduce(2),  // cmdDyn, reduce: RepeatTerminator
+			reduce(2),  // cmdDynamics, reduce: RepeatTerminator
+			reduce(2),  // cmdCresc, reduce: RepeatTerminator
+			reduce(2),  // cmdDim, reduce: RepeatTerminator
+			reduce(2),  // blockComment, reduce: RepeatTerminator
 		},
 	},
-	actionRow{ // S76
+	actionRow{ // S206
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(17), // terminator, reduce: NoteSymbol
+			shift(256), // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
-			reduce(17), // cmdEnd, reduce: NoteSymbol
-			reduce(17), // bracketBegin, reduce: NoteSymbol
+			reduce(2),  // cmdEnd, reduce: RepeatTerminator
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(17), // symbol, reduce: NoteSymbol
-			reduce(17), // rest, reduce: NoteSymbol
-			reduce(17), // propSharp, reduce: NoteSymbol
-			reduce(17), // propFlat, reduce: NoteSymbol
-			reduce(17), // propStaccato, reduce: NoteSymbol
-			reduce(17), // propAccent, reduce: NoteSymbol
-			reduce(17), // propMarcato, reduce: NoteSymbol
-			reduce(17), // propGhost, reduce: NoteSymbol
-			reduce(17), // uint, reduce: NoteSymbol
-			reduce(17), // propDot, reduce: NoteSymbol
-			reduce(17), // propTuplet, reduce: NoteSymbol
-			reduce(17), // propLetRing, reduce: NoteSymbol
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S77
+	actionRow{ // S207
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
 			nil,        // terminator
-			nil,        // cmdBar
+			shift(116), // lineComment
+			shift(122), // cmdBar
 			nil,        // cmdEnd
+			shift(125), // chord
+			shift(126), // pitch
+			shift(127), // degree
+			shift(129), // bracketBegin
+			nil,        // bracketEnd
+			shift(130), // symbol
+			shift(131), // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			shift(132), // cmdRepeat
+			shift(133), // cmdAssign
+			shift(134), // cmdKit
+			shift(135), // cmdPlay
+			shift(136), // cmdTempo
+			nil,        // arrow
+			shift(137), // cmdKey
+			shift(138), // cmdScale
+			shift(139), // cmdTime
+			shift(140), // cmdMeter
+			shift(141), // cmdVelocity
+			shift(142), // cmdOctave
+			shift(143), // cmdChannel
+			shift(144), // cmdVoice
+			shift(145), // cmdProgram
+			shift(146), // cmdProgramName
+			nil,        // string
+			shift(147), // cmdControl
+			shift(148), // cmdControlRamp
+			shift(149), // cmdBend
+			shift(150), // cmdPressure
+			shift(151), // cmdSysex
+			shift(152), // cmdSysexFile
+			shift(153), // cmdRPN
+			shift(154), // cmdNRPN
+			shift(155), // cmdSwing
+			shift(156), // cmdGroove
+			shift(157), // cmdHumanize
+			shift(158), // cmdStart
+			shift(159), // cmdStop
+			shift(160), // cmdPickup
+			shift(161), // cmdStaff
+			shift(162), // cmdInclude
+			shift(163), // cmdVolta
+			shift(164), // cmdDyn
+			shift(165), // cmdDynamics
+			shift(166), // cmdCresc
+			shift(167), // cmdDim
+			shift(168), // blockComment
+		},
+	},
+	actionRow{ // S208
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(15), // terminator, reduce: NoteList
+			reduce(15), // lineComment, reduce: NoteList
+			nil,        // cmdBar
+			reduce(15), // cmdEnd, reduce: NoteList
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
-			shift(129), // symbol
+			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -2960,36 +15224,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S78
+	actionRow{ // S209
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(31), // terminator, reduce: Command
+			reduce(16), // terminator, reduce: NoteObject
+			reduce(16), // lineComment, reduce: NoteObject
 			nil,        // cmdBar
-			reduce(31), // cmdEnd, reduce: Command
-			nil,        // bracketBegin
+			reduce(16), // cmdEnd, reduce: NoteObject
+			reduce(16), // chord, reduce: NoteObject
+			reduce(16), // pitch, reduce: NoteObject
+			reduce(16), // degree, reduce: NoteObject
+			reduce(16), // bracketBegin, reduce: NoteObject
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
+			reduce(16), // symbol, reduce: NoteObject
+			reduce(16), // rest, reduce: NoteObject
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -2998,1024 +15297,2042 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S79
+	actionRow{ // S210
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			nil,        // bracketBegin
+			reduce(24), // cmdEnd, reduce: PropertyList
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			shift(130), // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(211), // propSharp
+			shift(212), // propFlat
+			shift(213), // propOctaveUp
+			shift(214), // propOctaveDown
+			shift(215), // propStaccato
+			shift(216), // propAccent
+			shift(217), // propMarcato
+			shift(218), // propGhost
+			shift(219), // uint
+			shift(220), // propDot
+			shift(221), // propTuplet
+			shift(222), // propLetRing
+			shift(223), // propTie
+			shift(224), // propAftertouch
+			shift(225), // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S80
+	actionRow{ // S211
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(33), // terminator, reduce: Command
+			reduce(26), // terminator, reduce: Property
+			reduce(26), // lineComment, reduce: Property
 			nil,        // cmdBar
-			reduce(33), // cmdEnd, reduce: Command
-			nil,        // bracketBegin
+			reduce(26), // cmdEnd, reduce: Property
+			reduce(26), // chord, reduce: Property
+			reduce(26), // pitch, reduce: Property
+			reduce(26), // degree, reduce: Property
+			reduce(26), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			nil,        // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(26), // symbol, reduce: Property
+			reduce(26), // rest, reduce: Property
+			reduce(26), // propSharp, reduce: Property
+			reduce(26), // propFlat, reduce: Property
+			reduce(26), // propOctaveUp, reduce: Property
+			reduce(26), // propOctaveDown, reduce: Property
+			reduce(26), // propStaccato, reduce: Property
+			reduce(26), // propAccent, reduce: Property
+			reduce(26), // propMarcato, reduce: Property
+			reduce(26), // propGhost, reduce: Property
+			reduce(26), // uint, reduce: Property
+			reduce(26), // propDot, reduce: Property
+			reduce(26), // propTuplet, reduce: Property
+			reduce(26), // propLetRing, reduce: Property
+			reduce(26), // propTie, reduce: Property
+			reduce(26), // propAftertouch, reduce: Property
+			reduce(26), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S81
+	actionRow{ // S212
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(27), // terminator, reduce: Property
+			reduce(27), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			nil,        // bracketBegin
+			reduce(27), // cmdEnd, reduce: Property
+			reduce(27), // chord, reduce: Property
+			reduce(27), // pitch, reduce: Property
+			reduce(27), // degree, reduce: Property
+			reduce(27), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			shift(131), // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(27), // symbol, reduce: Property
+			reduce(27), // rest, reduce: Property
+			reduce(27), // propSharp, reduce: Property
+			reduce(27), // propFlat, reduce: Property
+			reduce(27), // propOctaveUp, reduce: Property
+			reduce(27), // propOctaveDown, reduce: Property
+			reduce(27), // propStaccato, reduce: Property
+			reduce(27), // propAccent, reduce: Property
+			reduce(27), // propMarcato, reduce: Property
+			reduce(27), // propGhost, reduce: Property
+			reduce(27), // uint, reduce: Property
+			reduce(27), // propDot, reduce: Property
+			reduce(27), // propTuplet, reduce: Property
+			reduce(27), // propLetRing, reduce: Property
+			reduce(27), // propTie, reduce: Property
+			reduce(27), // propAftertouch, reduce: Property
+			reduce(27), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S82
+	actionRow{ // S213
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(28), // terminator, reduce: Property
+			reduce(28), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			nil,        // bracketBegin
+			reduce(28), // cmdEnd, reduce: Property
+			reduce(28), // chord, reduce: Property
+			reduce(28), // pitch, reduce: Property
+			reduce(28), // degree, reduce: Property
+			reduce(28), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			shift(132), // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(28), // symbol, reduce: Property
+			reduce(28), // rest, reduce: Property
+			reduce(28), // propSharp, reduce: Property
+			reduce(28), // propFlat, reduce: Property
+			reduce(28), // propOctaveUp, reduce: Property
+			reduce(28), // propOctaveDown, reduce: Property
+			reduce(28), // propStaccato, reduce: Property
+			reduce(28), // propAccent, reduce: Property
+			reduce(28), // propMarcato, reduce: Property
+			reduce(28), // propGhost, reduce: Property
+			reduce(28), // uint, reduce: Property
+			reduce(28), // propDot, reduce: Property
+			reduce(28), // propTuplet, reduce: Property
+			reduce(28), // propLetRing, reduce: Property
+			reduce(28), // propTie, reduce: Property
+			reduce(28), // propAftertouch, reduce: Property
+			reduce(28), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S83
+	actionRow{ // S214
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(29), // terminator, reduce: Property
+			reduce(29), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			nil,        // bracketBegin
+			reduce(29), // cmdEnd, reduce: Property
+			reduce(29), // chord, reduce: Property
+			reduce(29), // pitch, reduce: Property
+			reduce(29), // degree, reduce: Property
+			reduce(29), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			shift(133), // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(29), // symbol, reduce: Property
+			reduce(29), // rest, reduce: Property
+			reduce(29), // propSharp, reduce: Property
+			reduce(29), // propFlat, reduce: Property
+			reduce(29), // propOctaveUp, reduce: Property
+			reduce(29), // propOctaveDown, reduce: Property
+			reduce(29), // propStaccato, reduce: Property
+			reduce(29), // propAccent, reduce: Property
+			reduce(29), // propMarcato, reduce: Property
+			reduce(29), // propGhost, reduce: Property
+			reduce(29), // uint, reduce: Property
+			reduce(29), // propDot, reduce: Property
+			reduce(29), // propTuplet, reduce: Property
+			reduce(29), // propLetRing, reduce: Property
+			reduce(29), // propTie, reduce: Property
+			reduce(29), // propAftertouch, reduce: Property
+			reduce(29), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S84
+	actionRow{ // S215
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(30), // terminator, reduce: Property
+			reduce(30), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			nil,        // bracketBegin
+			reduce(30), // cmdEnd, reduce: Property
+			reduce(30), // chord, reduce: Property
+			reduce(30), // pitch, reduce: Property
+			reduce(30), // degree, reduce: Property
+			reduce(30), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			shift(134), // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(30), // symbol, reduce: Property
+			reduce(30), // rest, reduce: Property
+			reduce(30), // propSharp, reduce: Property
+			reduce(30), // propFlat, reduce: Property
+			reduce(30), // propOctaveUp, reduce: Property
+			reduce(30), // propOctaveDown, reduce: Property
+			reduce(30), // propStaccato, reduce: Property
+			reduce(30), // propAccent, reduce: Property
+			reduce(30), // propMarcato, reduce: Property
+			reduce(30), // propGhost, reduce: Property
+			reduce(30), // uint, reduce: Property
+			reduce(30), // propDot, reduce: Property
+			reduce(30), // propTuplet, reduce: Property
+			reduce(30), // propLetRing, reduce: Property
+			reduce(30), // propTie, reduce: Property
+			reduce(30), // propAftertouch, reduce: Property
+			reduce(30), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S85
+	actionRow{ // S216
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(31), // terminator, reduce: Property
+			reduce(31), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			nil,        // bracketBegin
+			reduce(31), // cmdEnd, reduce: Property
+			reduce(31), // chord, reduce: Property
+			reduce(31), // pitch, reduce: Property
+			reduce(31), // degree, reduce: Property
+			reduce(31), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			shift(135), // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(31), // symbol, reduce: Property
+			reduce(31), // rest, reduce: Property
+			reduce(31), // propSharp, reduce: Property
+			reduce(31), // propFlat, reduce: Property
+			reduce(31), // propOctaveUp, reduce: Property
+			reduce(31), // propOctaveDown, reduce: Property
+			reduce(31), // propStaccato, reduce: Property
+			reduce(31), // propAccent, reduce: Property
+			reduce(31), // propMarcato, reduce: Property
+			reduce(31), // propGhost, reduce: Property
+			reduce(31), // uint, reduce: Property
+			reduce(31), // propDot, reduce: Property
+			reduce(31), // propTuplet, reduce: Property
+			reduce(31), // propLetRing, reduce: Property
+			reduce(31), // propTie, reduce: Property
+			reduce(31), // propAftertouch, reduce: Property
+			reduce(31), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S86
+	actionRow{ // S217
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(32), // terminator, reduce: Property
+			reduce(32), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			nil,        // bracketBegin
+			reduce(32), // cmdEnd, reduce: Property
+			reduce(32), // chord, reduce: Property
+			reduce(32), // pitch, reduce: Property
+			reduce(32), // degree, reduce: Property
+			reduce(32), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			shift(136), // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(32), // symbol, reduce: Property
+			reduce(32), // rest, reduce: Property
+			reduce(32), // propSharp, reduce: Property
+			reduce(32), // propFlat, reduce: Property
+			reduce(32), // propOctaveUp, reduce: Property
+			reduce(32), // propOctaveDown, reduce: Property
+			reduce(32), // propStaccato, reduce: Property
+			reduce(32), // propAccent, reduce: Property
+			reduce(32), // propMarcato, reduce: Property
+			reduce(32), // propGhost, reduce: Property
+			reduce(32), // uint, reduce: Property
+			reduce(32), // propDot, reduce: Property
+			reduce(32), // propTuplet, reduce: Property
+			reduce(32), // propLetRing, reduce: Property
+			reduce(32), // propTie, reduce: Property
+			reduce(32), // propAftertouch, reduce: Property
+			reduce(32), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S87
+	actionRow{ // S218
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(40), // terminator, reduce: Command
+			reduce(33), // terminator, reduce: Property
+			reduce(33), // lineComment, reduce: Property
 			nil,        // cmdBar
-			reduce(40), // cmdEnd, reduce: Command
-			nil,        // bracketBegin
+			reduce(33), // cmdEnd, reduce: Property
+			reduce(33), // chord, reduce: Property
+			reduce(33), // pitch, reduce: Property
+			reduce(33), // degree, reduce: Property
+			reduce(33), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			nil,        // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(33), // symbol, reduce: Property
+			reduce(33), // rest, reduce: Property
+			reduce(33), // propSharp, reduce: Property
+			reduce(33), // propFlat, reduce: Property
+			reduce(33), // propOctaveUp, reduce: Property
+			reduce(33), // propOctaveDown, reduce: Property
+			reduce(33), // propStaccato, reduce: Property
+			reduce(33), // propAccent, reduce: Property
+			reduce(33), // propMarcato, reduce: Property
+			reduce(33), // propGhost, reduce: Property
+			reduce(33), // uint, reduce: Property
+			reduce(33), // propDot, reduce: Property
+			reduce(33), // propTuplet, reduce: Property
+			reduce(33), // propLetRing, reduce: Property
+			reduce(33), // propTie, reduce: Property
+			reduce(33), // propAftertouch, reduce: Property
+			reduce(33), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S88
+	actionRow{ // S219
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(41), // terminator, reduce: Command
+			reduce(34), // terminator, reduce: Property
+			reduce(34), // lineComment, reduce: Property
 			nil,        // cmdBar
-			reduce(41), // cmdEnd, reduce: Command
-			nil,        // bracketBegin
+			reduce(34), // cmdEnd, reduce: Property
+			reduce(34), // chord, reduce: Property
+			reduce(34), // pitch, reduce: Property
+			reduce(34), // degree, reduce: Property
+			reduce(34), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			nil,        // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(34), // symbol, reduce: Property
+			reduce(34), // rest, reduce: Property
+			reduce(34), // propSharp, reduce: Property
+			reduce(34), // propFlat, reduce: Property
+			reduce(34), // propOctaveUp, reduce: Property
+			reduce(34), // propOctaveDown, reduce: Property
+			reduce(34), // propStaccato, reduce: Property
+			reduce(34), // propAccent, reduce: Property
+			reduce(34), // propMarcato, reduce: Property
+			reduce(34), // propGhost, reduce: Property
+			reduce(34), // uint, reduce: Property
+			reduce(34), // propDot, reduce: Property
+			reduce(34), // propTuplet, reduce: Property
+			reduce(34), // propLetRing, reduce: Property
+			reduce(34), // propTie, reduce: Property
+			reduce(34), // propAftertouch, reduce: Property
+			reduce(34), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S89
+	actionRow{ // S220
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(42), // terminator, reduce: Comment
+			reduce(35), // terminator, reduce: Property
+			reduce(35), // lineComment, reduce: Property
 			nil,        // cmdBar
-			reduce(42), // cmdEnd, reduce: Comment
-			nil,        // bracketBegin
+			reduce(35), // cmdEnd, reduce: Property
+			reduce(35), // chord, reduce: Property
+			reduce(35), // pitch, reduce: Property
+			reduce(35), // degree, reduce: Property
+			reduce(35), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			nil,        // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(35), // symbol, reduce: Property
+			reduce(35), // rest, reduce: Property
+			reduce(35), // propSharp, reduce: Property
+			reduce(35), // propFlat, reduce: Property
+			reduce(35), // propOctaveUp, reduce: Property
+			reduce(35), // propOctaveDown, reduce: Property
+			reduce(35), // propStaccato, reduce: Property
+			reduce(35), // propAccent, reduce: Property
+			reduce(35), // propMarcato, reduce: Property
+			reduce(35), // propGhost, reduce: Property
+			reduce(35), // uint, reduce: Property
+			reduce(35), // propDot, reduce: Property
+			reduce(35), // propTuplet, reduce: Property
+			reduce(35), // propLetRing, reduce: Property
+			reduce(35), // propTie, reduce: Property
+			reduce(35), // propAftertouch, reduce: Property
+			reduce(35), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S90
+	actionRow{ // S221
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(19), // ␚, reduce: PropertyList
+			nil,        // ␚
 			nil,        // empty
-			reduce(19), // terminator, reduce: PropertyList
+			reduce(36), // terminator, reduce: Property
+			reduce(36), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(19), // bracketBegin, reduce: PropertyList
+			reduce(36), // cmdEnd, reduce: Property
+			reduce(36), // chord, reduce: Property
+			reduce(36), // pitch, reduce: Property
+			reduce(36), // degree, reduce: Property
+			reduce(36), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			reduce(19), // symbol, reduce: PropertyList
-			reduce(19), // rest, reduce: PropertyList
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			nil,        // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(36), // symbol, reduce: Property
+			reduce(36), // rest, reduce: Property
+			reduce(36), // propSharp, reduce: Property
+			reduce(36), // propFlat, reduce: Property
+			reduce(36), // propOctaveUp, reduce: Property
+			reduce(36), // propOctaveDown, reduce: Property
+			reduce(36), // propStaccato, reduce: Property
+			reduce(36), // propAccent, reduce: Property
+			reduce(36), // propMarcato, reduce: Property
+			reduce(36), // propGhost, reduce: Property
+			reduce(36), // uint, reduce: Property
+			reduce(36), // propDot, reduce: Property
+			reduce(36), // propTuplet, reduce: Property
+			reduce(36), // propLetRing, reduce: Property
+			reduce(36), // propTie, reduce: Property
+			reduce(36), // propAftertouch, reduce: Property
+			reduce(36), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S91
+	actionRow{ // S222
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(18), // ␚, reduce: PropertyList
+			nil,        // ␚
 			nil,        // empty
-			reduce(18), // terminator, reduce: PropertyList
+			reduce(37), // terminator, reduce: Property
+			reduce(37), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(18), // bracketBegin, reduce: PropertyList
+			reduce(37), // cmdEnd, reduce: Property
+			reduce(37), // chord, reduce: Property
+			reduce(37), // pitch, reduce: Property
+			reduce(37), // degree, reduce: Property
+			reduce(37), // bracketBegin, reduce: Property
 			nil,        // bracketEnd
-			reduce(18), // symbol, reduce: PropertyList
-			reduce(18), // rest, reduce: PropertyList
-			shift(37),  // propSharp
-			shift(38),  // propFlat
-			shift(39),  // propStaccato
-			shift(40),  // propAccent
-			shift(41),  // propMarcato
-			shift(42),  // propGhost
-			shift(43),  // uint
-			shift(44),  // propDot
-			shift(45),  // propTuplet
-			shift(46),  // propLetRing
+			reduce(37), // symbol, reduce: Property
+			reduce(37), // rest, reduce: Property
+			reduce(37), // propSharp, reduce: Property
+			reduce(37), // propFlat, reduce: Property
+			reduce(37), // propOctaveUp, reduce: Property
+			reduce(37), // propOctaveDown, reduce: Property
+			reduce(37), // propStaccato, reduce: Property
+			reduce(37), // propAccent, reduce: Property
+			reduce(37), // propMarcato, reduce: Property
+			reduce(37), // propGhost, reduce: Property
+			reduce(37), // uint, reduce: Property
+			reduce(37), // propDot, reduce: Property
+			reduce(37), // propTuplet, reduce: Property
+			reduce(37), // propLetRing, reduce: Property
+			reduce(37), // propTie, reduce: Property
+			reduce(37), // propAftertouch, reduce: Property
+			reduce(37), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S92
+	actionRow{ // S223
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(38), // terminator, reduce: Property
+			reduce(38), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			nil,        // bracketBegin
-			reduce(12), // bracketEnd, reduce: NoteList
-			nil,        // symbol
-			nil,        // rest
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			nil,        // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(38), // cmdEnd, reduce: Property
+			reduce(38), // chord, reduce: Property
+			reduce(38), // pitch, reduce: Property
+			reduce(38), // degree, reduce: Property
+			reduce(38), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(38), // symbol, reduce: Property
+			reduce(38), // rest, reduce: Property
+			reduce(38), // propSharp, reduce: Property
+			reduce(38), // propFlat, reduce: Property
+			reduce(38), // propOctaveUp, reduce: Property
+			reduce(38), // propOctaveDown, reduce: Property
+			reduce(38), // propStaccato, reduce: Property
+			reduce(38), // propAccent, reduce: Property
+			reduce(38), // propMarcato, reduce: Property
+			reduce(38), // propGhost, reduce: Property
+			reduce(38), // uint, reduce: Property
+			reduce(38), // propDot, reduce: Property
+			reduce(38), // propTuplet, reduce: Property
+			reduce(38), // propLetRing, reduce: Property
+			reduce(38), // propTie, reduce: Property
+			reduce(38), // propAftertouch, reduce: Property
+			reduce(38), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S93
+	actionRow{ // S224
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(39), // terminator, reduce: Property
+			reduce(39), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(13), // bracketBegin, reduce: NoteObject
-			reduce(13), // bracketEnd, reduce: NoteObject
-			reduce(13), // symbol, reduce: NoteObject
-			reduce(13), // rest, reduce: NoteObject
-			nil,        // propSharp
-			nil,        // propFlat
-			nil,        // propStaccato
-			nil,        // propAccent
-			nil,        // propMarcato
-			nil,        // propGhost
-			nil,        // uint
-			nil,        // propDot
-			nil,        // propTuplet
-			nil,        // propLetRing
+			reduce(39), // cmdEnd, reduce: Property
+			reduce(39), // chord, reduce: Property
+			reduce(39), // pitch, reduce: Property
+			reduce(39), // degree, reduce: Property
+			reduce(39), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(39), // symbol, reduce: Property
+			reduce(39), // rest, reduce: Property
+			reduce(39), // propSharp, reduce: Property
+			reduce(39), // propFlat, reduce: Property
+			reduce(39), // propOctaveUp, reduce: Property
+			reduce(39), // propOctaveDown, reduce: Property
+			reduce(39), // propStaccato, reduce: Property
+			reduce(39), // propAccent, reduce: Property
+			reduce(39), // propMarcato, reduce: Property
+			reduce(39), // propGhost, reduce: Property
+			reduce(39), // uint, reduce: Property
+			reduce(39), // propDot, reduce: Property
+			reduce(39), // propTuplet, reduce: Property
+			reduce(39), // propLetRing, reduce: Property
+			reduce(39), // propTie, reduce: Property
+			reduce(39), // propAftertouch, reduce: Property
+			reduce(39), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S94
+	actionRow{ // S225
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(40), // terminator, reduce: Property
+			reduce(40), // lineComment, reduce: Property
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(18), // bracketBegin, reduce: PropertyList
-			reduce(18), // bracketEnd, reduce: PropertyList
-			reduce(18), // symbol, reduce: PropertyList
-			reduce(18), // rest, reduce: PropertyList
-			shift(95),  // propSharp
-			shift(96),  // propFlat
-			shift(97),  // propStaccato
-			shift(98),  // propAccent
-			shift(99),  // propMarcato
-			shift(100), // propGhost
-			shift(101), // uint
-			shift(102), // propDot
-			shift(103), // propTuplet
-			shift(104), // propLetRing
+			reduce(40), // cmdEnd, reduce: Property
+			reduce(40), // chord, reduce: Property
+			reduce(40), // pitch, reduce: Property
+			reduce(40), // degree, reduce: Property
+			reduce(40), // bracketBegin, reduce: Property
+			nil,        // bracketEnd
+			reduce(40), // symbol, reduce: Property
+			reduce(40), // rest, reduce: Property
+			reduce(40), // propSharp, reduce: Property
+			reduce(40), // propFlat, reduce: Property
+			reduce(40), // propOctaveUp, reduce: Property
+			reduce(40), // propOctaveDown, reduce: Property
+			reduce(40), // propStaccato, reduce: Property
+			reduce(40), // propAccent, reduce: Property
+			reduce(40), // propMarcato, reduce: Property
+			reduce(40), // propGhost, reduce: Property
+			reduce(40), // uint, reduce: Property
+			reduce(40), // propDot, reduce: Property
+			reduce(40), // propTuplet, reduce: Property
+			reduce(40), // propLetRing, reduce: Property
+			reduce(40), // propTie, reduce: Property
+			reduce(40), // propAftertouch, reduce: Property
+			reduce(40), // propChance, reduce: Property
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S95
+	actionRow{ // S226
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(17), // terminator, reduce: NoteObject
+			reduce(17), // lineComment, reduce: NoteObject
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(20), // bracketBegin, reduce: Property
-			reduce(20), // bracketEnd, reduce: Property
-			reduce(20), // symbol, reduce: Property
-			reduce(20), // rest, reduce: Property
-			reduce(20), // propSharp, reduce: Property
-			reduce(20), // propFlat, reduce: Property
-			reduce(20), // propStaccato, reduce: Property
-			reduce(20), // propAccent, reduce: Property
-			reduce(20), // propMarcato, reduce: Property
-			reduce(20), // propGhost, reduce: Property
-			reduce(20), // uint, reduce: Property
-			reduce(20), // propDot, reduce: Property
-			reduce(20), // propTuplet, reduce: Property
-			reduce(20), // propLetRing, reduce: Property
+			reduce(17), // cmdEnd, reduce: NoteObject
+			reduce(17), // chord, reduce: NoteObject
+			reduce(17), // pitch, reduce: NoteObject
+			reduce(17), // degree, reduce: NoteObject
+			reduce(17), // bracketBegin, reduce: NoteObject
+			nil,        // bracketEnd
+			reduce(17), // symbol, reduce: NoteObject
+			reduce(17), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S96
+	actionRow{ // S227
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(18), // terminator, reduce: NoteObject
+			reduce(18), // lineComment, reduce: NoteObject
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(21), // bracketBegin, reduce: Property
-			reduce(21), // bracketEnd, reduce: Property
-			reduce(21), // symbol, reduce: Property
-			reduce(21), // rest, reduce: Property
-			reduce(21), // propSharp, reduce: Property
-			reduce(21), // propFlat, reduce: Property
-			reduce(21), // propStaccato, reduce: Property
-			reduce(21), // propAccent, reduce: Property
-			reduce(21), // propMarcato, reduce: Property
-			reduce(21), // propGhost, reduce: Property
-			reduce(21), // uint, reduce: Property
-			reduce(21), // propDot, reduce: Property
-			reduce(21), // propTuplet, reduce: Property
-			reduce(21), // propLetRing, reduce: Property
+			reduce(18), // cmdEnd, reduce: NoteObject
+			reduce(18), // chord, reduce: NoteObject
+			reduce(18), // pitch, reduce: NoteObject
+			reduce(18), // degree, reduce: NoteObject
+			reduce(18), // bracketBegin, reduce: NoteObject
+			nil,        // bracketEnd
+			reduce(18), // symbol, reduce: NoteObject
+			reduce(18), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S97
+	actionRow{ // S228
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(19), // terminator, reduce: NoteObject
+			reduce(19), // lineComment, reduce: NoteObject
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(22), // bracketBegin, reduce: Property
-			reduce(22), // bracketEnd, reduce: Property
-			reduce(22), // symbol, reduce: Property
-			reduce(22), // rest, reduce: Property
-			reduce(22), // propSharp, reduce: Property
-			reduce(22), // propFlat, reduce: Property
-			reduce(22), // propStaccato, reduce: Property
-			reduce(22), // propAccent, reduce: Property
-			reduce(22), // propMarcato, reduce: Property
-			reduce(22), // propGhost, reduce: Property
-			reduce(22), // uint, reduce: Property
-			reduce(22), // propDot, reduce: Property
-			reduce(22), // propTuplet, reduce: Property
-			reduce(22), // propLetRing, reduce: Property
+			reduce(19), // cmdEnd, reduce: NoteObject
+			reduce(19), // chord, reduce: NoteObject
+			reduce(19), // pitch, reduce: NoteObject
+			reduce(19), // degree, reduce: NoteObject
+			reduce(19), // bracketBegin, reduce: NoteObject
+			nil,        // bracketEnd
+			reduce(19), // symbol, reduce: NoteObject
+			reduce(19), // rest, reduce: NoteObject
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S98
+	actionRow{ // S229
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
 			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(23), // bracketBegin, reduce: Property
-			reduce(23), // bracketEnd, reduce: Property
-			reduce(23), // symbol, reduce: Property
-			reduce(23), // rest, reduce: Property
-			reduce(23), // propSharp, reduce: Property
-			reduce(23), // propFlat, reduce: Property
-			reduce(23), // propStaccato, reduce: Property
-			reduce(23), // propAccent, reduce: Property
-			reduce(23), // propMarcato, reduce: Property
-			reduce(23), // propGhost, reduce: Property
-			reduce(23), // uint, reduce: Property
-			reduce(23), // propDot, reduce: Property
-			reduce(23), // propTuplet, reduce: Property
-			reduce(23), // propLetRing, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			shift(259), // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S99
+	actionRow{ // S230
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			shift(3),  // terminator
+			reduce(2), // lineComment, reduce: RepeatTerminator
+			reduce(2), // cmdBar, reduce: RepeatTerminator
+			nil,       // cmdEnd
+			reduce(2), // chord, reduce: RepeatTerminator
+			reduce(2), // pitch, reduce: RepeatTerminator
+			reduce(2), // degree, reduce: RepeatTerminator
+			reduce(2), // bracketBegin, reduce: RepeatTerminator
+			nil,       // bracketEnd
+			reduce(2), // symbol, reduce: RepeatTerminator
+			reduce(2), // rest, reduce: RepeatTerminator
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			reduce(2), // cmdRepeat, reduce: RepeatTerminator
+			reduce(2), // cmdAssign, reduce: RepeatTerminator
+			reduce(2), // cmdKit, reduce: RepeatTerminator
+			reduce(2), // cmdPlay, reduce: RepeatTerminator
+			reduce(2), // cmdTempo, reduce: RepeatTerminator
+			nil,       // arrow
+			reduce(2), // cmdKey, reduce: RepeatTerminator
+			reduce(2), // cmdScale, reduce: RepeatTerminator
+			reduce(2), // cmdTime, reduce: RepeatTerminator
+			reduce(2), // cmdMeter, reduce: RepeatTerminator
+			reduce(2), // cmdVelocity, reduce: RepeatTerminator
+			reduce(2), // cmdOctave, reduce: RepeatTerminator
+			reduce(2), // cmdChannel, reduce: RepeatTerminator
+			reduce(2), // cmdVoice, reduce: RepeatTerminator
+			reduce(2), // cmdProgram, reduce: RepeatTerminator
+			reduce(2), // cmdProgramName, reduce: RepeatTerminator
+			nil,       // string
+			reduce(2), // cmdControl, reduce: RepeatTerminator
+			reduce(2), // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2), // cmdBend, reduce: RepeatTerminator
+			reduce(2), // cmdPressure, reduce: RepeatTerminator
+			reduce(2), // cmdSysex, reduce: RepeatTerminator
+			reduce(2), // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2), // cmdRPN, reduce: RepeatTerminator
+			reduce(2), // cmdNRPN, reduce: RepeatTerminator
+			reduce(2), // cmdSwing, reduce: RepeatTerminator
+			reduce(2), // cmdGroove, reduce: RepeatTerminator
+			reduce(2), // cmdHumanize, reduce: RepeatTerminator
+			reduce(2), // cmdStart, reduce: RepeatTerminator
+			reduce(2), // cmdStop, reduce: RepeatTerminator
+			reduce(2), // cmdPickup, reduce: RepeatTerminator
+			reduce(2), // cmdStaff, reduce: RepeatTerminator
+			reduce(2), // cmdInclude, reduce: RepeatTerminator
+			reduce(2), // cmdVolta, reduce: RepeatTerminator
+			reduce(2), // cmdDyn, reduce: RepeatTerminator
+			reduce(2), // cmdDynamics, reduce: RepeatTerminator
+			reduce(2), // cmdCresc, reduce: RepeatTerminator
+			reduce(2), // cmdDim, reduce: RepeatTerminator
+			reduce(2), // blockComment, reduce: RepeatTerminator
+		},
+	},
+	actionRow{ // S231
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
 			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(24), // bracketBegin, reduce: Property
-			reduce(24), // bracketEnd, reduce: Property
-			reduce(24), // symbol, reduce: Property
-			reduce(24), // rest, reduce: Property
-			reduce(24), // propSharp, reduce: Property
-			reduce(24), // propFlat, reduce: Property
-			reduce(24), // propStaccato, reduce: Property
-			reduce(24), // propAccent, reduce: Property
-			reduce(24), // propMarcato, reduce: Property
-			reduce(24), // propGhost, reduce: Property
-			reduce(24), // uint, reduce: Property
-			reduce(24), // propDot, reduce: Property
-			reduce(24), // propTuplet, reduce: Property
-			reduce(24), // propLetRing, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(261), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S100
+	actionRow{ // S232
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(45), // terminator, reduce: Command
+			reduce(45), // lineComment, reduce: Command
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(25), // bracketBegin, reduce: Property
-			reduce(25), // bracketEnd, reduce: Property
-			reduce(25), // symbol, reduce: Property
-			reduce(25), // rest, reduce: Property
-			reduce(25), // propSharp, reduce: Property
-			reduce(25), // propFlat, reduce: Property
-			reduce(25), // propStaccato, reduce: Property
-			reduce(25), // propAccent, reduce: Property
-			reduce(25), // propMarcato, reduce: Property
-			reduce(25), // propGhost, reduce: Property
-			reduce(25), // uint, reduce: Property
-			reduce(25), // propDot, reduce: Property
-			reduce(25), // propTuplet, reduce: Property
-			reduce(25), // propLetRing, reduce: Property
+			reduce(45), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			shift(262), // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S101
+	actionRow{ // S233
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
 			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(26), // bracketBegin, reduce: Property
-			reduce(26), // bracketEnd, reduce: Property
-			reduce(26), // symbol, reduce: Property
-			reduce(26), // rest, reduce: Property
-			reduce(26), // propSharp, reduce: Property
-			reduce(26), // propFlat, reduce: Property
-			reduce(26), // propStaccato, reduce: Property
-			reduce(26), // propAccent, reduce: Property
-			reduce(26), // propMarcato, reduce: Property
-			reduce(26), // propGhost, reduce: Property
-			reduce(26), // uint, reduce: Property
-			reduce(26), // propDot, reduce: Property
-			reduce(26), // propTuplet, reduce: Property
-			reduce(26), // propLetRing, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(263), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S102
+	actionRow{ // S234
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
 			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(27), // bracketBegin, reduce: Property
-			reduce(27), // bracketEnd, reduce: Property
-			reduce(27), // symbol, reduce: Property
-			reduce(27), // rest, reduce: Property
-			reduce(27), // propSharp, reduce: Property
-			reduce(27), // propFlat, reduce: Property
-			reduce(27), // propStaccato, reduce: Property
-			reduce(27), // propAccent, reduce: Property
-			reduce(27), // propMarcato, reduce: Property
-			reduce(27), // propGhost, reduce: Property
-			reduce(27), // uint, reduce: Property
-			reduce(27), // propDot, reduce: Property
-			reduce(27), // propTuplet, reduce: Property
-			reduce(27), // propLetRing, reduce: Property
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(264), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S103
+	actionRow{ // S235
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(52), // terminator, reduce: Command
+			reduce(52), // lineComment, reduce: Command
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(28), // bracketBegin, reduce: Property
-			reduce(28), // bracketEnd, reduce: Property
-			reduce(28), // symbol, reduce: Property
-			reduce(28), // rest, reduce: Property
-			reduce(28), // propSharp, reduce: Property
-			reduce(28), // propFlat, reduce: Property
-			reduce(28), // propStaccato, reduce: Property
-			reduce(28), // propAccent, reduce: Property
-			reduce(28), // propMarcato, reduce: Property
-			reduce(28), // propGhost, reduce: Property
-			reduce(28), // uint, reduce: Property
-			reduce(28), // propDot, reduce: Property
-			reduce(28), // propTuplet, reduce: Property
-			reduce(28), // propLetRing, reduce: Property
+			reduce(52), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S104
+	actionRow{ // S236
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(54), // terminator, reduce: Command
+			reduce(54), // lineComment, reduce: Command
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(29), // bracketBegin, reduce: Property
-			reduce(29), // bracketEnd, reduce: Property
-			reduce(29), // symbol, reduce: Property
-			reduce(29), // rest, reduce: Property
-			reduce(29), // propSharp, reduce: Property
-			reduce(29), // propFlat, reduce: Property
-			reduce(29), // propStaccato, reduce: Property
-			reduce(29), // propAccent, reduce: Property
-			reduce(29), // propMarcato, reduce: Property
-			reduce(29), // propGhost, reduce: Property
-			reduce(29), // uint, reduce: Property
-			reduce(29), // propDot, reduce: Property
-			reduce(29), // propTuplet, reduce: Property
-			reduce(29), // propLetRing, reduce: Property
+			reduce(54), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S105
+	actionRow{ // S237
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(55), // terminator, reduce: Command
+			reduce(55), // lineComment, reduce: Command
 			nil,        // cmdBar
-			nil,        // cmdEnd
+			reduce(55), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
-			shift(139), // bracketEnd
+			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -4024,36 +17341,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S106
+	actionRow{ // S238
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(30), // ␚, reduce: Command
+			nil,        // ␚
 			nil,        // empty
-			reduce(30), // terminator, reduce: Command
+			reduce(57), // terminator, reduce: Command
+			reduce(57), // lineComment, reduce: Command
 			nil,        // cmdBar
-			nil,        // cmdEnd
+			reduce(57), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -4062,36 +17414,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S107
+	actionRow{ // S239
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(34), // ␚, reduce: Command
+			nil,        // ␚
 			nil,        // empty
-			reduce(34), // terminator, reduce: Command
+			reduce(61), // terminator, reduce: Command
+			reduce(61), // lineComment, reduce: Command
 			nil,        // cmdBar
-			nil,        // cmdEnd
+			reduce(61), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -4100,36 +17487,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S108
+	actionRow{ // S240
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(39), // ␚, reduce: Command
+			nil,        // ␚
 			nil,        // empty
-			reduce(39), // terminator, reduce: Command
+			reduce(63), // terminator, reduce: Command
+			reduce(63), // lineComment, reduce: Command
 			nil,        // cmdBar
-			nil,        // cmdEnd
+			reduce(63), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -4138,188 +17560,217 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S109
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(4), // ␚, reduce: DeclList
-			nil,       // empty
-			nil,       // terminator
-			nil,       // cmdBar
-			nil,       // cmdEnd
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
-		},
-	},
-	actionRow{ // S110
+	actionRow{ // S241
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(3), // ␚, reduce: RepeatTerminator
-			nil,       // empty
-			nil,       // terminator
-			reduce(3), // cmdBar, reduce: RepeatTerminator
-			nil,       // cmdEnd
-			reduce(3), // bracketBegin, reduce: RepeatTerminator
-			nil,       // bracketEnd
-			reduce(3), // symbol, reduce: RepeatTerminator
-			reduce(3), // rest, reduce: RepeatTerminator
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			reduce(3), // cmdAssign, reduce: RepeatTerminator
-			reduce(3), // cmdPlay, reduce: RepeatTerminator
-			reduce(3), // cmdTempo, reduce: RepeatTerminator
-			reduce(3), // cmdKey, reduce: RepeatTerminator
-			reduce(3), // cmdTime, reduce: RepeatTerminator
-			reduce(3), // cmdVelocity, reduce: RepeatTerminator
-			reduce(3), // cmdChannel, reduce: RepeatTerminator
-			reduce(3), // cmdVoice, reduce: RepeatTerminator
-			reduce(3), // cmdProgram, reduce: RepeatTerminator
-			reduce(3), // cmdControl, reduce: RepeatTerminator
-			reduce(3), // cmdStart, reduce: RepeatTerminator
-			reduce(3), // cmdStop, reduce: RepeatTerminator
-			reduce(3), // blockComment, reduce: RepeatTerminator
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			nil,        // lineComment
+			nil,        // cmdBar
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(265), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
 		},
 	},
-	actionRow{ // S111
+	actionRow{ // S242
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(10), // ␚, reduce: Bar
+			nil,        // ␚
 			nil,        // empty
-			reduce(10), // terminator, reduce: Bar
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			nil,        // uint
+			shift(266), // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S112
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			nil,       // cmdBar
-			reduce(5), // cmdEnd, reduce: DeclList
-			nil,       // bracketBegin
-			nil,       // bracketEnd
-			nil,       // symbol
-			nil,       // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			nil,       // cmdAssign
-			nil,       // cmdPlay
-			nil,       // cmdTempo
-			nil,       // cmdKey
-			nil,       // cmdTime
-			nil,       // cmdVelocity
-			nil,       // cmdChannel
-			nil,       // cmdVoice
-			nil,       // cmdProgram
-			nil,       // cmdControl
-			nil,       // cmdStart
-			nil,       // cmdStop
-			nil,       // blockComment
-		},
-	},
-	actionRow{ // S113
+	actionRow{ // S243
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			shift(141), // terminator
-			reduce(2),  // cmdBar, reduce: RepeatTerminator
-			reduce(2),  // cmdEnd, reduce: RepeatTerminator
-			reduce(2),  // bracketBegin, reduce: RepeatTerminator
+			reduce(75), // terminator, reduce: Command
+			reduce(75), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(75), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(2),  // symbol, reduce: RepeatTerminator
-			reduce(2),  // rest, reduce: RepeatTerminator
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -4328,74 +17779,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
-			reduce(2),  // cmdAssign, reduce: RepeatTerminator
-			reduce(2),  // cmdPlay, reduce: RepeatTerminator
-			reduce(2),  // cmdTempo, reduce: RepeatTerminator
-			reduce(2),  // cmdKey, reduce: RepeatTerminator
-			reduce(2),  // cmdTime, reduce: RepeatTerminator
-			reduce(2),  // cmdVelocity, reduce: RepeatTerminator
-			reduce(2),  // cmdChannel, reduce: RepeatTerminator
-			reduce(2),  // cmdVoice, reduce: RepeatTerminator
-			reduce(2),  // cmdProgram, reduce: RepeatTerminator
-			reduce(2),  // cmdControl, reduce: RepeatTerminator
-			reduce(2),  // cmdStart, reduce: RepeatTerminator
-			reduce(2),  // cmdStop, reduce: RepeatTerminator
-			reduce(2),  // blockComment, reduce: RepeatTerminator
-		},
-	},
-	actionRow{ // S114
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			shift(70), // cmdBar
-			nil,       // cmdEnd
-			shift(74), // bracketBegin
-			nil,       // bracketEnd
-			shift(75), // symbol
-			shift(76), // rest
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			shift(77), // cmdAssign
-			shift(78), // cmdPlay
-			shift(79), // cmdTempo
-			shift(80), // cmdKey
-			shift(81), // cmdTime
-			shift(82), // cmdVelocity
-			shift(83), // cmdChannel
-			shift(84), // cmdVoice
-			shift(85), // cmdProgram
-			shift(86), // cmdControl
-			shift(87), // cmdStart
-			shift(88), // cmdStop
-			shift(89), // blockComment
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
 		},
 	},
-	actionRow{ // S115
+	actionRow{ // S244
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(12), // terminator, reduce: NoteList
+			reduce(76), // terminator, reduce: Command
+			reduce(76), // lineComment, reduce: Command
 			nil,        // cmdBar
-			reduce(12), // cmdEnd, reduce: NoteList
+			reduce(76), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -4404,36 +17852,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S116
+	actionRow{ // S245
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(21), // ␚, reduce: NoteGroup
 			nil,        // empty
-			reduce(13), // terminator, reduce: NoteObject
+			reduce(21), // terminator, reduce: NoteGroup
+			reduce(21), // lineComment, reduce: NoteGroup
 			nil,        // cmdBar
-			reduce(13), // cmdEnd, reduce: NoteObject
-			reduce(13), // bracketBegin, reduce: NoteObject
+			nil,        // cmdEnd
+			reduce(21), // chord, reduce: NoteGroup
+			reduce(21), // pitch, reduce: NoteGroup
+			reduce(21), // degree, reduce: NoteGroup
+			reduce(21), // bracketBegin, reduce: NoteGroup
 			nil,        // bracketEnd
-			reduce(13), // symbol, reduce: NoteObject
-			reduce(13), // rest, reduce: NoteObject
+			reduce(21), // symbol, reduce: NoteGroup
+			reduce(21), // rest, reduce: NoteGroup
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -4442,454 +17925,1093 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S117
+	actionRow{ // S246
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(18), // terminator, reduce: PropertyList
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
-			reduce(18), // cmdEnd, reduce: PropertyList
-			reduce(18), // bracketBegin, reduce: PropertyList
-			nil,        // bracketEnd
-			reduce(18), // symbol, reduce: PropertyList
-			reduce(18), // rest, reduce: PropertyList
-			shift(118), // propSharp
-			shift(119), // propFlat
-			shift(120), // propStaccato
-			shift(121), // propAccent
-			shift(122), // propMarcato
-			shift(123), // propGhost
-			shift(124), // uint
-			shift(125), // propDot
-			shift(126), // propTuplet
-			shift(127), // propLetRing
+			nil,        // cmdEnd
+			reduce(25), // chord, reduce: PropertyList
+			reduce(25), // pitch, reduce: PropertyList
+			reduce(25), // degree, reduce: PropertyList
+			reduce(25), // bracketBegin, reduce: PropertyList
+			reduce(25), // bracketEnd, reduce: PropertyList
+			reduce(25), // symbol, reduce: PropertyList
+			reduce(25), // rest, reduce: PropertyList
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S118
+	actionRow{ // S247
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(20), // terminator, reduce: Property
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
-			reduce(20), // cmdEnd, reduce: Property
-			reduce(20), // bracketBegin, reduce: Property
-			nil,        // bracketEnd
-			reduce(20), // symbol, reduce: Property
-			reduce(20), // rest, reduce: Property
-			reduce(20), // propSharp, reduce: Property
-			reduce(20), // propFlat, reduce: Property
-			reduce(20), // propStaccato, reduce: Property
-			reduce(20), // propAccent, reduce: Property
-			reduce(20), // propMarcato, reduce: Property
-			reduce(20), // propGhost, reduce: Property
-			reduce(20), // uint, reduce: Property
-			reduce(20), // propDot, reduce: Property
-			reduce(20), // propTuplet, reduce: Property
-			reduce(20), // propLetRing, reduce: Property
+			nil,        // cmdEnd
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
+			reduce(24), // bracketEnd, reduce: PropertyList
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(174), // propSharp
+			shift(175), // propFlat
+			shift(176), // propOctaveUp
+			shift(177), // propOctaveDown
+			shift(178), // propStaccato
+			shift(179), // propAccent
+			shift(180), // propMarcato
+			shift(181), // propGhost
+			shift(182), // uint
+			shift(183), // propDot
+			shift(184), // propTuplet
+			shift(185), // propLetRing
+			shift(186), // propTie
+			shift(187), // propAftertouch
+			shift(188), // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S119
+	actionRow{ // S248
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(21), // terminator, reduce: Property
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
-			reduce(21), // cmdEnd, reduce: Property
-			reduce(21), // bracketBegin, reduce: Property
+			shift(268), // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(21), // symbol, reduce: Property
-			reduce(21), // rest, reduce: Property
-			reduce(21), // propSharp, reduce: Property
-			reduce(21), // propFlat, reduce: Property
-			reduce(21), // propStaccato, reduce: Property
-			reduce(21), // propAccent, reduce: Property
-			reduce(21), // propMarcato, reduce: Property
-			reduce(21), // propGhost, reduce: Property
-			reduce(21), // uint, reduce: Property
-			reduce(21), // propDot, reduce: Property
-			reduce(21), // propTuplet, reduce: Property
-			reduce(21), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S120
+	actionRow{ // S249
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(46), // ␚, reduce: Command
 			nil,        // empty
-			reduce(22), // terminator, reduce: Property
+			reduce(46), // terminator, reduce: Command
+			reduce(46), // lineComment, reduce: Command
 			nil,        // cmdBar
-			reduce(22), // cmdEnd, reduce: Property
-			reduce(22), // bracketBegin, reduce: Property
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(22), // symbol, reduce: Property
-			reduce(22), // rest, reduce: Property
-			reduce(22), // propSharp, reduce: Property
-			reduce(22), // propFlat, reduce: Property
-			reduce(22), // propStaccato, reduce: Property
-			reduce(22), // propAccent, reduce: Property
-			reduce(22), // propMarcato, reduce: Property
-			reduce(22), // propGhost, reduce: Property
-			reduce(22), // uint, reduce: Property
-			reduce(22), // propDot, reduce: Property
-			reduce(22), // propTuplet, reduce: Property
-			reduce(22), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(269), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S121
+	actionRow{ // S250
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(64), // ␚, reduce: Command
 			nil,        // empty
-			reduce(23), // terminator, reduce: Property
+			reduce(64), // terminator, reduce: Command
+			reduce(64), // lineComment, reduce: Command
 			nil,        // cmdBar
-			reduce(23), // cmdEnd, reduce: Property
-			reduce(23), // bracketBegin, reduce: Property
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(23), // symbol, reduce: Property
-			reduce(23), // rest, reduce: Property
-			reduce(23), // propSharp, reduce: Property
-			reduce(23), // propFlat, reduce: Property
-			reduce(23), // propStaccato, reduce: Property
-			reduce(23), // propAccent, reduce: Property
-			reduce(23), // propMarcato, reduce: Property
-			reduce(23), // propGhost, reduce: Property
-			reduce(23), // uint, reduce: Property
-			reduce(23), // propDot, reduce: Property
-			reduce(23), // propTuplet, reduce: Property
-			reduce(23), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(270), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S122
+	actionRow{ // S251
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(66), // ␚, reduce: Command
 			nil,        // empty
-			reduce(24), // terminator, reduce: Property
+			reduce(66), // terminator, reduce: Command
+			reduce(66), // lineComment, reduce: Command
 			nil,        // cmdBar
-			reduce(24), // cmdEnd, reduce: Property
-			reduce(24), // bracketBegin, reduce: Property
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(24), // symbol, reduce: Property
-			reduce(24), // rest, reduce: Property
-			reduce(24), // propSharp, reduce: Property
-			reduce(24), // propFlat, reduce: Property
-			reduce(24), // propStaccato, reduce: Property
-			reduce(24), // propAccent, reduce: Property
-			reduce(24), // propMarcato, reduce: Property
-			reduce(24), // propGhost, reduce: Property
-			reduce(24), // uint, reduce: Property
-			reduce(24), // propDot, reduce: Property
-			reduce(24), // propTuplet, reduce: Property
-			reduce(24), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			shift(271), // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S123
+	actionRow{ // S252
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(5), // ␚, reduce: DeclList
+			nil,       // empty
+			nil,       // terminator
+			nil,       // lineComment
+			nil,       // cmdBar
+			nil,       // cmdEnd
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
+			nil,       // bracketBegin
+			nil,       // bracketEnd
+			nil,       // symbol
+			nil,       // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
+		},
+	},
+	actionRow{ // S253
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			nil,        // terminator
+			shift(116), // lineComment
+			shift(122), // cmdBar
+			reduce(3),  // cmdEnd, reduce: RepeatTerminator
+			shift(125), // chord
+			shift(126), // pitch
+			shift(127), // degree
+			shift(129), // bracketBegin
+			nil,        // bracketEnd
+			shift(130), // symbol
+			shift(131), // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			shift(132), // cmdRepeat
+			shift(133), // cmdAssign
+			shift(134), // cmdKit
+			shift(135), // cmdPlay
+			shift(136), // cmdTempo
+			nil,        // arrow
+			shift(137), // cmdKey
+			shift(138), // cmdScale
+			shift(139), // cmdTime
+			shift(140), // cmdMeter
+			shift(141), // cmdVelocity
+			shift(142), // cmdOctave
+			shift(143), // cmdChannel
+			shift(144), // cmdVoice
+			shift(145), // cmdProgram
+			shift(146), // cmdProgramName
+			nil,        // string
+			shift(147), // cmdControl
+			shift(148), // cmdControlRamp
+			shift(149), // cmdBend
+			shift(150), // cmdPressure
+			shift(151), // cmdSysex
+			shift(152), // cmdSysexFile
+			shift(153), // cmdRPN
+			shift(154), // cmdNRPN
+			shift(155), // cmdSwing
+			shift(156), // cmdGroove
+			shift(157), // cmdHumanize
+			shift(158), // cmdStart
+			shift(159), // cmdStop
+			shift(160), // cmdPickup
+			shift(161), // cmdStaff
+			shift(162), // cmdInclude
+			shift(163), // cmdVolta
+			shift(164), // cmdDyn
+			shift(165), // cmdDynamics
+			shift(166), // cmdCresc
+			shift(167), // cmdDim
+			shift(168), // blockComment
+		},
+	},
+	actionRow{ // S254
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(25), // terminator, reduce: Property
-			nil,        // cmdBar
-			reduce(25), // cmdEnd, reduce: Property
-			reduce(25), // bracketBegin, reduce: Property
+			shift(254), // terminator
+			reduce(2),  // lineComment, reduce: RepeatTerminator
+			reduce(2),  // cmdBar, reduce: RepeatTerminator
+			reduce(2),  // cmdEnd, reduce: RepeatTerminator
+			reduce(2),  // chord, reduce: RepeatTerminator
+			reduce(2),  // pitch, reduce: RepeatTerminator
+			reduce(2),  // degree, reduce: RepeatTerminator
+			reduce(2),  // bracketBegin, reduce: RepeatTerminator
 			nil,        // bracketEnd
-			reduce(25), // symbol, reduce: Property
-			reduce(25), // rest, reduce: Property
-			reduce(25), // propSharp, reduce: Property
-			reduce(25), // propFlat, reduce: Property
-			reduce(25), // propStaccato, reduce: Property
-			reduce(25), // propAccent, reduce: Property
-			reduce(25), // propMarcato, reduce: Property
-			reduce(25), // propGhost, reduce: Property
-			reduce(25), // uint, reduce: Property
-			reduce(25), // propDot, reduce: Property
-			reduce(25), // propTuplet, reduce: Property
-			reduce(25), // propLetRing, reduce: Property
-			nil,        // cmdAssign
-			nil,        // cmdPlay
-			nil,        // cmdTempo
-			nil,        // cmdKey
-			nil,        // cmdTime
-			nil,        // cmdVelocity
-			nil,        // cmdChannel
-			nil,        // cmdVoice
-			nil,        // cmdProgram
-			nil,        // cmdControl
-			nil,        // cmdStart
-			nil,        // cmdStop
-			nil,        // blockComment
+			reduce(2),  // symbol, reduce: RepeatTerminator
+			reduce(2),  // rest, reduce: RepeatTerminator
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			reduce(2),  // cmdRepeat, reduce: RepeatTerminator
+			reduce(2),  // cmdAssign, reduce: RepeatTerminator
+			reduce(2),  // cmdKit, reduce: RepeatTerminator
+			reduce(2),  // cmdPlay, reduce: RepeatTerminator
+			reduce(2),  // cmdTempo, reduce: RepeatTerminator
+			nil,        // arrow
+			reduce(2),  // cmdKey, reduce: RepeatTerminator
+			reduce(2),  // cmdScale, reduce: RepeatTerminator
+			reduce(2),  // cmdTime, reduce: RepeatTerminator
+			reduce(2),  // cmdMeter, reduce: RepeatTerminator
+			reduce(2),  // cmdVelocity, reduce: RepeatTerminator
+			reduce(2),  // cmdOctave, reduce: RepeatTerminator
+			reduce(2),  // cmdChannel, reduce: RepeatTerminator
+			reduce(2),  // cmdVoice, reduce: RepeatTerminator
+			reduce(2),  // cmdProgram, reduce: RepeatTerminator
+			reduce(2),  // cmdProgramName, reduce: RepeatTerminator
+			nil,        // string
+			reduce(2),  // cmdControl, reduce: RepeatTerminator
+			reduce(2),  // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2),  // cmdBend, reduce: RepeatTerminator
+			reduce(2),  // cmdPressure, reduce: RepeatTerminator
+			reduce(2),  // cmdSysex, reduce: RepeatTerminator
+			reduce(2),  // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2),  // cmdRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdNRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdSwing, reduce: RepeatTerminator
+			reduce(2),  // cmdGroove, reduce: RepeatTerminator
+			reduce(2),  // cmdHumanize, reduce: RepeatTerminator
+			reduce(2),  // cmdStart, reduce: RepeatTerminator
+			reduce(2),  // cmdStop, reduce: RepeatTerminator
+			reduce(2),  // cmdPickup, reduce: RepeatTerminator
+			reduce(2),  // cmdStaff, reduce: RepeatTerminator
+			reduce(2),  // cmdInclude, reduce: RepeatTerminator
+			reduce(2),  // cmdVolta, reduce: RepeatTerminator
+			reduce(2),  // cmdDyn, reduce: RepeatTerminator
+			reduce(2),  // cmdDynamics, reduce: RepeatTerminator
+			reduce(2),  // cmdCresc, reduce: RepeatTerminator
+			reduce(2),  // cmdDim, reduce: RepeatTerminator
+			reduce(2),  // blockComment, reduce: RepeatTerminator
 		},
 	},
-	actionRow{ // S124
+	actionRow{ // S255
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // terminator
+			nil,       // lineComment
+			nil,       // cmdBar
+			reduce(7), // cmdEnd, reduce: DeclList
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
+			nil,       // bracketBegin
+			nil,       // bracketEnd
+			nil,       // symbol
+			nil,       // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
+		},
+	},
+	actionRow{ // S256
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(26), // terminator, reduce: Property
-			nil,        // cmdBar
-			reduce(26), // cmdEnd, reduce: Property
-			reduce(26), // bracketBegin, reduce: Property
+			shift(254), // terminator
+			reduce(2),  // lineComment, reduce: RepeatTerminator
+			reduce(2),  // cmdBar, reduce: RepeatTerminator
+			reduce(2),  // cmdEnd, reduce: RepeatTerminator
+			reduce(2),  // chord, reduce: RepeatTerminator
+			reduce(2),  // pitch, reduce: RepeatTerminator
+			reduce(2),  // degree, reduce: RepeatTerminator
+			reduce(2),  // bracketBegin, reduce: RepeatTerminator
 			nil,        // bracketEnd
-			reduce(26), // symbol, reduce: Property
-			reduce(26), // rest, reduce: Property
-			reduce(26), // propSharp, reduce: Property
-			reduce(26), // propFlat, reduce: Property
-			reduce(26), // propStaccato, reduce: Property
-			reduce(26), // propAccent, reduce: Property
-			reduce(26), // propMarcato, reduce: Property
-			reduce(26), // propGhost, reduce: Property
-			reduce(26), // uint, reduce: Property
-			reduce(26), // propDot, reduce: Property
-			reduce(26), // propTuplet, reduce: Property
-			reduce(26), // propLetRing, reduce: Property
-			nil,        // cmdAssign
-			nil,        // cmdPlay
-			nil,        // cmdTempo
-			nil,        // cmdKey
-			nil,        // cmdTime
-			nil,        // cmdVelocity
-			nil,        // cmdChannel
-			nil,        // cmdVoice
-			nil,        // cmdProgram
-			nil,        // cmdControl
-			nil,        // cmdStart
-			nil,        // cmdStop
-			nil,        // blockComment
+			reduce(2),  // symbol, reduce: RepeatTerminator
+			reduce(2),  // rest, reduce: RepeatTerminator
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			reduce(2),  // cmdRepeat, reduce: RepeatTerminator
+			reduce(2),  // cmdAssign, reduce: RepeatTerminator
+			reduce(2),  // cmdKit, reduce: RepeatTerminator
+			reduce(2),  // cmdPlay, reduce: RepeatTerminator
+			reduce(2),  // cmdTempo, reduce: RepeatTerminator
+			nil,        // arrow
+			reduce(2),  // cmdKey, reduce: RepeatTerminator
+			reduce(2),  // cmdScale, reduce: RepeatTerminator
+			reduce(2),  // cmdTime, reduce: RepeatTerminator
+			reduce(2),  // cmdMeter, reduce: RepeatTerminator
+			reduce(2),  // cmdVelocity, reduce: RepeatTerminator
+			reduce(2),  // cmdOctave, reduce: RepeatTerminator
+			reduce(2),  // cmdChannel, reduce: RepeatTerminator
+			reduce(2),  // cmdVoice, reduce: RepeatTerminator
+			reduce(2),  // cmdProgram, reduce: RepeatTerminator
+			reduce(2),  // cmdProgramName, reduce: RepeatTerminator
+			nil,        // string
+			reduce(2),  // cmdControl, reduce: RepeatTerminator
+			reduce(2),  // cmdControlRamp, reduce: RepeatTerminator
+			reduce(2),  // cmdBend, reduce: RepeatTerminator
+			reduce(2),  // cmdPressure, reduce: RepeatTerminator
+			reduce(2),  // cmdSysex, reduce: RepeatTerminator
+			reduce(2),  // cmdSysexFile, reduce: RepeatTerminator
+			reduce(2),  // cmdRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdNRPN, reduce: RepeatTerminator
+			reduce(2),  // cmdSwing, reduce: RepeatTerminator
+			reduce(2),  // cmdGroove, reduce: RepeatTerminator
+			reduce(2),  // cmdHumanize, reduce: RepeatTerminator
+			reduce(2),  // cmdStart, reduce: RepeatTerminator
+			reduce(2),  // cmdStop, reduce: RepeatTerminator
+			reduce(2),  // cmdPickup, reduce: RepeatTerminator
+			reduce(2),  // cmdStaff, reduce: RepeatTerminator
+			reduce(2),  // cmdInclude, reduce: RepeatTerminator
+			reduce(2),  // cmdVolta, reduce: RepeatTerminator
+			reduce(2),  // cmdDyn, reduce: RepeatTerminator
+			reduce(2),  // cmdDynamics, reduce: RepeatTerminator
+			reduce(2),  // cmdCresc, reduce: RepeatTerminator
+			reduce(2),  // cmdDim, reduce: RepeatTerminator
+			reduce(2),  // blockComment, reduce: RepeatTerminator
 		},
 	},
-	actionRow{ // S125
+	actionRow{ // S257
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(27), // terminator, reduce: Property
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
-			reduce(27), // cmdEnd, reduce: Property
-			reduce(27), // bracketBegin, reduce: Property
+			shift(275), // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(27), // symbol, reduce: Property
-			reduce(27), // rest, reduce: Property
-			reduce(27), // propSharp, reduce: Property
-			reduce(27), // propFlat, reduce: Property
-			reduce(27), // propStaccato, reduce: Property
-			reduce(27), // propAccent, reduce: Property
-			reduce(27), // propMarcato, reduce: Property
-			reduce(27), // propGhost, reduce: Property
-			reduce(27), // uint, reduce: Property
-			reduce(27), // propDot, reduce: Property
-			reduce(27), // propTuplet, reduce: Property
-			reduce(27), // propLetRing, reduce: Property
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S126
+	actionRow{ // S258
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(28), // terminator, reduce: Property
+			reduce(25), // terminator, reduce: PropertyList
+			reduce(25), // lineComment, reduce: PropertyList
 			nil,        // cmdBar
-			reduce(28), // cmdEnd, reduce: Property
-			reduce(28), // bracketBegin, reduce: Property
+			reduce(25), // cmdEnd, reduce: PropertyList
+			reduce(25), // chord, reduce: PropertyList
+			reduce(25), // pitch, reduce: PropertyList
+			reduce(25), // degree, reduce: PropertyList
+			reduce(25), // bracketBegin, reduce: PropertyList
 			nil,        // bracketEnd
-			reduce(28), // symbol, reduce: Property
-			reduce(28), // rest, reduce: Property
-			reduce(28), // propSharp, reduce: Property
-			reduce(28), // propFlat, reduce: Property
-			reduce(28), // propStaccato, reduce: Property
-			reduce(28), // propAccent, reduce: Property
-			reduce(28), // propMarcato, reduce: Property
-			reduce(28), // propGhost, reduce: Property
-			reduce(28), // uint, reduce: Property
-			reduce(28), // propDot, reduce: Property
-			reduce(28), // propTuplet, reduce: Property
-			reduce(28), // propLetRing, reduce: Property
+			reduce(25), // symbol, reduce: PropertyList
+			reduce(25), // rest, reduce: PropertyList
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S127
+	actionRow{ // S259
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(29), // terminator, reduce: Property
+			reduce(24), // terminator, reduce: PropertyList
+			reduce(24), // lineComment, reduce: PropertyList
 			nil,        // cmdBar
-			reduce(29), // cmdEnd, reduce: Property
-			reduce(29), // bracketBegin, reduce: Property
+			reduce(24), // cmdEnd, reduce: PropertyList
+			reduce(24), // chord, reduce: PropertyList
+			reduce(24), // pitch, reduce: PropertyList
+			reduce(24), // degree, reduce: PropertyList
+			reduce(24), // bracketBegin, reduce: PropertyList
 			nil,        // bracketEnd
-			reduce(29), // symbol, reduce: Property
-			reduce(29), // rest, reduce: Property
-			reduce(29), // propSharp, reduce: Property
-			reduce(29), // propFlat, reduce: Property
-			reduce(29), // propStaccato, reduce: Property
-			reduce(29), // propAccent, reduce: Property
-			reduce(29), // propMarcato, reduce: Property
-			reduce(29), // propGhost, reduce: Property
-			reduce(29), // uint, reduce: Property
-			reduce(29), // propDot, reduce: Property
-			reduce(29), // propTuplet, reduce: Property
-			reduce(29), // propLetRing, reduce: Property
+			reduce(24), // symbol, reduce: PropertyList
+			reduce(24), // rest, reduce: PropertyList
+			shift(211), // propSharp
+			shift(212), // propFlat
+			shift(213), // propOctaveUp
+			shift(214), // propOctaveDown
+			shift(215), // propStaccato
+			shift(216), // propAccent
+			shift(217), // propMarcato
+			shift(218), // propGhost
+			shift(219), // uint
+			shift(220), // propDot
+			shift(221), // propTuplet
+			shift(222), // propLetRing
+			shift(223), // propTie
+			shift(224), // propAftertouch
+			shift(225), // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S128
+	actionRow{ // S260
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
 			nil,        // terminator
-			nil,        // cmdBar
+			shift(116), // lineComment
+			shift(122), // cmdBar
 			nil,        // cmdEnd
-			nil,        // bracketBegin
-			shift(144), // bracketEnd
-			nil,        // symbol
-			nil,        // rest
+			shift(125), // chord
+			shift(126), // pitch
+			shift(127), // degree
+			shift(129), // bracketBegin
+			nil,        // bracketEnd
+			shift(130), // symbol
+			shift(131), // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -4898,150 +19020,290 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
-			nil,        // cmdAssign
-			nil,        // cmdPlay
-			nil,        // cmdTempo
-			nil,        // cmdKey
-			nil,        // cmdTime
-			nil,        // cmdVelocity
-			nil,        // cmdChannel
-			nil,        // cmdVoice
-			nil,        // cmdProgram
-			nil,        // cmdControl
-			nil,        // cmdStart
-			nil,        // cmdStop
-			nil,        // blockComment
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			shift(132), // cmdRepeat
+			shift(133), // cmdAssign
+			shift(134), // cmdKit
+			shift(135), // cmdPlay
+			shift(136), // cmdTempo
+			nil,        // arrow
+			shift(137), // cmdKey
+			shift(138), // cmdScale
+			shift(139), // cmdTime
+			shift(140), // cmdMeter
+			shift(141), // cmdVelocity
+			shift(142), // cmdOctave
+			shift(143), // cmdChannel
+			shift(144), // cmdVoice
+			shift(145), // cmdProgram
+			shift(146), // cmdProgramName
+			nil,        // string
+			shift(147), // cmdControl
+			shift(148), // cmdControlRamp
+			shift(149), // cmdBend
+			shift(150), // cmdPressure
+			shift(151), // cmdSysex
+			shift(152), // cmdSysexFile
+			shift(153), // cmdRPN
+			shift(154), // cmdNRPN
+			shift(155), // cmdSwing
+			shift(156), // cmdGroove
+			shift(157), // cmdHumanize
+			shift(158), // cmdStart
+			shift(159), // cmdStop
+			shift(160), // cmdPickup
+			shift(161), // cmdStaff
+			shift(162), // cmdInclude
+			shift(163), // cmdVolta
+			shift(164), // cmdDyn
+			shift(165), // cmdDynamics
+			shift(166), // cmdCresc
+			shift(167), // cmdDim
+			shift(168), // blockComment
 		},
 	},
-	actionRow{ // S129
+	actionRow{ // S261
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(42), // terminator, reduce: Command
+			reduce(42), // lineComment, reduce: Command
 			nil,        // cmdBar
-			nil,        // cmdEnd
+			reduce(42), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			shift(145), // uint
+			nil,        // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S130
+	actionRow{ // S262
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(32), // terminator, reduce: Command
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
-			reduce(32), // cmdEnd, reduce: Command
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			nil,        // uint
+			shift(278), // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S131
+	actionRow{ // S263
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(50), // terminator, reduce: Command
+			reduce(50), // lineComment, reduce: Command
 			nil,        // cmdBar
-			nil,        // cmdEnd
+			reduce(50), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			shift(146), // uint
+			nil,        // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S132
+	actionRow{ // S264
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(35), // terminator, reduce: Command
+			reduce(51), // terminator, reduce: Command
+			reduce(51), // lineComment, reduce: Command
 			nil,        // cmdBar
-			reduce(35), // cmdEnd, reduce: Command
+			reduce(51), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -5050,112 +19312,217 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S133
+	actionRow{ // S265
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(36), // terminator, reduce: Command
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
-			reduce(36), // cmdEnd, reduce: Command
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			nil,        // uint
+			shift(279), // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S134
+	actionRow{ // S266
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(37), // terminator, reduce: Command
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
-			reduce(37), // cmdEnd, reduce: Command
+			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			nil,        // uint
+			shift(280), // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S135
+	actionRow{ // S267
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(38), // terminator, reduce: Command
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
-			reduce(38), // cmdEnd, reduce: Command
-			nil,        // bracketBegin
-			nil,        // bracketEnd
-			nil,        // symbol
-			nil,        // rest
+			nil,        // cmdEnd
+			reduce(21), // chord, reduce: NoteGroup
+			reduce(21), // pitch, reduce: NoteGroup
+			reduce(21), // degree, reduce: NoteGroup
+			reduce(21), // bracketBegin, reduce: NoteGroup
+			reduce(21), // bracketEnd, reduce: NoteGroup
+			reduce(21), // symbol, reduce: NoteGroup
+			reduce(21), // rest, reduce: NoteGroup
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -5164,74 +19531,144 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S136
+	actionRow{ // S268
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(41), // ␚, reduce: Repeat
 			nil,        // empty
-			nil,        // terminator
+			reduce(41), // terminator, reduce: Repeat
+			reduce(41), // lineComment, reduce: Repeat
 			nil,        // cmdBar
 			nil,        // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			shift(147), // uint
+			nil,        // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S137
+	actionRow{ // S269
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(15), // ␚, reduce: NoteGroup
+			reduce(47), // ␚, reduce: Command
 			nil,        // empty
-			reduce(15), // terminator, reduce: NoteGroup
+			reduce(47), // terminator, reduce: Command
+			reduce(47), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(15), // bracketBegin, reduce: NoteGroup
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(15), // symbol, reduce: NoteGroup
-			reduce(15), // rest, reduce: NoteGroup
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -5240,36 +19677,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S138
+	actionRow{ // S270
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(65), // ␚, reduce: Command
 			nil,        // empty
-			nil,        // terminator
+			reduce(65), // terminator, reduce: Command
+			reduce(65), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(19), // bracketBegin, reduce: PropertyList
-			reduce(19), // bracketEnd, reduce: PropertyList
-			reduce(19), // symbol, reduce: PropertyList
-			reduce(19), // rest, reduce: PropertyList
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -5278,74 +19750,217 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S139
+	actionRow{ // S271
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // ␚
+			reduce(67), // ␚, reduce: Command
 			nil,        // empty
-			nil,        // terminator
+			reduce(67), // terminator, reduce: Command
+			reduce(67), // lineComment, reduce: Command
 			nil,        // cmdBar
 			nil,        // cmdEnd
-			reduce(18), // bracketBegin, reduce: PropertyList
-			reduce(18), // bracketEnd, reduce: PropertyList
-			reduce(18), // symbol, reduce: PropertyList
-			reduce(18), // rest, reduce: PropertyList
-			shift(95),  // propSharp
-			shift(96),  // propFlat
-			shift(97),  // propStaccato
-			shift(98),  // propAccent
-			shift(99),  // propMarcato
-			shift(100), // propGhost
-			shift(101), // uint
-			shift(102), // propDot
-			shift(103), // propTuplet
-			shift(104), // propLetRing
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S140
+	actionRow{ // S272
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // ␚
+			nil,       // empty
+			nil,       // terminator
+			nil,       // lineComment
+			nil,       // cmdBar
+			reduce(4), // cmdEnd, reduce: DeclList
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
+			nil,       // bracketBegin
+			nil,       // bracketEnd
+			nil,       // symbol
+			nil,       // rest
+			nil,       // propSharp
+			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
+			nil,       // propStaccato
+			nil,       // propAccent
+			nil,       // propMarcato
+			nil,       // propGhost
+			nil,       // uint
+			nil,       // propDot
+			nil,       // propTuplet
+			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
+			nil,       // cmdAssign
+			nil,       // cmdKit
+			nil,       // cmdPlay
+			nil,       // cmdTempo
+			nil,       // arrow
+			nil,       // cmdKey
+			nil,       // cmdScale
+			nil,       // cmdTime
+			nil,       // cmdMeter
+			nil,       // cmdVelocity
+			nil,       // cmdOctave
+			nil,       // cmdChannel
+			nil,       // cmdVoice
+			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
+			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
+			nil,       // cmdStart
+			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
+			nil,       // blockComment
+		},
+	},
+	actionRow{ // S273
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // terminator
-			shift(70), // cmdBar
+			reduce(3), // lineComment, reduce: RepeatTerminator
+			reduce(3), // cmdBar, reduce: RepeatTerminator
 			reduce(3), // cmdEnd, reduce: RepeatTerminator
-			shift(74), // bracketBegin
+			reduce(3), // chord, reduce: RepeatTerminator
+			reduce(3), // pitch, reduce: RepeatTerminator
+			reduce(3), // degree, reduce: RepeatTerminator
+			reduce(3), // bracketBegin, reduce: RepeatTerminator
 			nil,       // bracketEnd
-			shift(75), // symbol
-			shift(76), // rest
+			reduce(3), // symbol, reduce: RepeatTerminator
+			reduce(3), // rest, reduce: RepeatTerminator
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -5354,36 +19969,71 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
-			shift(77), // cmdAssign
-			shift(78), // cmdPlay
-			shift(79), // cmdTempo
-			shift(80), // cmdKey
-			shift(81), // cmdTime
-			shift(82), // cmdVelocity
-			shift(83), // cmdChannel
-			shift(84), // cmdVoice
-			shift(85), // cmdProgram
-			shift(86), // cmdControl
-			shift(87), // cmdStart
-			shift(88), // cmdStop
-			shift(89), // blockComment
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			reduce(3), // cmdRepeat, reduce: RepeatTerminator
+			reduce(3), // cmdAssign, reduce: RepeatTerminator
+			reduce(3), // cmdKit, reduce: RepeatTerminator
+			reduce(3), // cmdPlay, reduce: RepeatTerminator
+			reduce(3), // cmdTempo, reduce: RepeatTerminator
+			nil,       // arrow
+			reduce(3), // cmdKey, reduce: RepeatTerminator
+			reduce(3), // cmdScale, reduce: RepeatTerminator
+			reduce(3), // cmdTime, reduce: RepeatTerminator
+			reduce(3), // cmdMeter, reduce: RepeatTerminator
+			reduce(3), // cmdVelocity, reduce: RepeatTerminator
+			reduce(3), // cmdOctave, reduce: RepeatTerminator
+			reduce(3), // cmdChannel, reduce: RepeatTerminator
+			reduce(3), // cmdVoice, reduce: RepeatTerminator
+			reduce(3), // cmdProgram, reduce: RepeatTerminator
+			reduce(3), // cmdProgramName, reduce: RepeatTerminator
+			nil,       // string
+			reduce(3), // cmdControl, reduce: RepeatTerminator
+			reduce(3), // cmdControlRamp, reduce: RepeatTerminator
+			reduce(3), // cmdBend, reduce: RepeatTerminator
+			reduce(3), // cmdPressure, reduce: RepeatTerminator
+			reduce(3), // cmdSysex, reduce: RepeatTerminator
+			reduce(3), // cmdSysexFile, reduce: RepeatTerminator
+			reduce(3), // cmdRPN, reduce: RepeatTerminator
+			reduce(3), // cmdNRPN, reduce: RepeatTerminator
+			reduce(3), // cmdSwing, reduce: RepeatTerminator
+			reduce(3), // cmdGroove, reduce: RepeatTerminator
+			reduce(3), // cmdHumanize, reduce: RepeatTerminator
+			reduce(3), // cmdStart, reduce: RepeatTerminator
+			reduce(3), // cmdStop, reduce: RepeatTerminator
+			reduce(3), // cmdPickup, reduce: RepeatTerminator
+			reduce(3), // cmdStaff, reduce: RepeatTerminator
+			reduce(3), // cmdInclude, reduce: RepeatTerminator
+			reduce(3), // cmdVolta, reduce: RepeatTerminator
+			reduce(3), // cmdDyn, reduce: RepeatTerminator
+			reduce(3), // cmdDynamics, reduce: RepeatTerminator
+			reduce(3), // cmdCresc, reduce: RepeatTerminator
+			reduce(3), // cmdDim, reduce: RepeatTerminator
+			reduce(3), // blockComment, reduce: RepeatTerminator
 		},
 	},
-	actionRow{ // S141
+	actionRow{ // S274
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			shift(141), // terminator
-			reduce(2),  // cmdBar, reduce: RepeatTerminator
-			reduce(2),  // cmdEnd, reduce: RepeatTerminator
-			reduce(2),  // bracketBegin, reduce: RepeatTerminator
+			nil,        // terminator
+			shift(116), // lineComment
+			shift(122), // cmdBar
+			reduce(3),  // cmdEnd, reduce: RepeatTerminator
+			shift(125), // chord
+			shift(126), // pitch
+			shift(127), // degree
+			shift(129), // bracketBegin
 			nil,        // bracketEnd
-			reduce(2),  // symbol, reduce: RepeatTerminator
-			reduce(2),  // rest, reduce: RepeatTerminator
+			shift(130), // symbol
+			shift(131), // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -5392,36 +20042,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
-			reduce(2),  // cmdAssign, reduce: RepeatTerminator
-			reduce(2),  // cmdPlay, reduce: RepeatTerminator
-			reduce(2),  // cmdTempo, reduce: RepeatTerminator
-			reduce(2),  // cmdKey, reduce: RepeatTerminator
-			reduce(2),  // cmdTime, reduce: RepeatTerminator
-			reduce(2),  // cmdVelocity, reduce: RepeatTerminator
-			reduce(2),  // cmdChannel, reduce: RepeatTerminator
-			reduce(2),  // cmdVoice, reduce: RepeatTerminator
-			reduce(2),  // cmdProgram, reduce: RepeatTerminator
-			reduce(2),  // cmdControl, reduce: RepeatTerminator
-			reduce(2),  // cmdStart, reduce: RepeatTerminator
-			reduce(2),  // cmdStop, reduce: RepeatTerminator
-			reduce(2),  // blockComment, reduce: RepeatTerminator
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			shift(132), // cmdRepeat
+			shift(133), // cmdAssign
+			shift(134), // cmdKit
+			shift(135), // cmdPlay
+			shift(136), // cmdTempo
+			nil,        // arrow
+			shift(137), // cmdKey
+			shift(138), // cmdScale
+			shift(139), // cmdTime
+			shift(140), // cmdMeter
+			shift(141), // cmdVelocity
+			shift(142), // cmdOctave
+			shift(143), // cmdChannel
+			shift(144), // cmdVoice
+			shift(145), // cmdProgram
+			shift(146), // cmdProgramName
+			nil,        // string
+			shift(147), // cmdControl
+			shift(148), // cmdControlRamp
+			shift(149), // cmdBend
+			shift(150), // cmdPressure
+			shift(151), // cmdSysex
+			shift(152), // cmdSysexFile
+			shift(153), // cmdRPN
+			shift(154), // cmdNRPN
+			shift(155), // cmdSwing
+			shift(156), // cmdGroove
+			shift(157), // cmdHumanize
+			shift(158), // cmdStart
+			shift(159), // cmdStop
+			shift(160), // cmdPickup
+			shift(161), // cmdStaff
+			shift(162), // cmdInclude
+			shift(163), // cmdVolta
+			shift(164), // cmdDyn
+			shift(165), // cmdDynamics
+			shift(166), // cmdCresc
+			shift(167), // cmdDim
+			shift(168), // blockComment
 		},
 	},
-	actionRow{ // S142
+	actionRow{ // S275
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(13), // terminator, reduce: Bar
+			reduce(13), // lineComment, reduce: Bar
 			nil,        // cmdBar
-			shift(151), // cmdEnd
+			reduce(13), // cmdEnd, reduce: Bar
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -5430,36 +20115,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S143
+	actionRow{ // S276
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(19), // terminator, reduce: PropertyList
+			reduce(21), // terminator, reduce: NoteGroup
+			reduce(21), // lineComment, reduce: NoteGroup
 			nil,        // cmdBar
-			reduce(19), // cmdEnd, reduce: PropertyList
-			reduce(19), // bracketBegin, reduce: PropertyList
+			reduce(21), // cmdEnd, reduce: NoteGroup
+			reduce(21), // chord, reduce: NoteGroup
+			reduce(21), // pitch, reduce: NoteGroup
+			reduce(21), // degree, reduce: NoteGroup
+			reduce(21), // bracketBegin, reduce: NoteGroup
 			nil,        // bracketEnd
-			reduce(19), // symbol, reduce: PropertyList
-			reduce(19), // rest, reduce: PropertyList
+			reduce(21), // symbol, reduce: NoteGroup
+			reduce(21), // rest, reduce: NoteGroup
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -5468,74 +20188,71 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S144
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // ␚
-			nil,        // empty
-			reduce(18), // terminator, reduce: PropertyList
-			nil,        // cmdBar
-			reduce(18), // cmdEnd, reduce: PropertyList
-			reduce(18), // bracketBegin, reduce: PropertyList
-			nil,        // bracketEnd
-			reduce(18), // symbol, reduce: PropertyList
-			reduce(18), // rest, reduce: PropertyList
-			shift(118), // propSharp
-			shift(119), // propFlat
-			shift(120), // propStaccato
-			shift(121), // propAccent
-			shift(122), // propMarcato
-			shift(123), // propGhost
-			shift(124), // uint
-			shift(125), // propDot
-			shift(126), // propTuplet
-			shift(127), // propLetRing
-			nil,        // cmdAssign
-			nil,        // cmdPlay
-			nil,        // cmdTempo
-			nil,        // cmdKey
-			nil,        // cmdTime
-			nil,        // cmdVelocity
-			nil,        // cmdChannel
-			nil,        // cmdVoice
-			nil,        // cmdProgram
-			nil,        // cmdControl
-			nil,        // cmdStart
-			nil,        // cmdStop
-			nil,        // blockComment
-		},
-	},
-	actionRow{ // S145
+	actionRow{ // S277
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(30), // terminator, reduce: Command
+			nil,        // terminator
+			nil,        // lineComment
 			nil,        // cmdBar
-			reduce(30), // cmdEnd, reduce: Command
+			shift(282), // cmdEnd
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -5544,150 +20261,290 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S146
+	actionRow{ // S278
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(34), // terminator, reduce: Command
+			reduce(46), // terminator, reduce: Command
+			reduce(46), // lineComment, reduce: Command
 			nil,        // cmdBar
-			reduce(34), // cmdEnd, reduce: Command
+			reduce(46), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			nil,        // uint
+			shift(283), // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S147
+	actionRow{ // S279
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(39), // terminator, reduce: Command
+			reduce(64), // terminator, reduce: Command
+			reduce(64), // lineComment, reduce: Command
 			nil,        // cmdBar
-			reduce(39), // cmdEnd, reduce: Command
+			reduce(64), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			nil,        // uint
+			shift(284), // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S148
+	actionRow{ // S280
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			nil,        // terminator
+			reduce(66), // terminator, reduce: Command
+			reduce(66), // lineComment, reduce: Command
 			nil,        // cmdBar
-			nil,        // cmdEnd
-			reduce(15), // bracketBegin, reduce: NoteGroup
-			reduce(15), // bracketEnd, reduce: NoteGroup
-			reduce(15), // symbol, reduce: NoteGroup
-			reduce(15), // rest, reduce: NoteGroup
+			reduce(66), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
 			nil,        // propGhost
-			nil,        // uint
+			shift(285), // uint
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S149
+	actionRow{ // S281
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // ␚
 			nil,       // empty
 			nil,       // terminator
+			nil,       // lineComment
 			nil,       // cmdBar
-			reduce(4), // cmdEnd, reduce: DeclList
+			reduce(5), // cmdEnd, reduce: DeclList
+			nil,       // chord
+			nil,       // pitch
+			nil,       // degree
 			nil,       // bracketBegin
 			nil,       // bracketEnd
 			nil,       // symbol
 			nil,       // rest
 			nil,       // propSharp
 			nil,       // propFlat
+			nil,       // propOctaveUp
+			nil,       // propOctaveDown
 			nil,       // propStaccato
 			nil,       // propAccent
 			nil,       // propMarcato
@@ -5696,74 +20553,144 @@ var actionTab = actionTable{
 			nil,       // propDot
 			nil,       // propTuplet
 			nil,       // propLetRing
+			nil,       // propTie
+			nil,       // propAftertouch
+			nil,       // propChance
+			nil,       // cmdRepeat
 			nil,       // cmdAssign
+			nil,       // cmdKit
 			nil,       // cmdPlay
 			nil,       // cmdTempo
+			nil,       // arrow
 			nil,       // cmdKey
+			nil,       // cmdScale
 			nil,       // cmdTime
+			nil,       // cmdMeter
 			nil,       // cmdVelocity
+			nil,       // cmdOctave
 			nil,       // cmdChannel
 			nil,       // cmdVoice
 			nil,       // cmdProgram
+			nil,       // cmdProgramName
+			nil,       // string
 			nil,       // cmdControl
+			nil,       // cmdControlRamp
+			nil,       // cmdBend
+			nil,       // cmdPressure
+			nil,       // cmdSysex
+			nil,       // cmdSysexFile
+			nil,       // cmdRPN
+			nil,       // cmdNRPN
+			nil,       // cmdSwing
+			nil,       // cmdGroove
+			nil,       // cmdHumanize
 			nil,       // cmdStart
 			nil,       // cmdStop
+			nil,       // cmdPickup
+			nil,       // cmdStaff
+			nil,       // cmdInclude
+			nil,       // cmdVolta
+			nil,       // cmdDyn
+			nil,       // cmdDynamics
+			nil,       // cmdCresc
+			nil,       // cmdDim
 			nil,       // blockComment
 		},
 	},
-	actionRow{ // S150
+	actionRow{ // S282
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // ␚
-			nil,       // empty
-			nil,       // terminator
-			reduce(3), // cmdBar, reduce: RepeatTerminator
-			reduce(3), // cmdEnd, reduce: RepeatTerminator
-			reduce(3), // bracketBegin, reduce: RepeatTerminator
-			nil,       // bracketEnd
-			reduce(3), // symbol, reduce: RepeatTerminator
-			reduce(3), // rest, reduce: RepeatTerminator
-			nil,       // propSharp
-			nil,       // propFlat
-			nil,       // propStaccato
-			nil,       // propAccent
-			nil,       // propMarcato
-			nil,       // propGhost
-			nil,       // uint
-			nil,       // propDot
-			nil,       // propTuplet
-			nil,       // propLetRing
-			reduce(3), // cmdAssign, reduce: RepeatTerminator
-			reduce(3), // cmdPlay, reduce: RepeatTerminator
-			reduce(3), // cmdTempo, reduce: RepeatTerminator
-			reduce(3), // cmdKey, reduce: RepeatTerminator
-			reduce(3), // cmdTime, reduce: RepeatTerminator
-			reduce(3), // cmdVelocity, reduce: RepeatTerminator
-			reduce(3), // cmdChannel, reduce: RepeatTerminator
-			reduce(3), // cmdVoice, reduce: RepeatTerminator
-			reduce(3), // cmdProgram, reduce: RepeatTerminator
-			reduce(3), // cmdControl, reduce: RepeatTerminator
-			reduce(3), // cmdStart, reduce: RepeatTerminator
-			reduce(3), // cmdStop, reduce: RepeatTerminator
-			reduce(3), // blockComment, reduce: RepeatTerminator
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(41), // terminator, reduce: Repeat
+			reduce(41), // lineComment, reduce: Repeat
+			nil,        // cmdBar
+			reduce(41), // cmdEnd, reduce: Repeat
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
 		},
 	},
-	actionRow{ // S151
+	actionRow{ // S283
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(10), // terminator, reduce: Bar
+			reduce(47), // terminator, reduce: Command
+			reduce(47), // lineComment, reduce: Command
 			nil,        // cmdBar
-			reduce(10), // cmdEnd, reduce: Bar
+			reduce(47), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
 			nil,        // bracketBegin
 			nil,        // bracketEnd
 			nil,        // symbol
 			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -5772,36 +20699,144 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
-	actionRow{ // S152
+	actionRow{ // S284
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // ␚
+			nil,        // empty
+			reduce(65), // terminator, reduce: Command
+			reduce(65), // lineComment, reduce: Command
+			nil,        // cmdBar
+			reduce(65), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
+			nil,        // bracketEnd
+			nil,        // symbol
+			nil,        // rest
+			nil,        // propSharp
+			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
+			nil,        // propStaccato
+			nil,        // propAccent
+			nil,        // propMarcato
+			nil,        // propGhost
+			nil,        // uint
+			nil,        // propDot
+			nil,        // propTuplet
+			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
+			nil,        // cmdAssign
+			nil,        // cmdKit
+			nil,        // cmdPlay
+			nil,        // cmdTempo
+			nil,        // arrow
+			nil,        // cmdKey
+			nil,        // cmdScale
+			nil,        // cmdTime
+			nil,        // cmdMeter
+			nil,        // cmdVelocity
+			nil,        // cmdOctave
+			nil,        // cmdChannel
+			nil,        // cmdVoice
+			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
+			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
+			nil,        // cmdStart
+			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
+			nil,        // blockComment
+		},
+	},
+	actionRow{ // S285
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // ␚
 			nil,        // empty
-			reduce(15), // terminator, reduce: NoteGroup
+			reduce(67), // terminator, reduce: Command
+			reduce(67), // lineComment, reduce: Command
 			nil,        // cmdBar
-			reduce(15), // cmdEnd, reduce: NoteGroup
-			reduce(15), // bracketBegin, reduce: NoteGroup
+			reduce(67), // cmdEnd, reduce: Command
+			nil,        // chord
+			nil,        // pitch
+			nil,        // degree
+			nil,        // bracketBegin
 			nil,        // bracketEnd
-			reduce(15), // symbol, reduce: NoteGroup
-			reduce(15), // rest, reduce: NoteGroup
+			nil,        // symbol
+			nil,        // rest
 			nil,        // propSharp
 			nil,        // propFlat
+			nil,        // propOctaveUp
+			nil,        // propOctaveDown
 			nil,        // propStaccato
 			nil,        // propAccent
 			nil,        // propMarcato
@@ -5810,18 +20845,47 @@ var actionTab = actionTable{
 			nil,        // propDot
 			nil,        // propTuplet
 			nil,        // propLetRing
+			nil,        // propTie
+			nil,        // propAftertouch
+			nil,        // propChance
+			nil,        // cmdRepeat
 			nil,        // cmdAssign
+			nil,        // cmdKit
 			nil,        // cmdPlay
 			nil,        // cmdTempo
+			nil,        // arrow
 			nil,        // cmdKey
+			nil,        // cmdScale
 			nil,        // cmdTime
+			nil,        // cmdMeter
 			nil,        // cmdVelocity
+			nil,        // cmdOctave
 			nil,        // cmdChannel
 			nil,        // cmdVoice
 			nil,        // cmdProgram
+			nil,        // cmdProgramName
+			nil,        // string
 			nil,        // cmdControl
+			nil,        // cmdControlRamp
+			nil,        // cmdBend
+			nil,        // cmdPressure
+			nil,        // cmdSysex
+			nil,        // cmdSysexFile
+			nil,        // cmdRPN
+			nil,        // cmdNRPN
+			nil,        // cmdSwing
+			nil,        // cmdGroove
+			nil,        // cmdHumanize
 			nil,        // cmdStart
 			nil,        // cmdStop
+			nil,        // cmdPickup
+			nil,        // cmdStaff
+			nil,        // cmdInclude
+			nil,        // cmdVolta
+			nil,        // cmdDyn
+			nil,        // cmdDynamics
+			nil,        // cmdCresc
+			nil,        // cmdDim
 			nil,        // blockComment
 		},
 	},
@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 14
+const numNTSymbols = 15
 
 type (
 	gotoTable [numStates]gotoRow
@@ -23,6 +23,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -39,6 +40,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -48,20 +50,21 @@ var gotoTab = gotoTable{
 		-1, // RepeatTerminator
 		4,  // DeclList
 		5,  // Decl
-		6,  // Bar
-		8,  // NoteList
-		11, // NoteObject
-		13, // NoteGroup
-		12, // NoteSymbol
+		7,  // Bar
+		10, // NoteList
+		13, // NoteObject
+		18, // NoteGroup
+		14, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
-		7,  // Command
-		9,  // Comment
+		8,  // Repeat
+		9,  // Command
+		11, // Comment
 	},
 	gotoRow{ // S3
 		-1, // S'
 		-1, // SourceFile
-		30, // RepeatTerminator
+		59, // RepeatTerminator
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
@@ -71,6 +74,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -87,13 +91,14 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
 	gotoRow{ // S5
 		-1, // S'
 		-1, // SourceFile
-		31, // RepeatTerminator
+		60, // RepeatTerminator
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
@@ -103,6 +108,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -119,6 +125,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -135,6 +142,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -151,6 +159,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -167,13 +176,14 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
 	gotoRow{ // S10
 		-1, // S'
 		-1, // SourceFile
-		33, // RepeatTerminator
+		-1, // RepeatTerminator
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
@@ -183,6 +193,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -193,19 +204,20 @@ var gotoTab = gotoTable{
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
-		34, // NoteList
-		11, // NoteObject
-		13, // NoteGroup
-		12, // NoteSymbol
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
 	gotoRow{ // S12
 		-1, // S'
 		-1, // SourceFile
-		-1, // RepeatTerminator
+		63, // RepeatTerminator
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
@@ -213,8 +225,9 @@ var gotoTab = gotoTable{
 		-1, // NoteObject
 		-1, // NoteGroup
 		-1, // NoteSymbol
-		35, // PropertyList
-		36, // Property
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -225,12 +238,13 @@ var gotoTab = gotoTable{
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
-		-1, // NoteList
-		-1, // NoteObject
-		-1, // NoteGroup
-		-1, // NoteSymbol
+		64, // NoteList
+		13, // NoteObject
+		18, // NoteGroup
+		14, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -241,12 +255,13 @@ var gotoTab = gotoTable{
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
-		47, // NoteList
-		48, // NoteObject
-		50, // NoteGroup
-		49, // NoteSymbol
-		-1, // PropertyList
-		-1, // Property
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		65, // PropertyList
+		66, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -261,8 +276,9 @@ var gotoTab = gotoTable{
 		-1, // NoteObject
 		-1, // NoteGroup
 		-1, // NoteSymbol
-		-1, // PropertyList
-		-1, // Property
+		82, // PropertyList
+		66, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -277,8 +293,9 @@ var gotoTab = gotoTable{
 		-1, // NoteObject
 		-1, // NoteGroup
 		-1, // NoteSymbol
-		-1, // PropertyList
-		-1, // Property
+		83, // PropertyList
+		66, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -293,8 +310,9 @@ var gotoTab = gotoTable{
 		-1, // NoteObject
 		-1, // NoteGroup
 		-1, // NoteSymbol
-		-1, // PropertyList
-		-1, // Property
+		84, // PropertyList
+		66, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -311,6 +329,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -321,12 +340,13 @@ var gotoTab = gotoTable{
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
-		-1, // NoteList
-		-1, // NoteObject
-		-1, // NoteGroup
-		-1, // NoteSymbol
+		85, // NoteList
+		86, // NoteObject
+		91, // NoteGroup
+		87, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -343,6 +363,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -359,6 +380,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -375,6 +397,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -391,6 +414,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -407,6 +431,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -423,6 +448,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -439,6 +465,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -455,6 +482,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -471,6 +499,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -487,6 +516,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -503,6 +533,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -519,13 +550,14 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
 	gotoRow{ // S32
 		-1, // S'
 		-1, // SourceFile
-		62, // RepeatTerminator
+		-1, // RepeatTerminator
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
@@ -535,6 +567,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -542,17 +575,18 @@ var gotoTab = gotoTable{
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
-		64, // DeclList
-		65, // Decl
-		66, // Bar
-		68, // NoteList
-		71, // NoteObject
-		73, // NoteGroup
-		72, // NoteSymbol
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
-		67, // Command
-		69, // Comment
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
 	},
 	gotoRow{ // S34
 		-1, // S'
@@ -567,6 +601,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -583,6 +618,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -597,8 +633,9 @@ var gotoTab = gotoTable{
 		-1, // NoteObject
 		-1, // NoteGroup
 		-1, // NoteSymbol
-		90, // PropertyList
-		36, // Property
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -615,6 +652,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -631,6 +669,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -647,6 +686,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -663,6 +703,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -679,6 +720,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -695,6 +737,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -711,6 +754,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -727,6 +771,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -743,6 +788,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -759,6 +805,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -775,6 +822,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -785,12 +833,13 @@ var gotoTab = gotoTable{
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
-		92, // NoteList
-		48, // NoteObject
-		50, // NoteGroup
-		49, // NoteSymbol
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -805,8 +854,9 @@ var gotoTab = gotoTable{
 		-1, // NoteObject
 		-1, // NoteGroup
 		-1, // NoteSymbol
-		93, // PropertyList
-		94, // Property
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -823,26 +873,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
 	gotoRow{ // S51
-		-1,  // S'
-		-1,  // SourceFile
-		-1,  // RepeatTerminator
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // Bar
-		105, // NoteList
-		48,  // NoteObject
-		50,  // NoteGroup
-		49,  // NoteSymbol
-		-1,  // PropertyList
-		-1,  // Property
-		-1,  // Command
-		-1,  // Comment
-	},
-	gotoRow{ // S52
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -855,10 +890,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S53
+	gotoRow{ // S52
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -871,10 +907,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S54
+	gotoRow{ // S53
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -887,10 +924,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S55
+	gotoRow{ // S54
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -903,10 +941,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S56
+	gotoRow{ // S55
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -919,10 +958,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S57
+	gotoRow{ // S56
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -935,10 +975,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S58
+	gotoRow{ // S57
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -951,10 +992,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S59
+	gotoRow{ // S58
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -967,10 +1009,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S60
+	gotoRow{ // S59
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -983,10 +1026,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S61
+	gotoRow{ // S60
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -999,26 +1043,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S62
-		-1,  // S'
-		-1,  // SourceFile
-		-1,  // RepeatTerminator
-		109, // DeclList
-		5,   // Decl
-		6,   // Bar
-		8,   // NoteList
-		11,  // NoteObject
-		13,  // NoteGroup
-		12,  // NoteSymbol
-		-1,  // PropertyList
-		-1,  // Property
-		7,   // Command
-		9,   // Comment
-	},
-	gotoRow{ // S63
+	gotoRow{ // S61
 		-1,  // S'
 		-1,  // SourceFile
 		110, // RepeatTerminator
@@ -1031,26 +1060,11 @@ var gotoTab = gotoTable{
 		-1,  // NoteSymbol
 		-1,  // PropertyList
 		-1,  // Property
+		-1,  // Repeat
 		-1,  // Command
 		-1,  // Comment
 	},
-	gotoRow{ // S64
-		-1, // S'
-		-1, // SourceFile
-		-1, // RepeatTerminator
-		-1, // DeclList
-		-1, // Decl
-		-1, // Bar
-		-1, // NoteList
-		-1, // NoteObject
-		-1, // NoteGroup
-		-1, // NoteSymbol
-		-1, // PropertyList
-		-1, // Property
-		-1, // Command
-		-1, // Comment
-	},
-	gotoRow{ // S65
+	gotoRow{ // S62
 		-1,  // S'
 		-1,  // SourceFile
 		112, // RepeatTerminator
@@ -1063,42 +1077,28 @@ var gotoTab = gotoTable{
 		-1,  // NoteSymbol
 		-1,  // PropertyList
 		-1,  // Property
+		-1,  // Repeat
 		-1,  // Command
 		-1,  // Comment
 	},
-	gotoRow{ // S66
-		-1, // S'
-		-1, // SourceFile
-		-1, // RepeatTerminator
-		-1, // DeclList
-		-1, // Decl
-		-1, // Bar
-		-1, // NoteList
-		-1, // NoteObject
-		-1, // NoteGroup
-		-1, // NoteSymbol
-		-1, // PropertyList
-		-1, // Property
-		-1, // Command
-		-1, // Comment
-	},
-	gotoRow{ // S67
-		-1, // S'
-		-1, // SourceFile
-		-1, // RepeatTerminator
-		-1, // DeclList
-		-1, // Decl
-		-1, // Bar
-		-1, // NoteList
-		-1, // NoteObject
-		-1, // NoteGroup
-		-1, // NoteSymbol
-		-1, // PropertyList
-		-1, // Property
-		-1, // Command
-		-1, // Comment
+	gotoRow{ // S63
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		114, // DeclList
+		115, // Decl
+		117, // Bar
+		120, // NoteList
+		123, // NoteObject
+		128, // NoteGroup
+		124, // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		118, // Repeat
+		119, // Command
+		121, // Comment
 	},
-	gotoRow{ // S68
+	gotoRow{ // S64
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1111,10 +1111,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S69
+	gotoRow{ // S65
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1127,42 +1128,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S70
-		-1,  // S'
-		-1,  // SourceFile
-		114, // RepeatTerminator
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // Bar
-		-1,  // NoteList
-		-1,  // NoteObject
-		-1,  // NoteGroup
-		-1,  // NoteSymbol
-		-1,  // PropertyList
-		-1,  // Property
-		-1,  // Command
-		-1,  // Comment
-	},
-	gotoRow{ // S71
-		-1,  // S'
-		-1,  // SourceFile
-		-1,  // RepeatTerminator
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // Bar
-		115, // NoteList
-		71,  // NoteObject
-		73,  // NoteGroup
-		72,  // NoteSymbol
-		-1,  // PropertyList
-		-1,  // Property
-		-1,  // Command
-		-1,  // Comment
-	},
-	gotoRow{ // S72
+	gotoRow{ // S66
 		-1,  // S'
 		-1,  // SourceFile
 		-1,  // RepeatTerminator
@@ -1173,12 +1143,13 @@ var gotoTab = gotoTable{
 		-1,  // NoteObject
 		-1,  // NoteGroup
 		-1,  // NoteSymbol
-		116, // PropertyList
-		117, // Property
+		169, // PropertyList
+		66,  // Property
+		-1,  // Repeat
 		-1,  // Command
 		-1,  // Comment
 	},
-	gotoRow{ // S73
+	gotoRow{ // S67
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1191,26 +1162,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S74
-		-1,  // S'
-		-1,  // SourceFile
-		-1,  // RepeatTerminator
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // Bar
-		128, // NoteList
-		48,  // NoteObject
-		50,  // NoteGroup
-		49,  // NoteSymbol
-		-1,  // PropertyList
-		-1,  // Property
-		-1,  // Command
-		-1,  // Comment
-	},
-	gotoRow{ // S75
+	gotoRow{ // S68
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1223,10 +1179,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S76
+	gotoRow{ // S69
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1239,10 +1196,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S77
+	gotoRow{ // S70
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1255,10 +1213,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S78
+	gotoRow{ // S71
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1271,10 +1230,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S79
+	gotoRow{ // S72
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1287,10 +1247,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S80
+	gotoRow{ // S73
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1303,10 +1264,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S81
+	gotoRow{ // S74
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1319,10 +1281,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S82
+	gotoRow{ // S75
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1335,10 +1298,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S83
+	gotoRow{ // S76
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1351,10 +1315,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S84
+	gotoRow{ // S77
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1367,10 +1332,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S85
+	gotoRow{ // S78
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1383,10 +1349,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S86
+	gotoRow{ // S79
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1399,10 +1366,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S87
+	gotoRow{ // S80
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1415,10 +1383,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S88
+	gotoRow{ // S81
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1431,10 +1400,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S89
+	gotoRow{ // S82
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1447,10 +1417,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S90
+	gotoRow{ // S83
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1463,26 +1434,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S91
-		-1,  // S'
-		-1,  // SourceFile
-		-1,  // RepeatTerminator
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // Bar
-		-1,  // NoteList
-		-1,  // NoteObject
-		-1,  // NoteGroup
-		-1,  // NoteSymbol
-		137, // PropertyList
-		36,  // Property
-		-1,  // Command
-		-1,  // Comment
-	},
-	gotoRow{ // S92
+	gotoRow{ // S84
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1495,10 +1451,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S93
+	gotoRow{ // S85
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1511,10 +1468,28 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S94
+	gotoRow{ // S86
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		171, // NoteList
+		86,  // NoteObject
+		91,  // NoteGroup
+		87,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S87
 		-1,  // S'
 		-1,  // SourceFile
 		-1,  // RepeatTerminator
@@ -1525,15 +1500,2294 @@ var gotoTab = gotoTable{
 		-1,  // NoteObject
 		-1,  // NoteGroup
 		-1,  // NoteSymbol
-		138, // PropertyList
-		94,  // Property
+		172, // PropertyList
+		173, // Property
+		-1,  // Repeat
 		-1,  // Command
 		-1,  // Comment
 	},
-	gotoRow{ // S95
-		-1, // S'
-		-1, // SourceFile
-		-1, // RepeatTerminator
+	gotoRow{ // S88
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		189, // PropertyList
+		173, // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S89
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		190, // PropertyList
+		173, // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S90
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		191, // PropertyList
+		173, // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S91
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S92
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		192, // NoteList
+		86,  // NoteObject
+		91,  // NoteGroup
+		87,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S93
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S94
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S95
+		-1,  // S'
+		-1,  // SourceFile
+		193, // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S96
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S97
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S98
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S99
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S100
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S101
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S102
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S103
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S104
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S105
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S106
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S107
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S108
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S109
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S110
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		200, // DeclList
+		5,   // Decl
+		7,   // Bar
+		10,  // NoteList
+		13,  // NoteObject
+		18,  // NoteGroup
+		14,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		8,   // Repeat
+		9,   // Command
+		11,  // Comment
+	},
+	gotoRow{ // S111
+		-1,  // S'
+		-1,  // SourceFile
+		201, // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S112
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S113
+		-1,  // S'
+		-1,  // SourceFile
+		202, // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S114
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S115
+		-1,  // S'
+		-1,  // SourceFile
+		204, // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S116
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S117
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S118
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S119
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S120
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S121
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S122
+		-1,  // S'
+		-1,  // SourceFile
+		207, // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S123
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		208, // NoteList
+		123, // NoteObject
+		128, // NoteGroup
+		124, // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S124
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		209, // PropertyList
+		210, // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S125
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		226, // PropertyList
+		210, // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S126
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		227, // PropertyList
+		210, // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S127
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		228, // PropertyList
+		210, // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S128
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S129
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		229, // NoteList
+		86,  // NoteObject
+		91,  // NoteGroup
+		87,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S130
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S131
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S132
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S133
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S134
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S135
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S136
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S137
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S138
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S139
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S140
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S141
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S142
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S143
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S144
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S145
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S146
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S147
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S148
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S149
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S150
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S151
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S152
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S153
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S154
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S155
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S156
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S157
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S158
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S159
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S160
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S161
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S162
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S163
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S164
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S165
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S166
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S167
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S168
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S169
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S170
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		245, // PropertyList
+		66,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S171
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S172
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S173
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		246, // PropertyList
+		173, // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S174
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S175
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S176
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S177
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S178
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S179
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S180
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S181
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S182
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S183
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S184
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S185
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S186
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S187
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S188
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S189
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S190
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S191
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S192
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S193
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		248, // DeclList
+		115, // Decl
+		117, // Bar
+		120, // NoteList
+		123, // NoteObject
+		128, // NoteGroup
+		124, // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		118, // Repeat
+		119, // Command
+		121, // Comment
+	},
+	gotoRow{ // S194
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S195
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S196
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S197
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S198
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S199
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S200
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S201
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S202
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		252, // DeclList
+		5,   // Decl
+		7,   // Bar
+		10,  // NoteList
+		13,  // NoteObject
+		18,  // NoteGroup
+		14,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		8,   // Repeat
+		9,   // Command
+		11,  // Comment
+	},
+	gotoRow{ // S203
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S204
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S205
+		-1,  // S'
+		-1,  // SourceFile
+		253, // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S206
+		-1,  // S'
+		-1,  // SourceFile
+		255, // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S207
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		257, // DeclList
+		115, // Decl
+		117, // Bar
+		120, // NoteList
+		123, // NoteObject
+		128, // NoteGroup
+		124, // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		118, // Repeat
+		119, // Command
+		121, // Comment
+	},
+	gotoRow{ // S208
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S209
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S210
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		258, // PropertyList
+		210, // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S211
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S212
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S213
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S214
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S215
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S216
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S217
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S218
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S219
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S220
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S221
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S222
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
 		-1, // DeclList
 		-1, // Decl
 		-1, // Bar
@@ -1543,10 +3797,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S96
+	gotoRow{ // S223
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1559,10 +3814,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S97
+	gotoRow{ // S224
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1575,10 +3831,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S98
+	gotoRow{ // S225
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1591,10 +3848,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S99
+	gotoRow{ // S226
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1607,10 +3865,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S100
+	gotoRow{ // S227
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1623,10 +3882,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S101
+	gotoRow{ // S228
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1639,10 +3899,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S102
+	gotoRow{ // S229
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1655,10 +3916,28 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S103
+	gotoRow{ // S230
+		-1,  // S'
+		-1,  // SourceFile
+		260, // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S231
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1671,10 +3950,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S104
+	gotoRow{ // S232
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1687,10 +3967,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S105
+	gotoRow{ // S233
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1703,10 +3984,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S106
+	gotoRow{ // S234
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1719,10 +4001,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S107
+	gotoRow{ // S235
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1735,10 +4018,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S108
+	gotoRow{ // S236
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1751,10 +4035,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S109
+	gotoRow{ // S237
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1767,10 +4052,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S110
+	gotoRow{ // S238
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1783,10 +4069,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S111
+	gotoRow{ // S239
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1799,10 +4086,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S112
+	gotoRow{ // S240
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1815,42 +4103,45 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S113
-		-1,  // S'
-		-1,  // SourceFile
-		140, // RepeatTerminator
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // Bar
-		-1,  // NoteList
-		-1,  // NoteObject
-		-1,  // NoteGroup
-		-1,  // NoteSymbol
-		-1,  // PropertyList
-		-1,  // Property
-		-1,  // Command
-		-1,  // Comment
+	gotoRow{ // S241
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
 	},
-	gotoRow{ // S114
-		-1,  // S'
-		-1,  // SourceFile
-		-1,  // RepeatTerminator
-		142, // DeclList
-		65,  // Decl
-		66,  // Bar
-		68,  // NoteList
-		71,  // NoteObject
-		73,  // NoteGroup
-		72,  // NoteSymbol
-		-1,  // PropertyList
-		-1,  // Property
-		67,  // Command
-		69,  // Comment
+	gotoRow{ // S242
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
 	},
-	gotoRow{ // S115
+	gotoRow{ // S243
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1863,10 +4154,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S116
+	gotoRow{ // S244
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1879,10 +4171,45 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S117
+	gotoRow{ // S245
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S246
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
+	},
+	gotoRow{ // S247
 		-1,  // S'
 		-1,  // SourceFile
 		-1,  // RepeatTerminator
@@ -1893,12 +4220,13 @@ var gotoTab = gotoTable{
 		-1,  // NoteObject
 		-1,  // NoteGroup
 		-1,  // NoteSymbol
-		143, // PropertyList
-		117, // Property
+		267, // PropertyList
+		173, // Property
+		-1,  // Repeat
 		-1,  // Command
 		-1,  // Comment
 	},
-	gotoRow{ // S118
+	gotoRow{ // S248
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1911,10 +4239,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S119
+	gotoRow{ // S249
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1927,10 +4256,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S120
+	gotoRow{ // S250
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1943,10 +4273,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S121
+	gotoRow{ // S251
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1959,10 +4290,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S122
+	gotoRow{ // S252
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1975,10 +4307,45 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S123
+	gotoRow{ // S253
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		272, // DeclList
+		115, // Decl
+		117, // Bar
+		120, // NoteList
+		123, // NoteObject
+		128, // NoteGroup
+		124, // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		118, // Repeat
+		119, // Command
+		121, // Comment
+	},
+	gotoRow{ // S254
+		-1,  // S'
+		-1,  // SourceFile
+		273, // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S255
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -1991,10 +4358,28 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S124
+	gotoRow{ // S256
+		-1,  // S'
+		-1,  // SourceFile
+		274, // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S257
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2007,10 +4392,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S125
+	gotoRow{ // S258
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2023,10 +4409,45 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S126
+	gotoRow{ // S259
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // Bar
+		-1,  // NoteList
+		-1,  // NoteObject
+		-1,  // NoteGroup
+		-1,  // NoteSymbol
+		276, // PropertyList
+		210, // Property
+		-1,  // Repeat
+		-1,  // Command
+		-1,  // Comment
+	},
+	gotoRow{ // S260
+		-1,  // S'
+		-1,  // SourceFile
+		-1,  // RepeatTerminator
+		277, // DeclList
+		115, // Decl
+		117, // Bar
+		120, // NoteList
+		123, // NoteObject
+		128, // NoteGroup
+		124, // NoteSymbol
+		-1,  // PropertyList
+		-1,  // Property
+		118, // Repeat
+		119, // Command
+		121, // Comment
+	},
+	gotoRow{ // S261
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2039,10 +4460,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S127
+	gotoRow{ // S262
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2055,10 +4477,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S128
+	gotoRow{ // S263
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2071,10 +4494,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S129
+	gotoRow{ // S264
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2087,10 +4511,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S130
+	gotoRow{ // S265
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2103,10 +4528,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S131
+	gotoRow{ // S266
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2119,10 +4545,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S132
+	gotoRow{ // S267
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2135,10 +4562,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S133
+	gotoRow{ // S268
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2151,10 +4579,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S134
+	gotoRow{ // S269
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2167,10 +4596,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S135
+	gotoRow{ // S270
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2183,10 +4613,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S136
+	gotoRow{ // S271
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2199,10 +4630,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S137
+	gotoRow{ // S272
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2215,10 +4647,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S138
+	gotoRow{ // S273
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2231,58 +4664,28 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S139
-		-1,  // S'
-		-1,  // SourceFile
-		-1,  // RepeatTerminator
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // Bar
-		-1,  // NoteList
-		-1,  // NoteObject
-		-1,  // NoteGroup
-		-1,  // NoteSymbol
-		148, // PropertyList
-		94,  // Property
-		-1,  // Command
-		-1,  // Comment
-	},
-	gotoRow{ // S140
+	gotoRow{ // S274
 		-1,  // S'
 		-1,  // SourceFile
 		-1,  // RepeatTerminator
-		149, // DeclList
-		65,  // Decl
-		66,  // Bar
-		68,  // NoteList
-		71,  // NoteObject
-		73,  // NoteGroup
-		72,  // NoteSymbol
-		-1,  // PropertyList
-		-1,  // Property
-		67,  // Command
-		69,  // Comment
-	},
-	gotoRow{ // S141
-		-1,  // S'
-		-1,  // SourceFile
-		150, // RepeatTerminator
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // Bar
-		-1,  // NoteList
-		-1,  // NoteObject
-		-1,  // NoteGroup
-		-1,  // NoteSymbol
+		281, // DeclList
+		115, // Decl
+		117, // Bar
+		120, // NoteList
+		123, // NoteObject
+		128, // NoteGroup
+		124, // NoteSymbol
 		-1,  // PropertyList
 		-1,  // Property
-		-1,  // Command
-		-1,  // Comment
+		118, // Repeat
+		119, // Command
+		121, // Comment
 	},
-	gotoRow{ // S142
+	gotoRow{ // S275
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2295,10 +4698,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S143
+	gotoRow{ // S276
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2311,26 +4715,28 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S144
-		-1,  // S'
-		-1,  // SourceFile
-		-1,  // RepeatTerminator
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // Bar
-		-1,  // NoteList
-		-1,  // NoteObject
-		-1,  // NoteGroup
-		-1,  // NoteSymbol
-		152, // PropertyList
-		117, // Property
-		-1,  // Command
-		-1,  // Comment
+	gotoRow{ // S277
+		-1, // S'
+		-1, // SourceFile
+		-1, // RepeatTerminator
+		-1, // DeclList
+		-1, // Decl
+		-1, // Bar
+		-1, // NoteList
+		-1, // NoteObject
+		-1, // NoteGroup
+		-1, // NoteSymbol
+		-1, // PropertyList
+		-1, // Property
+		-1, // Repeat
+		-1, // Command
+		-1, // Comment
 	},
-	gotoRow{ // S145
+	gotoRow{ // S278
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2343,10 +4749,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S146
+	gotoRow{ // S279
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2359,10 +4766,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S147
+	gotoRow{ // S280
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2375,10 +4783,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S148
+	gotoRow{ // S281
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2391,10 +4800,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S149
+	gotoRow{ // S282
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2407,10 +4817,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S150
+	gotoRow{ // S283
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2423,10 +4834,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S151
+	gotoRow{ // S284
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2439,10 +4851,11 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
-	gotoRow{ // S152
+	gotoRow{ // S285
 		-1, // S'
 		-1, // SourceFile
 		-1, // RepeatTerminator
@@ -2455,6 +4868,7 @@ var gotoTab = gotoTable{
 		-1, // NoteSymbol
 		-1, // PropertyList
 		-1, // Property
+		-1, // Repeat
 		-1, // Command
 		-1, // Comment
 	},
@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 43
-	numStates      = 153
-	numSymbols     = 47
+	numProductions = 83
+	numStates      = 286
+	numSymbols     = 83
 )
 
 // Stack
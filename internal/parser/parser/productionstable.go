@@ -73,20 +73,50 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `DeclList : Decl RepeatTerminator	<< ast.NewNodeList(X[0].(ast.Node)), nil >>`,
+		String: `DeclList : Decl lineComment terminator RepeatTerminator DeclList	<< ast.NewNodeList(X[0].(ast.Node), ast.NewNodeList(ast.NewLineComment(string(X[1].(*token.Token).Lit)), X[4].(ast.NodeList)...)...), nil >>`,
 		Id:         "DeclList",
 		NTType:     3,
 		Index:      5,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeList(X[0].(ast.Node), ast.NewNodeList(ast.NewLineComment(string(X[1].(*token.Token).Lit)), X[4].(ast.NodeList)...)...), nil
+		},
+	},
+	ProdTabEntry{
+		String: `DeclList : Decl RepeatTerminator	<< ast.NewNodeList(X[0].(ast.Node)), nil >>`,
+		Id:         "DeclList",
+		NTType:     3,
+		Index:      6,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewNodeList(X[0].(ast.Node)), nil
 		},
 	},
+	ProdTabEntry{
+		String: `DeclList : Decl lineComment RepeatTerminator	<< ast.NewNodeList(X[0].(ast.Node), ast.NewLineComment(string(X[1].(*token.Token).Lit))), nil >>`,
+		Id:         "DeclList",
+		NTType:     3,
+		Index:      7,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNodeList(X[0].(ast.Node), ast.NewLineComment(string(X[1].(*token.Token).Lit))), nil
+		},
+	},
 	ProdTabEntry{
 		String: `Decl : Bar	<<  >>`,
 		Id:         "Decl",
 		NTType:     4,
-		Index:      6,
+		Index:      8,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Decl : Repeat	<<  >>`,
+		Id:         "Decl",
+		NTType:     4,
+		Index:      9,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -96,7 +126,7 @@ var productionsTable = ProdTab{
 		String: `Decl : Command	<<  >>`,
 		Id:         "Decl",
 		NTType:     4,
-		Index:      7,
+		Index:      10,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -106,7 +136,7 @@ var productionsTable = ProdTab{
 		String: `Decl : NoteList	<<  >>`,
 		Id:         "Decl",
 		NTType:     4,
-		Index:      8,
+		Index:      11,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -116,7 +146,7 @@ var productionsTable = ProdTab{
 		String: `Decl : Comment	<<  >>`,
 		Id:         "Decl",
 		NTType:     4,
-		Index:      9,
+		Index:      12,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -126,7 +156,7 @@ var productionsTable = ProdTab{
 		String: `Bar : cmdBar RepeatTerminator DeclList cmdEnd	<< ast.NewBar(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":bar "):]), X[2].(ast.NodeList)), nil >>`,
 		Id:         "Bar",
 		NTType:     5,
-		Index:      10,
+		Index:      13,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewBar(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":bar "):]), X[2].(ast.NodeList)), nil
@@ -136,7 +166,7 @@ var productionsTable = ProdTab{
 		String: `NoteList : NoteObject	<< ast.NewNodeList(X[0].(ast.Node)), nil >>`,
 		Id:         "NoteList",
 		NTType:     6,
-		Index:      11,
+		Index:      14,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewNodeList(X[0].(ast.Node)), nil
@@ -146,27 +176,57 @@ var productionsTable = ProdTab{
 		String: `NoteList : NoteObject NoteList	<< ast.NewNodeList(X[0].(ast.Node), X[1].(ast.NodeList)...), nil >>`,
 		Id:         "NoteList",
 		NTType:     6,
-		Index:      12,
+		Index:      15,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewNodeList(X[0].(ast.Node), X[1].(ast.NodeList)...), nil
 		},
 	},
 	ProdTabEntry{
-		String: `NoteObject : NoteSymbol PropertyList	<< ast.NewNote(X[0].(*token.Token).Pos, []rune(string(X[0].(*token.Token).Lit))[0], X[1].(ast.PropertyList)), nil >>`,
+		String: `NoteObject : NoteSymbol PropertyList	<< ast.NewNote(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit), X[1].(ast.PropertyList)), nil >>`,
 		Id:         "NoteObject",
 		NTType:     7,
-		Index:      13,
+		Index:      16,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewNote(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit), X[1].(ast.PropertyList)), nil
+		},
+	},
+	ProdTabEntry{
+		String: `NoteObject : chord PropertyList	<< ast.NewChord(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit), X[1].(ast.PropertyList)) >>`,
+		Id:         "NoteObject",
+		NTType:     7,
+		Index:      17,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewChord(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit), X[1].(ast.PropertyList))
+		},
+	},
+	ProdTabEntry{
+		String: `NoteObject : pitch PropertyList	<< ast.NewPitch(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit), X[1].(ast.PropertyList)) >>`,
+		Id:         "NoteObject",
+		NTType:     7,
+		Index:      18,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewPitch(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit), X[1].(ast.PropertyList))
+		},
+	},
+	ProdTabEntry{
+		String: `NoteObject : degree PropertyList	<< ast.NewDegree(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit), X[1].(ast.PropertyList)) >>`,
+		Id:         "NoteObject",
+		NTType:     7,
+		Index:      19,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewNote(X[0].(*token.Token).Pos, []rune(string(X[0].(*token.Token).Lit))[0], X[1].(ast.PropertyList)), nil
+			return ast.NewDegree(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit), X[1].(ast.PropertyList))
 		},
 	},
 	ProdTabEntry{
 		String: `NoteObject : NoteGroup	<<  >>`,
 		Id:         "NoteObject",
 		NTType:     7,
-		Index:      14,
+		Index:      20,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -176,7 +236,7 @@ var productionsTable = ProdTab{
 		String: `NoteGroup : bracketBegin NoteList bracketEnd PropertyList	<< ast.NewNoteGroup(X[1].(ast.NodeList), X[3].(ast.PropertyList)) >>`,
 		Id:         "NoteGroup",
 		NTType:     8,
-		Index:      15,
+		Index:      21,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewNoteGroup(X[1].(ast.NodeList), X[3].(ast.PropertyList))
@@ -186,7 +246,7 @@ var productionsTable = ProdTab{
 		String: `NoteSymbol : symbol	<<  >>`,
 		Id:         "NoteSymbol",
 		NTType:     9,
-		Index:      16,
+		Index:      22,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -196,7 +256,7 @@ var productionsTable = ProdTab{
 		String: `NoteSymbol : rest	<<  >>`,
 		Id:         "NoteSymbol",
 		NTType:     9,
-		Index:      17,
+		Index:      23,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -206,7 +266,7 @@ var productionsTable = ProdTab{
 		String: `PropertyList : empty	<< ast.PropertyList(nil), nil >>`,
 		Id:         "PropertyList",
 		NTType:     10,
-		Index:      18,
+		Index:      24,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.PropertyList(nil), nil
@@ -216,7 +276,7 @@ var productionsTable = ProdTab{
 		String: `PropertyList : Property PropertyList	<< ast.NewPropertyList(X[0].(*token.Token), X[1]) >>`,
 		Id:         "PropertyList",
 		NTType:     10,
-		Index:      19,
+		Index:      25,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewPropertyList(X[0].(*token.Token), X[1])
@@ -226,7 +286,7 @@ var productionsTable = ProdTab{
 		String: `Property : propSharp	<<  >>`,
 		Id:         "Property",
 		NTType:     11,
-		Index:      20,
+		Index:      26,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -236,7 +296,27 @@ var productionsTable = ProdTab{
 		String: `Property : propFlat	<<  >>`,
 		Id:         "Property",
 		NTType:     11,
-		Index:      21,
+		Index:      27,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Property : propOctaveUp	<<  >>`,
+		Id:         "Property",
+		NTType:     11,
+		Index:      28,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Property : propOctaveDown	<<  >>`,
+		Id:         "Property",
+		NTType:     11,
+		Index:      29,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -246,7 +326,7 @@ var productionsTable = ProdTab{
 		String: `Property : propStaccato	<<  >>`,
 		Id:         "Property",
 		NTType:     11,
-		Index:      22,
+		Index:      30,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -256,7 +336,7 @@ var productionsTable = ProdTab{
 		String: `Property : propAccent	<<  >>`,
 		Id:         "Property",
 		NTType:     11,
-		Index:      23,
+		Index:      31,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -266,7 +346,7 @@ var productionsTable = ProdTab{
 		String: `Property : propMarcato	<<  >>`,
 		Id:         "Property",
 		NTType:     11,
-		Index:      24,
+		Index:      32,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -276,7 +356,7 @@ var productionsTable = ProdTab{
 		String: `Property : propGhost	<<  >>`,
 		Id:         "Property",
 		NTType:     11,
-		Index:      25,
+		Index:      33,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -286,7 +366,7 @@ var productionsTable = ProdTab{
 		String: `Property : uint	<<  >>`,
 		Id:         "Property",
 		NTType:     11,
-		Index:      26,
+		Index:      34,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -296,7 +376,7 @@ var productionsTable = ProdTab{
 		String: `Property : propDot	<<  >>`,
 		Id:         "Property",
 		NTType:     11,
-		Index:      27,
+		Index:      35,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -306,7 +386,7 @@ var productionsTable = ProdTab{
 		String: `Property : propTuplet	<<  >>`,
 		Id:         "Property",
 		NTType:     11,
-		Index:      28,
+		Index:      36,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -316,77 +396,177 @@ var productionsTable = ProdTab{
 		String: `Property : propLetRing	<<  >>`,
 		Id:         "Property",
 		NTType:     11,
-		Index:      29,
+		Index:      37,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Command : cmdAssign symbol uint	<< ast.NewCmdAssign(X[0].(*token.Token).Pos, []rune(string(X[1].(*token.Token).Lit))[0], ast.Must(X[2].(*token.Token).Int64Value())) >>`,
-		Id:         "Command",
+		String: `Property : propTie	<<  >>`,
+		Id:         "Property",
+		NTType:     11,
+		Index:      38,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Property : propAftertouch	<<  >>`,
+		Id:         "Property",
+		NTType:     11,
+		Index:      39,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Property : propChance	<<  >>`,
+		Id:         "Property",
+		NTType:     11,
+		Index:      40,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Repeat : cmdRepeat uint RepeatTerminator DeclList cmdEnd	<< ast.NewRepeat(X[0].(*token.Token).Pos, ast.Must(X[1].(*token.Token).Int64Value()), X[3].(ast.NodeList)) >>`,
+		Id:         "Repeat",
 		NTType:     12,
-		Index:      30,
+		Index:      41,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewRepeat(X[0].(*token.Token).Pos, ast.Must(X[1].(*token.Token).Int64Value()), X[3].(ast.NodeList))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdAssign symbol uint	<< ast.NewCmdAssign(X[0].(*token.Token).Pos, string(X[1].(*token.Token).Lit), ast.Must(X[2].(*token.Token).Int64Value())) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      42,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewCmdAssign(X[0].(*token.Token).Pos, []rune(string(X[1].(*token.Token).Lit))[0], ast.Must(X[2].(*token.Token).Int64Value()))
+			return ast.NewCmdAssign(X[0].(*token.Token).Pos, string(X[1].(*token.Token).Lit), ast.Must(X[2].(*token.Token).Int64Value()))
 		},
 	},
 	ProdTabEntry{
-		String: `Command : cmdPlay	<< ast.NewCmdPlay(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":play "):])) >>`,
+		String: `Command : cmdKit	<< ast.NewCmdKit(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":kit "):])) >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      31,
+		NTType:     13,
+		Index:      43,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewCmdPlay(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":play "):]))
+			return ast.NewCmdKit(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":kit "):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdPlay	<< ast.NewCmdPlay(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":play"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      44,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdPlay(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":play"):]))
 		},
 	},
 	ProdTabEntry{
 		String: `Command : cmdTempo uint	<< ast.NewCmdTempo(ast.Must(X[1].(*token.Token).Int64Value())) >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      32,
+		NTType:     13,
+		Index:      45,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmdTempo(ast.Must(X[1].(*token.Token).Int64Value()))
 		},
 	},
 	ProdTabEntry{
-		String: `Command : cmdKey	<< ast.NewCmdKey(string(X[0].(*token.Token).Lit[len(":key "):])) >>`,
+		String: `Command : cmdTempo uint arrow uint	<< ast.NewCmdTempoRamp(ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), 1) >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      33,
+		NTType:     13,
+		Index:      46,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdTempoRamp(ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), 1)
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdTempo uint arrow uint uint	<< ast.NewCmdTempoRamp(ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), ast.Must(X[4].(*token.Token).Int64Value())) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      47,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdTempoRamp(ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), ast.Must(X[4].(*token.Token).Int64Value()))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdKey	<< ast.NewCmdKey(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":key"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      48,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewCmdKey(string(X[0].(*token.Token).Lit[len(":key "):]))
+			return ast.NewCmdKey(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":key"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdScale	<< ast.NewCmdScale(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":scale"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      49,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdScale(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":scale"):]))
 		},
 	},
 	ProdTabEntry{
 		String: `Command : cmdTime uint uint	<< ast.NewCmdTime(ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value())) >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      34,
+		NTType:     13,
+		Index:      50,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmdTime(ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()))
 		},
 	},
+	ProdTabEntry{
+		String: `Command : cmdMeter uint uint	<< ast.NewCmdMeter(ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value())) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      51,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdMeter(ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()))
+		},
+	},
 	ProdTabEntry{
 		String: `Command : cmdVelocity uint	<< ast.NewCmdVelocity(ast.Must(X[1].(*token.Token).Int64Value())) >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      35,
+		NTType:     13,
+		Index:      52,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmdVelocity(ast.Must(X[1].(*token.Token).Int64Value()))
 		},
 	},
+	ProdTabEntry{
+		String: `Command : cmdOctave	<< ast.NewCmdOctave(string(X[0].(*token.Token).Lit[len(":octave"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      53,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdOctave(string(X[0].(*token.Token).Lit[len(":octave"):]))
+		},
+	},
 	ProdTabEntry{
 		String: `Command : cmdChannel uint	<< ast.NewCmdChannel(ast.Must(X[1].(*token.Token).Int64Value())) >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      36,
+		NTType:     13,
+		Index:      54,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmdChannel(ast.Must(X[1].(*token.Token).Int64Value()))
@@ -395,38 +575,168 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Command : cmdVoice uint	<< ast.NewCmdVoice(ast.Must(X[1].(*token.Token).Int64Value())) >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      37,
+		NTType:     13,
+		Index:      55,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewCmdVoice(ast.Must(X[1].(*token.Token).Int64Value()))
 		},
 	},
 	ProdTabEntry{
-		String: `Command : cmdProgram uint	<< ast.NewCmdProgram(ast.Must(X[1].(*token.Token).Int64Value())) >>`,
+		String: `Command : cmdProgram	<< ast.NewCmdProgram(string(X[0].(*token.Token).Lit[len(":program"):])) >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      38,
+		NTType:     13,
+		Index:      56,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdProgram(string(X[0].(*token.Token).Lit[len(":program"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdProgramName string	<< ast.NewCmdProgramName(string(X[1].(*token.Token).Lit)) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      57,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewCmdProgram(ast.Must(X[1].(*token.Token).Int64Value()))
+			return ast.NewCmdProgramName(string(X[1].(*token.Token).Lit))
 		},
 	},
 	ProdTabEntry{
-		String: `Command : cmdControl uint uint	<< ast.NewCmdControl(ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value())) >>`,
+		String: `Command : cmdControl	<< ast.NewCmdControl(string(X[0].(*token.Token).Lit[len(":control"):])) >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      39,
-		NumSymbols: 3,
+		NTType:     13,
+		Index:      58,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdControl(string(X[0].(*token.Token).Lit[len(":control"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdControlRamp	<< ast.NewCmdControlRamp(string(X[0].(*token.Token).Lit[len(":control"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      59,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdControlRamp(string(X[0].(*token.Token).Lit[len(":control"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdBend	<< ast.NewCmdBend(string(X[0].(*token.Token).Lit[len(":bend"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      60,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdBend(string(X[0].(*token.Token).Lit[len(":bend"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdPressure uint	<< ast.NewCmdPressure(ast.Must(X[1].(*token.Token).Int64Value())) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      61,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdPressure(ast.Must(X[1].(*token.Token).Int64Value()))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdSysex	<< ast.NewCmdSysex(string(X[0].(*token.Token).Lit[len(":sysex"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      62,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdSysex(string(X[0].(*token.Token).Lit[len(":sysex"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdSysexFile string	<< ast.NewCmdSysexFile(X[0].(*token.Token).Pos, string(X[1].(*token.Token).Lit)) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      63,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdSysexFile(X[0].(*token.Token).Pos, string(X[1].(*token.Token).Lit))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdRPN uint uint uint	<< ast.NewCmdRPN(ast.RPN, ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), -1) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      64,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdRPN(ast.RPN, ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), -1)
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdRPN uint uint uint uint	<< ast.NewCmdRPN(ast.RPN, ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), ast.Must(X[4].(*token.Token).Int64Value())) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      65,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdRPN(ast.RPN, ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), ast.Must(X[4].(*token.Token).Int64Value()))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdNRPN uint uint uint	<< ast.NewCmdRPN(ast.NRPN, ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), -1) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      66,
+		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewCmdControl(ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()))
+			return ast.NewCmdRPN(ast.NRPN, ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), -1)
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdNRPN uint uint uint uint	<< ast.NewCmdRPN(ast.NRPN, ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), ast.Must(X[4].(*token.Token).Int64Value())) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      67,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdRPN(ast.NRPN, ast.Must(X[1].(*token.Token).Int64Value()), ast.Must(X[2].(*token.Token).Int64Value()), ast.Must(X[3].(*token.Token).Int64Value()), ast.Must(X[4].(*token.Token).Int64Value()))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdSwing	<< ast.NewCmdSwing(string(X[0].(*token.Token).Lit[len(":swing"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      68,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdSwing(string(X[0].(*token.Token).Lit[len(":swing"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdGroove	<< ast.NewCmdGroove(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":groove"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      69,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdGroove(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":groove"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdHumanize	<< ast.NewCmdHumanize(string(X[0].(*token.Token).Lit[len(":humanize"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      70,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdHumanize(string(X[0].(*token.Token).Lit[len(":humanize"):]))
 		},
 	},
 	ProdTabEntry{
 		String: `Command : cmdStart	<< ast.CmdStart{}, nil >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      40,
+		NTType:     13,
+		Index:      71,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.CmdStart{}, nil
@@ -435,21 +745,111 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Command : cmdStop	<< ast.CmdStop{}, nil >>`,
 		Id:         "Command",
-		NTType:     12,
-		Index:      41,
+		NTType:     13,
+		Index:      72,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.CmdStop{}, nil
 		},
 	},
+	ProdTabEntry{
+		String: `Command : cmdPickup	<< ast.CmdPickup{Pos: X[0].(*token.Token).Pos}, nil >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      73,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.CmdPickup{Pos: X[0].(*token.Token).Pos}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdStaff	<< ast.NewCmdStaff(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":staff"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      74,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdStaff(X[0].(*token.Token).Pos, string(X[0].(*token.Token).Lit[len(":staff"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdInclude string	<< ast.NewCmdInclude(X[0].(*token.Token).Pos, string(X[1].(*token.Token).Lit)) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      75,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdInclude(X[0].(*token.Token).Pos, string(X[1].(*token.Token).Lit))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdVolta uint	<< ast.NewCmdVolta(X[0].(*token.Token).Pos, ast.Must(X[1].(*token.Token).Int64Value())) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      76,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdVolta(X[0].(*token.Token).Pos, ast.Must(X[1].(*token.Token).Int64Value()))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdDyn	<< ast.NewCmdDyn(string(X[0].(*token.Token).Lit[len(":dyn"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      77,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdDyn(string(X[0].(*token.Token).Lit[len(":dyn"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdDynamics	<< ast.NewCmdDynamics(string(X[0].(*token.Token).Lit[len(":dynamics"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      78,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdDynamics(string(X[0].(*token.Token).Lit[len(":dynamics"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdCresc	<< ast.NewCmdHairpin(ast.Crescendo, string(X[0].(*token.Token).Lit[len(":cresc"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      79,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdHairpin(ast.Crescendo, string(X[0].(*token.Token).Lit[len(":cresc"):]))
+		},
+	},
+	ProdTabEntry{
+		String: `Command : cmdDim	<< ast.NewCmdHairpin(ast.Diminuendo, string(X[0].(*token.Token).Lit[len(":dim"):])) >>`,
+		Id:         "Command",
+		NTType:     13,
+		Index:      80,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCmdHairpin(ast.Diminuendo, string(X[0].(*token.Token).Lit[len(":dim"):]))
+		},
+	},
 	ProdTabEntry{
 		String: `Comment : blockComment	<< ast.NewBlockComment(string(X[0].(*token.Token).Lit)), nil >>`,
 		Id:         "Comment",
-		NTType:     13,
-		Index:      42,
+		NTType:     14,
+		Index:      81,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewBlockComment(string(X[0].(*token.Token).Lit)), nil
 		},
 	},
+	ProdTabEntry{
+		String: `Comment : lineComment	<< ast.NewLineComment(string(X[0].(*token.Token).Lit)), nil >>`,
+		Id:         "Comment",
+		NTType:     14,
+		Index:      82,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewLineComment(string(X[0].(*token.Token).Lit)), nil
+		},
+	},
 }
@@ -140,14 +140,20 @@ var TokMap = TokenMap{
 		"␚",
 		"empty",
 		"terminator",
+		"lineComment",
 		"cmdBar",
 		"cmdEnd",
+		"chord",
+		"pitch",
+		"degree",
 		"bracketBegin",
 		"bracketEnd",
 		"symbol",
 		"rest",
 		"propSharp",
 		"propFlat",
+		"propOctaveUp",
+		"propOctaveDown",
 		"propStaccato",
 		"propAccent",
 		"propMarcato",
@@ -156,54 +162,118 @@ var TokMap = TokenMap{
 		"propDot",
 		"propTuplet",
 		"propLetRing",
+		"propTie",
+		"propAftertouch",
+		"propChance",
+		"cmdRepeat",
 		"cmdAssign",
+		"cmdKit",
 		"cmdPlay",
 		"cmdTempo",
+		"arrow",
 		"cmdKey",
+		"cmdScale",
 		"cmdTime",
+		"cmdMeter",
 		"cmdVelocity",
+		"cmdOctave",
 		"cmdChannel",
 		"cmdVoice",
 		"cmdProgram",
+		"cmdProgramName",
+		"string",
 		"cmdControl",
+		"cmdControlRamp",
+		"cmdBend",
+		"cmdPressure",
+		"cmdSysex",
+		"cmdSysexFile",
+		"cmdRPN",
+		"cmdNRPN",
+		"cmdSwing",
+		"cmdGroove",
+		"cmdHumanize",
 		"cmdStart",
 		"cmdStop",
+		"cmdPickup",
+		"cmdStaff",
+		"cmdInclude",
+		"cmdVolta",
+		"cmdDyn",
+		"cmdDynamics",
+		"cmdCresc",
+		"cmdDim",
 		"blockComment",
 	},
 
 	idMap: map[string]Type{
-		"INVALID":      0,
-		"␚":            1,
-		"empty":        2,
-		"terminator":   3,
-		"cmdBar":       4,
-		"cmdEnd":       5,
-		"bracketBegin": 6,
-		"bracketEnd":   7,
-		"symbol":       8,
-		"rest":         9,
-		"propSharp":    10,
-		"propFlat":     11,
-		"propStaccato": 12,
-		"propAccent":   13,
-		"propMarcato":  14,
-		"propGhost":    15,
-		"uint":         16,
-		"propDot":      17,
-		"propTuplet":   18,
-		"propLetRing":  19,
-		"cmdAssign":    20,
-		"cmdPlay":      21,
-		"cmdTempo":     22,
-		"cmdKey":       23,
-		"cmdTime":      24,
-		"cmdVelocity":  25,
-		"cmdChannel":   26,
-		"cmdVoice":     27,
-		"cmdProgram":   28,
-		"cmdControl":   29,
-		"cmdStart":     30,
-		"cmdStop":      31,
-		"blockComment": 32,
+		"INVALID":        0,
+		"␚":              1,
+		"empty":          2,
+		"terminator":     3,
+		"lineComment":    4,
+		"cmdBar":         5,
+		"cmdEnd":         6,
+		"chord":          7,
+		"pitch":          8,
+		"degree":         9,
+		"bracketBegin":   10,
+		"bracketEnd":     11,
+		"symbol":         12,
+		"rest":           13,
+		"propSharp":      14,
+		"propFlat":       15,
+		"propOctaveUp":   16,
+		"propOctaveDown": 17,
+		"propStaccato":   18,
+		"propAccent":     19,
+		"propMarcato":    20,
+		"propGhost":      21,
+		"uint":           22,
+		"propDot":        23,
+		"propTuplet":     24,
+		"propLetRing":    25,
+		"propTie":        26,
+		"propAftertouch": 27,
+		"propChance":     28,
+		"cmdRepeat":      29,
+		"cmdAssign":      30,
+		"cmdKit":         31,
+		"cmdPlay":        32,
+		"cmdTempo":       33,
+		"arrow":          34,
+		"cmdKey":         35,
+		"cmdScale":       36,
+		"cmdTime":        37,
+		"cmdMeter":       38,
+		"cmdVelocity":    39,
+		"cmdOctave":      40,
+		"cmdChannel":     41,
+		"cmdVoice":       42,
+		"cmdProgram":     43,
+		"cmdProgramName": 44,
+		"string":         45,
+		"cmdControl":     46,
+		"cmdControlRamp": 47,
+		"cmdBend":        48,
+		"cmdPressure":    49,
+		"cmdSysex":       50,
+		"cmdSysexFile":   51,
+		"cmdRPN":         52,
+		"cmdNRPN":        53,
+		"cmdSwing":       54,
+		"cmdGroove":      55,
+		"cmdHumanize":    56,
+		"cmdStart":       57,
+		"cmdStop":        58,
+		"cmdPickup":      59,
+		"cmdStaff":       60,
+		"cmdInclude":     61,
+		"cmdVolta":       62,
+		"cmdDyn":         63,
+		"cmdDynamics":    64,
+		"cmdCresc":       65,
+		"cmdDim":         66,
+		"blockComment":   67,
 	},
 }
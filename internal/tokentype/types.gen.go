@@ -8,35 +8,70 @@ import (
 
 // Language tokens.
 var (
-	BlockComment = token.TokMap.Type("blockComment")
-	BracketBegin = token.TokMap.Type("bracketBegin")
-	BracketEnd   = token.TokMap.Type("bracketEnd")
-	CmdAssign    = token.TokMap.Type("cmdAssign")
-	CmdBar       = token.TokMap.Type("cmdBar")
-	CmdChannel   = token.TokMap.Type("cmdChannel")
-	CmdControl   = token.TokMap.Type("cmdControl")
-	CmdEnd       = token.TokMap.Type("cmdEnd")
-	CmdKey       = token.TokMap.Type("cmdKey")
-	CmdPlay      = token.TokMap.Type("cmdPlay")
-	CmdProgram   = token.TokMap.Type("cmdProgram")
-	CmdStart     = token.TokMap.Type("cmdStart")
-	CmdStop      = token.TokMap.Type("cmdStop")
-	CmdTempo     = token.TokMap.Type("cmdTempo")
-	CmdTime      = token.TokMap.Type("cmdTime")
-	CmdVelocity  = token.TokMap.Type("cmdVelocity")
-	CmdVoice     = token.TokMap.Type("cmdVoice")
-	Empty        = token.TokMap.Type("empty")
-	PropAccent   = token.TokMap.Type("propAccent")
-	PropDot      = token.TokMap.Type("propDot")
-	PropFlat     = token.TokMap.Type("propFlat")
-	PropGhost    = token.TokMap.Type("propGhost")
-	PropLetRing  = token.TokMap.Type("propLetRing")
-	PropMarcato  = token.TokMap.Type("propMarcato")
-	PropSharp    = token.TokMap.Type("propSharp")
-	PropStaccato = token.TokMap.Type("propStaccato")
-	PropTuplet   = token.TokMap.Type("propTuplet")
-	Rest         = token.TokMap.Type("rest")
-	Symbol       = token.TokMap.Type("symbol")
-	Terminator   = token.TokMap.Type("terminator")
-	Uint         = token.TokMap.Type("uint")
+	Arrow          = token.TokMap.Type("arrow")
+	BlockComment   = token.TokMap.Type("blockComment")
+	BracketBegin   = token.TokMap.Type("bracketBegin")
+	BracketEnd     = token.TokMap.Type("bracketEnd")
+	Chord          = token.TokMap.Type("chord")
+	CmdAssign      = token.TokMap.Type("cmdAssign")
+	CmdBar         = token.TokMap.Type("cmdBar")
+	CmdBend        = token.TokMap.Type("cmdBend")
+	CmdChannel     = token.TokMap.Type("cmdChannel")
+	CmdControl     = token.TokMap.Type("cmdControl")
+	CmdControlRamp = token.TokMap.Type("cmdControlRamp")
+	CmdCresc       = token.TokMap.Type("cmdCresc")
+	CmdDim         = token.TokMap.Type("cmdDim")
+	CmdDyn         = token.TokMap.Type("cmdDyn")
+	CmdDynamics    = token.TokMap.Type("cmdDynamics")
+	CmdEnd         = token.TokMap.Type("cmdEnd")
+	CmdGroove      = token.TokMap.Type("cmdGroove")
+	CmdHumanize    = token.TokMap.Type("cmdHumanize")
+	CmdInclude     = token.TokMap.Type("cmdInclude")
+	CmdKey         = token.TokMap.Type("cmdKey")
+	CmdKit         = token.TokMap.Type("cmdKit")
+	CmdMeter       = token.TokMap.Type("cmdMeter")
+	CmdNrpn        = token.TokMap.Type("cmdNRPN")
+	CmdOctave      = token.TokMap.Type("cmdOctave")
+	CmdPickup      = token.TokMap.Type("cmdPickup")
+	CmdPlay        = token.TokMap.Type("cmdPlay")
+	CmdPressure    = token.TokMap.Type("cmdPressure")
+	CmdProgram     = token.TokMap.Type("cmdProgram")
+	CmdProgramName = token.TokMap.Type("cmdProgramName")
+	CmdRepeat      = token.TokMap.Type("cmdRepeat")
+	CmdRpn         = token.TokMap.Type("cmdRPN")
+	CmdScale       = token.TokMap.Type("cmdScale")
+	CmdStaff       = token.TokMap.Type("cmdStaff")
+	CmdStart       = token.TokMap.Type("cmdStart")
+	CmdStop        = token.TokMap.Type("cmdStop")
+	CmdSwing       = token.TokMap.Type("cmdSwing")
+	CmdSysex       = token.TokMap.Type("cmdSysex")
+	CmdSysexFile   = token.TokMap.Type("cmdSysexFile")
+	CmdTempo       = token.TokMap.Type("cmdTempo")
+	CmdTime        = token.TokMap.Type("cmdTime")
+	CmdVelocity    = token.TokMap.Type("cmdVelocity")
+	CmdVoice       = token.TokMap.Type("cmdVoice")
+	CmdVolta       = token.TokMap.Type("cmdVolta")
+	Degree         = token.TokMap.Type("degree")
+	Empty          = token.TokMap.Type("empty")
+	LineComment    = token.TokMap.Type("lineComment")
+	Pitch          = token.TokMap.Type("pitch")
+	PropAccent     = token.TokMap.Type("propAccent")
+	PropAftertouch = token.TokMap.Type("propAftertouch")
+	PropChance     = token.TokMap.Type("propChance")
+	PropDot        = token.TokMap.Type("propDot")
+	PropFlat       = token.TokMap.Type("propFlat")
+	PropGhost      = token.TokMap.Type("propGhost")
+	PropLetRing    = token.TokMap.Type("propLetRing")
+	PropMarcato    = token.TokMap.Type("propMarcato")
+	PropOctaveDown = token.TokMap.Type("propOctaveDown")
+	PropOctaveUp   = token.TokMap.Type("propOctaveUp")
+	PropSharp      = token.TokMap.Type("propSharp")
+	PropStaccato   = token.TokMap.Type("propStaccato")
+	PropTie        = token.TokMap.Type("propTie")
+	PropTuplet     = token.TokMap.Type("propTuplet")
+	Rest           = token.TokMap.Type("rest")
+	String         = token.TokMap.Type("string")
+	Symbol         = token.TokMap.Type("symbol")
+	Terminator     = token.TokMap.Type("terminator")
+	Uint           = token.TokMap.Type("uint")
 )
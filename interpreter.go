@@ -1,16 +1,23 @@
 package balafon
 
 import (
-	"cmp"
+	"errors"
 	"fmt"
+	"maps"
 	"math"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
 	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/mgnsk/balafon/internal/ast"
 	"github.com/mgnsk/balafon/internal/constants"
+	"github.com/mgnsk/balafon/internal/gm"
 	"github.com/mgnsk/balafon/internal/parser/lexer"
 	"github.com/mgnsk/balafon/internal/parser/parser"
+	"github.com/mgnsk/balafon/internal/parser/token"
 	"gitlab.com/gomidi/midi/v2"
 	"gitlab.com/gomidi/midi/v2/smf"
 )
@@ -21,26 +28,49 @@ type Interpreter struct {
 	barBuffer []*Bar
 
 	velocity int
+	octave   int
 	channel  Channel
 	channels []Channel
 	voice    uint8
 
-	pos     uint32
-	timesig [2]uint8
+	pos               uint32
+	timesig           [2]uint8
+	tempoResolution   uint32
+	controlResolution uint32
+
+	keymap   *keyMap
+	bars     map[string]*Bar
+	scales   map[Channel]keySig
+	grooves  map[Channel]groove
+	humanize map[Channel]*humanizer
+	meters   map[Channel][2]uint8 // time signatures of channels with their own meter
+	dynamics map[string]uint8
+	custom   map[string][]int // custom scales
+	files    []string         // absolute paths of the files being parsed
+	linter   *linter
+	straight bool           // whether to keep the notated positions and velocities for notation
+	staff    bool           // whether to split top level note lists into bars
+	pickup   *ast.CmdPickup // the pickup command of the next bar with notes
+
+	rand  *rand.Rand     // the random source of notes played by chance
+	plays map[string]int // the number of evaluations of play commands by bar name, interval and position
+}
 
-	keymap *keyMap
-	bars   map[string]*Bar
-	scales map[Channel]string
+// Warnings returns the lint warnings of the evaluated input.
+func (it *Interpreter) Warnings() []*Warning {
+	return it.linter.warnings
 }
 
 // EvalFile evaluates a file.
-func (it *Interpreter) EvalFile(filepath string) error {
-	scanner, err := lexer.NewLexerFile(filepath)
+func (it *Interpreter) EvalFile(name string) error {
+	bars, err := it.parseFile(name)
 	if err != nil {
 		return err
 	}
 
-	return it.eval(scanner)
+	it.barBuffer = append(it.barBuffer, bars...)
+
+	return nil
 }
 
 // EvalString evaluates the string input.
@@ -54,24 +84,85 @@ func (it *Interpreter) Eval(input []byte) error {
 }
 
 func (it *Interpreter) eval(scanner parser.Scanner) error {
-	res, err := it.parser.Parse(scanner)
+	bars, err := it.parseSource(scanner)
 	if err != nil {
 		return err
 	}
 
+	it.barBuffer = append(it.barBuffer, bars...)
+
+	return nil
+}
+
+func (it *Interpreter) parseSource(scanner parser.Scanner) ([]*Bar, error) {
+	res, err := it.parser.Parse(scanner)
+	if err != nil {
+		return nil, err
+	}
+
 	declList, ok := res.(ast.NodeList)
 	if !ok {
 		panic("invalid input, expected ast.NodeList")
 	}
 
-	bars, err := it.parse(declList)
+	return it.parse(declList)
+}
+
+// parseFile parses a file. Files being parsed are tracked to detect include cycles.
+func (it *Interpreter) parseFile(name string) ([]*Bar, error) {
+	absPath, err := filepath.Abs(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(it.files, absPath) {
+		return nil, fmt.Errorf("include cycle: '%s'", name)
+	}
+
+	scanner, err := lexer.NewLexerFile(name)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	it.barBuffer = append(it.barBuffer, bars...)
+	it.files = append(it.files, absPath)
+	defer func() {
+		it.files = it.files[:len(it.files)-1]
+	}()
 
-	return nil
+	return it.parseSource(scanner)
+}
+
+// resolvePath resolves a path relative to the file containing the command at pos.
+func resolvePath(pos token.Pos, name string) string {
+	if !filepath.IsAbs(name) {
+		if src, ok := pos.Context.(token.Sourcer); ok {
+			return filepath.Join(filepath.Dir(src.Source()), name)
+		}
+	}
+
+	return name
+}
+
+// include parses a file relative to the file containing the include command.
+func (it *Interpreter) include(cmd ast.CmdInclude) ([]*Bar, error) {
+	bars, err := it.parseFile(resolvePath(cmd.Pos, cmd.Path))
+	if err != nil {
+		var (
+			perr *ParseError
+			eerr *EvalError
+		)
+		if errors.As(err, &perr) || errors.As(err, &eerr) {
+			// The error already points into the included file.
+			return nil, err
+		}
+
+		return nil, &EvalError{
+			Err: err,
+			Pos: cmd.Pos,
+		}
+	}
+
+	return bars, nil
 }
 
 // Flush flushes the parsed bar queue and resets the interpreter.
@@ -81,17 +172,56 @@ func (it *Interpreter) Flush() []*Bar {
 
 		buf          []Event
 		playableBars = make([]*Bar, 0, len(it.barBuffer))
+
+		ramp     *tempoRamp
+		rampBar  int
+		rampNext *tempoRamp
+
+		hairpins     []*activeHairpin
+		hairpinsNext []*hairpin
+
+		controlRamps []*controlRamp
+
+		splitPlayed = map[*ast.Note]bool{} // chance decisions of notes split at bar lines
 	)
 
 	for _, bar := range it.barBuffer {
 		timesig = bar.timeSig
 
+		it.playChances(bar, splitPlayed)
+
+		if bar.tempoRamp != nil {
+			rampNext = bar.tempoRamp
+		}
+
+		if bar.hairpin != nil {
+			hairpinsNext = append(hairpinsNext, bar.hairpin)
+		}
+
+		controlRamps = append(controlRamps, bar.ramps...)
+
 		// Defer virtual bars and concatenate them forward.
 		if bar.IsZeroDuration() {
 			buf = append(buf, bar.Events...)
 			continue
 		}
 
+		if rampNext != nil {
+			ramp, rampBar, rampNext = rampNext, 0, nil
+		}
+
+		for _, h := range hairpinsNext {
+			// A new hairpin replaces the active hairpin on the channel.
+			hairpins = slices.DeleteFunc(hairpins, func(active *activeHairpin) bool {
+				return active.track == h.track
+			})
+			hairpins = append(hairpins, &activeHairpin{
+				hairpin: h,
+				prev:    h.from,
+			})
+		}
+		hairpinsNext = hairpinsNext[:0]
+
 		{
 			barEvs := make([]Event, 0, len(buf)+len(bar.Events))
 			add := func(ev Event) {
@@ -134,12 +264,34 @@ func (it *Interpreter) Flush() []*Bar {
 					Message: smf.MetaMeter(bar.timeSig[0], bar.timeSig[1]),
 				})
 			}
+			if ramp != nil {
+				for _, ev := range it.expandTempoRamp(ramp, rampBar, bar.Cap()) {
+					add(ev)
+				}
+				rampBar++
+				if rampBar == ramp.bars {
+					ramp = nil
+				}
+			}
+			for _, r := range controlRamps {
+				for _, ev := range it.expandControlRamp(r, bar.Cap()) {
+					add(ev)
+				}
+			}
+			controlRamps = controlRamps[:0]
 			for _, ev := range bar.Events {
 				add(ev)
 			}
 			bar.Events = barEvs
 		}
 
+		for _, h := range hairpins {
+			it.expandHairpin(bar, h)
+		}
+		hairpins = slices.DeleteFunc(hairpins, func(h *activeHairpin) bool {
+			return h.bar == h.bars
+		})
+
 		buf = buf[:0]
 		playableBars = append(playableBars, bar)
 	}
@@ -155,26 +307,310 @@ func (it *Interpreter) Flush() []*Bar {
 	it.barBuffer = it.barBuffer[:0]
 
 	for _, bar := range playableBars {
-		slices.SortStableFunc(bar.Events, func(a, b Event) int {
-			return cmp.Compare(a.Pos, b.Pos)
-		})
+		sortEvents(bar.Events)
+	}
+
+	resolveTies(playableBars)
+
+	if !it.straight {
+		for _, bar := range playableBars {
+			if len(bar.grooves) == 0 && len(bar.humanize) == 0 {
+				continue
+			}
+			for track, g := range bar.grooves {
+				g.apply(bar.Events, track, bar.trackCap(track))
+			}
+			for track, h := range bar.humanize {
+				h.apply(bar.Events, track, bar.trackCap(track))
+			}
+			sortEvents(bar.Events)
+		}
 	}
 
 	return playableBars
 }
 
+// SetSeed sets the seed of the random numbers that decide which notes are played by chance.
+func (it *Interpreter) SetSeed(seed uint64) {
+	it.rand = newRand(seed)
+}
+
+func newRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// SetTempoResolution sets the interval in ticks between tempo events of a tempo ramp.
+// Zero sets the default resolution.
+func (it *Interpreter) SetTempoResolution(ticks uint32) {
+	if ticks == 0 {
+		ticks = uint32(constants.DefaultTempoResolution)
+	}
+	it.tempoResolution = ticks
+}
+
+// restKey is the note and position of a rest replacing a note played by chance.
+type restKey struct {
+	note *ast.Note
+	pos  uint32
+}
+
+// playChances decides which notes of the bar that play by chance are played.
+// The notes that are not played are replaced with rests.
+// The decisions of notes split at bar lines are shared across bars in splitPlayed.
+func (it *Interpreter) playChances(bar *Bar, splitPlayed map[*ast.Note]bool) {
+	var (
+		played  = map[*ast.Note]bool{}
+		rested  = map[restKey]bool{}
+		removed []int
+		rests   []Event
+	)
+
+	for i, ev := range bar.Events {
+		if ev.Note == nil || ev.Note.IsPause() {
+			// A rest is silent either way.
+			continue
+		}
+
+		chance, ok := ev.Note.Props.Chance()
+		if !ok {
+			continue
+		}
+
+		// The keys of a chord and the pieces of a split note are played together.
+		decisions, note := played, ev.Note
+		if src, ok := bar.split[ev.Note]; ok {
+			decisions, note = splitPlayed, src
+		}
+
+		isPlayed, ok := decisions[note]
+		if !ok {
+			isPlayed = it.rand.IntN(100) < chance
+			decisions[note] = isPlayed
+		}
+
+		if isPlayed {
+			continue
+		}
+
+		if k := (restKey{note, ev.Pos}); !rested[k] {
+			rested[k] = true
+
+			rest := *ev.Note
+			rest.Name = "-"
+			rest.Chord = nil
+			rest.Pitch = nil
+			rest.Degree = 0
+
+			rests = append(rests, Event{
+				Track:    ev.Track,
+				Voice:    ev.Voice,
+				Note:     &rest,
+				Pos:      ev.Pos,
+				Duration: ev.Duration,
+			})
+		}
+
+		removed = append(removed, i)
+		if off := findNoteOff(bar.Events, i); off != -1 {
+			removed = append(removed, off)
+		}
+
+		var ch, key uint8
+		ev.Message.GetNoteStart(&ch, &key, nil)
+		for j := i + 1; j < len(bar.Events) && bar.Events[j].Pos == ev.Pos; j++ {
+			var atCh, atKey uint8
+			if bar.Events[j].Message.GetPolyAfterTouch(&atCh, &atKey, nil) && atCh == ch && atKey == key {
+				removed = append(removed, j)
+			}
+		}
+	}
+
+	if len(removed) == 0 && len(rests) == 0 {
+		return
+	}
+
+	events := make([]Event, 0, len(bar.Events)-len(removed)+len(rests))
+	for i, ev := range bar.Events {
+		if !slices.Contains(removed, i) {
+			events = append(events, ev)
+		}
+	}
+	bar.Events = append(events, rests...)
+}
+
+// expandTempoRamp creates the tempo events for the n-th bar of a tempo ramp.
+// The starting tempo event is emitted by the ramp command itself.
+func (it *Interpreter) expandTempoRamp(ramp *tempoRamp, n int, barCap uint32) []Event {
+	var events []Event
+
+	total := float64(ramp.bars) * float64(barCap)
+
+	start := uint32(0)
+	if n == 0 {
+		start = it.tempoResolution
+	}
+
+	for pos := start; pos < barCap; pos += it.tempoResolution {
+		progress := (float64(n)*float64(barCap) + float64(pos)) / total
+		events = append(events, Event{
+			Pos:     pos,
+			Message: smf.MetaTempo(ramp.from + (ramp.to-ramp.from)*progress),
+		})
+	}
+
+	if n == ramp.bars-1 {
+		events = append(events, Event{
+			Pos:     barCap,
+			Message: smf.MetaTempo(ramp.to),
+		})
+	}
+
+	return events
+}
+
+// SetControlResolution sets the interval in ticks between control change events of a control ramp or hairpin.
+// Zero sets the default resolution.
+func (it *Interpreter) SetControlResolution(ticks uint32) {
+	if ticks == 0 {
+		ticks = uint32(constants.DefaultControlResolution)
+	}
+	it.controlResolution = ticks
+}
+
+// curveSteepness is the steepness of the exponential and logarithmic automation curves.
+const curveSteepness = 4.0
+
+// curveValue interpolates between from and to along a curve. Progress is in range [0, 1].
+func curveValue(curve string, from, to int, progress float64) int {
+	switch curve {
+	case ast.CurveExp:
+		progress = (math.Exp(curveSteepness*progress) - 1) / (math.Exp(curveSteepness) - 1)
+	case ast.CurveLog:
+		progress = math.Log1p((math.Exp(curveSteepness)-1)*progress) / curveSteepness
+	}
+
+	return int(math.Round(float64(from) + float64(to-from)*progress))
+}
+
+// expandControlRamp creates the control change events of a control ramp over a bar.
+// The starting value is emitted by the ramp command itself.
+func (it *Interpreter) expandControlRamp(r *controlRamp, barCap uint32) []Event {
+	var events []Event
+
+	ch := NewChannelFromHuman(r.track)
+	prev := r.from
+
+	addValue := func(pos uint32, value int) {
+		// Skip repeating values.
+		if value == prev {
+			return
+		}
+		prev = value
+		events = append(events, Event{
+			Track:   r.track,
+			Pos:     pos,
+			Message: smf.Message(midi.ControlChange(ch.Uint8(), r.control, uint8(value))),
+		})
+	}
+
+	for pos := it.controlResolution; pos < barCap; pos += it.controlResolution {
+		addValue(pos, curveValue(r.curve, r.from, r.to, float64(pos)/float64(barCap)))
+	}
+	addValue(barCap, r.to)
+
+	return events
+}
+
+// activeHairpin is a hairpin being expanded over bars.
+type activeHairpin struct {
+	*hairpin
+	bar  int // the number of bars expanded
+	prev int // the previous controller value
+}
+
+// expandHairpin applies the next bar of a hairpin to the bar.
+// The wedge and the controller value at the beginning of the hairpin are emitted by the hairpin command itself.
+func (it *Interpreter) expandHairpin(bar *Bar, h *activeHairpin) {
+	barCap := bar.Cap()
+	total := float64(h.bars) * float64(barCap)
+	n := h.bar
+
+	level := func(pos uint32) int {
+		progress := (float64(n)*float64(barCap) + float64(pos)) / total
+		return curveValue(ast.CurveLinear, h.from, h.to, progress)
+	}
+
+	if h.control < 0 {
+		for i, ev := range bar.Events {
+			var c, k, v uint8
+			if ev.Track != h.track || ev.Note == nil || !ev.Message.GetNoteStart(&c, &k, &v) {
+				continue
+			}
+			v = noteVelocity(level(ev.Pos), ev.Note.Props)
+			if v > 0 {
+				for _, o := range bar.velocity {
+					v = playVelocity(v, o)
+				}
+			}
+			bar.Events[i].Message = smf.Message(midi.NoteOn(c, k, v))
+		}
+	} else {
+		ch := NewChannelFromHuman(h.track)
+		addValue := func(pos uint32, value int) {
+			// Skip repeating values.
+			if value == h.prev {
+				return
+			}
+			h.prev = value
+			bar.Events = append(bar.Events, Event{
+				Track:   h.track,
+				Pos:     pos,
+				Message: smf.Message(midi.ControlChange(ch.Uint8(), uint8(h.control), uint8(value))),
+			})
+		}
+
+		for pos := uint32(0); pos < barCap; pos += it.controlResolution {
+			addValue(pos, level(pos))
+		}
+
+		if n == h.bars-1 {
+			addValue(barCap, h.to)
+		}
+	}
+
+	if n == h.bars-1 {
+		bar.Events = append(bar.Events, Event{
+			Track:   h.track,
+			Voice:   h.voice,
+			Pos:     barCap,
+			Dynamic: h.dynamic,
+			Wedge:   "stop",
+		})
+	}
+
+	h.bar++
+}
+
 func (it *Interpreter) beginBar() *Interpreter {
 	return &Interpreter{
 		velocity: it.velocity,
+		octave:   it.octave,
 		channel:  it.channel,
 		channels: it.channels,
 
 		pos:     it.pos,
 		timesig: it.timesig,
-
-		keymap: it.keymap,
-		bars:   it.bars,
-		scales: it.scales,
+		meters:  maps.Clone(it.meters),
+
+		keymap:   it.keymap,
+		bars:     it.bars,
+		scales:   it.scales,
+		grooves:  it.grooves,
+		humanize: it.humanize,
+		custom:   it.custom,
+		dynamics: it.dynamics,
+		linter:   it.linter,
+		straight: it.straight,
 	}
 }
 
@@ -187,10 +623,22 @@ func (it *Interpreter) parse(declList ast.NodeList) ([]*Bar, error) {
 			if !it.keymap.Set(it.channel, decl.Note, decl.Key) {
 				old, _ := it.keymap.Get(it.channel, decl.Note)
 				return nil, &EvalError{
-					Err: fmt.Errorf("note '%c' already assigned to key '%d' on channel '%d'", decl.Note, old, it.channel),
+					Err: fmt.Errorf("note '%s' already assigned to key '%d' on channel '%d'", decl.Note, old, it.channel),
+					Pos: decl.Pos,
+				}
+			}
+
+		case ast.CmdKit:
+			it.keymap.SetKit(it.channel, gm.Kits[decl.Name])
+
+		case ast.CmdScale:
+			if _, ok := it.custom[decl.Name]; ok {
+				return nil, &EvalError{
+					Err: fmt.Errorf("scale '%s' already defined", decl.Name),
 					Pos: decl.Pos,
 				}
 			}
+			it.custom[decl.Name] = decl.Intervals
 
 		case ast.Bar:
 			if _, ok := it.bars[decl.Name]; ok {
@@ -212,6 +660,26 @@ func (it *Interpreter) parse(declList ast.NodeList) ([]*Bar, error) {
 			}
 			it.bars[decl.Name] = newBar
 
+		case ast.CmdInclude:
+			includedBars, err := it.include(decl)
+			if err != nil {
+				return nil, err
+			}
+			bars = append(bars, includedBars...)
+
+		case ast.Repeat:
+			repeatBars, err := it.parseRepeat(decl)
+			if err != nil {
+				return nil, err
+			}
+			bars = append(bars, repeatBars...)
+
+		case ast.CmdVolta:
+			return nil, &EvalError{
+				Err: fmt.Errorf("command 'volta' not allowed outside repeat"),
+				Pos: decl.Pos,
+			}
+
 		case ast.CmdPlay:
 			savedBar, ok := it.bars[decl.BarName]
 			if !ok {
@@ -220,14 +688,43 @@ func (it *Interpreter) parse(declList ast.NodeList) ([]*Bar, error) {
 					Pos: decl.Pos,
 				}
 			}
-			bars = append(bars, savedBar)
+			if every, ok := decl.Options.Get("every"); ok {
+				// Play the bar on every n-th evaluation of the command.
+				// Separately evaluated inputs begin at the same position.
+				key := fmt.Sprintf("%s every=%d@%s", decl.BarName, every.Value, decl.Pos)
+				it.plays[key]++
+				if it.plays[key]%every.Value != 0 {
+					continue
+				}
+			}
+			bar, err := it.playBar(savedBar, decl)
+			if err != nil {
+				return nil, err
+			}
+			bars = append(bars, bar)
+
+		case ast.CmdStaff:
+			it.staff = decl.On
 
 		default:
 			bar, err := it.parseBar(ast.NodeList{decl})
 			if err != nil {
 				return nil, err
 			}
-			if bar != nil {
+			if bar == nil {
+				continue
+			}
+			if it.staff && isStaffNoteList(decl) {
+				// In staff mode the pickup bar is split from the note list.
+				pickup := it.pickup != nil
+				it.pickup = nil
+
+				staffBars, err := splitBar(bar, it.pos, pickup)
+				if err != nil {
+					return nil, err
+				}
+				bars = append(bars, staffBars...)
+			} else {
 				bars = append(bars, bar)
 			}
 		}
@@ -236,11 +733,278 @@ func (it *Interpreter) parse(declList ast.NodeList) ([]*Bar, error) {
 	return bars, nil
 }
 
+// playBar copies a saved bar and applies the play command options to the copy.
+func (it *Interpreter) playBar(saved *Bar, cmd ast.CmdPlay) (*Bar, error) {
+	bar := saved.clone()
+
+	if len(cmd.Options) == 0 {
+		return bar, nil
+	}
+
+	transpose, _ := cmd.Options.Get("transpose")
+	velocity, hasVelocity := cmd.Options.Get("velocity")
+	channel, hasChannel := cmd.Options.Get("channel")
+
+	if hasVelocity {
+		// Keep the velocity for hairpins that recompute note velocities.
+		bar.velocity = append(bar.velocity, velocity)
+	}
+
+	var ch Channel
+	if hasChannel {
+		ch = NewChannelFromHuman(uint8(channel.Value))
+		it.addChannel(ch)
+
+		if bar.hairpin != nil {
+			hCopy := *bar.hairpin
+			hCopy.track = ch.Human()
+			bar.hairpin = &hCopy
+		}
+
+		for i, r := range bar.ramps {
+			rCopy := *r
+			rCopy.track = ch.Human()
+			bar.ramps[i] = &rCopy
+		}
+
+		if len(bar.grooves) > 0 {
+			// All notes move to the channel, keep the groove of the lowest channel.
+			track := slices.Min(slices.Collect(maps.Keys(bar.grooves)))
+			bar.grooves = map[uint8]groove{ch.Human(): bar.grooves[track]}
+		}
+
+		if len(bar.humanize) > 0 {
+			track := slices.Min(slices.Collect(maps.Keys(bar.humanize)))
+			bar.humanize = map[uint8]*humanizer{ch.Human(): bar.humanize[track]}
+		}
+
+		if len(bar.meters) > 0 {
+			track := slices.Min(slices.Collect(maps.Keys(bar.meters)))
+			bar.meters = map[uint8][2]uint8{ch.Human(): bar.meters[track]}
+		}
+	}
+
+	for i := range bar.Events {
+		ev := &bar.Events[i]
+
+		if hasChannel && ev.Track != 0 {
+			ev.Track = ch.Human()
+		}
+
+		var c uint8
+		if !ev.Message.GetChannel(&c) {
+			continue
+		}
+
+		// Copy the message since the saved bar shares the underlying bytes.
+		msg := slices.Clone(ev.Message)
+		status := msg[0] & 0xF0
+
+		if hasChannel {
+			msg[0] = status | ch.Uint8()
+		}
+
+		switch status {
+		case 0x80, 0x90, 0xA0: // note off, note on and polyphonic aftertouch
+			key := int(msg[1]) + transpose.Value
+			if key < 0 || key > constants.MaxValue {
+				return nil, &EvalError{
+					Err: fmt.Errorf("transposed note key must be in range [%d, %d], got: %d", 0, constants.MaxValue, key),
+					Pos: cmd.Pos,
+				}
+			}
+			msg[1] = uint8(key)
+
+			if status == 0x90 && hasVelocity && msg[2] > 0 {
+				msg[2] = playVelocity(msg[2], velocity)
+			}
+
+			if ev.Note != nil && transpose.Value != 0 {
+				// Respell the transposed note by the key signature of its channel.
+				flats := it.keySig(NewChannelFromHuman(ev.Track)).flats
+
+				note := *ev.Note
+				note.Props = note.Props.Natural()
+				ev.Note = &note
+				ev.IsFlat = isFlatKey(key, flats)
+			}
+		}
+
+		ev.Message = msg
+	}
+
+	return bar, nil
+}
+
+// playVelocity applies the velocity option of a play command to a note on velocity.
+func playVelocity(v uint8, velocity ast.Option) uint8 {
+	value := velocity.Value
+	if velocity.Signed {
+		value += int(v)
+	}
+	return uint8(max(1, min(value, constants.MaxValue)))
+}
+
+func (it *Interpreter) addChannel(ch Channel) {
+	if !slices.Contains(it.channels, ch) {
+		it.channels = append(it.channels, ch)
+		slices.Sort(it.channels)
+	}
+}
+
+// parseRepeat expands a repeat block into bars. The block is evaluated again on each pass.
+func (it *Interpreter) parseRepeat(r ast.Repeat) ([]*Bar, error) {
+	var (
+		body   ast.NodeList
+		voltas []ast.NodeList
+	)
+
+	for _, decl := range r.DeclList {
+		var (
+			name string
+			pos  token.Pos
+		)
+
+		switch decl := decl.(type) {
+		case ast.Bar:
+			name, pos = "bar", decl.Pos
+		case ast.CmdAssign:
+			name, pos = "assign", decl.Pos
+		case ast.CmdKit:
+			name, pos = "kit", decl.Pos
+		case ast.CmdScale:
+			name, pos = "scale", decl.Pos
+		case ast.CmdInclude:
+			name, pos = "include", decl.Pos
+		case ast.Repeat:
+			name, pos = "repeat", decl.Pos
+		case ast.CmdVolta:
+			if int(decl.Number) != len(voltas)+1 {
+				return nil, &EvalError{
+					Err: fmt.Errorf("expected volta %d, got %d", len(voltas)+1, decl.Number),
+					Pos: decl.Pos,
+				}
+			}
+			voltas = append(voltas, nil)
+			continue
+		}
+
+		if name != "" {
+			return nil, &EvalError{
+				Err: fmt.Errorf("command '%s' not allowed in repeat", name),
+				Pos: pos,
+			}
+		}
+
+		if len(voltas) == 0 {
+			body = append(body, decl)
+		} else {
+			voltas[len(voltas)-1] = append(voltas[len(voltas)-1], decl)
+		}
+	}
+
+	if len(voltas) > 0 && len(voltas) != int(r.Times) {
+		return nil, &EvalError{
+			Err: fmt.Errorf("repeat %d times requires %d voltas, got %d", r.Times, r.Times, len(voltas)),
+			Pos: r.Pos,
+		}
+	}
+
+	var (
+		bars    []*Bar
+		written []*Bar // bars of the first pass that end up in notation
+	)
+
+	for pass := 1; pass <= int(r.Times); pass++ {
+		passBars, err := it.parse(body)
+		if err != nil {
+			return nil, err
+		}
+
+		if pass == 1 {
+			written = append(written, nonZeroBars(passBars)...)
+		} else {
+			for _, bar := range passBars {
+				bar.repeat.skip = true
+			}
+		}
+
+		bars = append(bars, passBars...)
+
+		if len(voltas) == 0 {
+			continue
+		}
+
+		voltaBars, err := it.parse(voltas[pass-1])
+		if err != nil {
+			return nil, err
+		}
+
+		playable := nonZeroBars(voltaBars)
+		if len(playable) == 0 {
+			return nil, &EvalError{
+				Err: fmt.Errorf("invalid empty volta %d", pass),
+				Pos: r.Pos,
+			}
+		}
+
+		if pass == 1 {
+			written = append(written, playable[0])
+		}
+
+		first, last := playable[0], playable[len(playable)-1]
+		first.repeat.ending = pass
+		first.repeat.endingStart = true
+		last.repeat.ending = pass
+		if pass < int(r.Times) {
+			last.repeat.endingStop = "stop"
+			last.repeat.backward = true
+		} else {
+			last.repeat.endingStop = "discontinue"
+		}
+
+		bars = append(bars, voltaBars...)
+	}
+
+	if len(written) == 0 {
+		return nil, &EvalError{
+			Err: fmt.Errorf("invalid empty repeat"),
+			Pos: r.Pos,
+		}
+	}
+
+	written[0].repeat.forward = true
+
+	if len(voltas) == 0 {
+		last := written[len(written)-1]
+		last.repeat.backward = true
+		if r.Times > 2 {
+			last.repeat.times = int(r.Times)
+		}
+	}
+
+	return bars, nil
+}
+
+// nonZeroBars returns the bars that are not merged with the next bar on flush.
+func nonZeroBars(bars []*Bar) []*Bar {
+	var result []*Bar
+	for _, bar := range bars {
+		if !bar.IsZeroDuration() {
+			result = append(result, bar)
+		}
+	}
+
+	return result
+}
+
 func (it *Interpreter) parseBar(declList ast.NodeList) (*Bar, error) {
 	bar := &Bar{
 		timeSig: it.timesig,
 	}
 
+	var length uint32 // the length of the longest note list
+
 	for _, decl := range declList {
 		switch decl := decl.(type) {
 		case ast.CmdAssign:
@@ -249,6 +1013,18 @@ func (it *Interpreter) parseBar(declList ast.NodeList) (*Bar, error) {
 				Pos: decl.Pos,
 			}
 
+		case ast.CmdKit:
+			return nil, &EvalError{
+				Err: fmt.Errorf("command 'kit' not allowed in bar"),
+				Pos: decl.Pos,
+			}
+
+		case ast.CmdScale:
+			return nil, &EvalError{
+				Err: fmt.Errorf("command 'scale' not allowed in bar"),
+				Pos: decl.Pos,
+			}
+
 		case ast.CmdPlay:
 			return nil, &EvalError{
 				Err: fmt.Errorf("command 'play' not allowed in bar"),
@@ -261,20 +1037,130 @@ func (it *Interpreter) parseBar(declList ast.NodeList) (*Bar, error) {
 				Pos: decl.Pos,
 			}
 
+		case ast.CmdInclude:
+			return nil, &EvalError{
+				Err: fmt.Errorf("command 'include' not allowed in bar"),
+				Pos: decl.Pos,
+			}
+
+		case ast.Repeat:
+			return nil, &EvalError{
+				Err: fmt.Errorf("command 'repeat' not allowed in bar"),
+				Pos: decl.Pos,
+			}
+
+		case ast.CmdVolta:
+			return nil, &EvalError{
+				Err: fmt.Errorf("command 'volta' not allowed in bar"),
+				Pos: decl.Pos,
+			}
+
+		case ast.CmdStaff:
+			return nil, &EvalError{
+				Err: fmt.Errorf("command 'staff' not allowed in bar"),
+				Pos: decl.Pos,
+			}
+
 		case ast.CmdTempo:
 			bar.Events = append(bar.Events, Event{
 				Message: smf.MetaTempo(decl.Value()),
 			})
 
+		case ast.CmdTempoRamp:
+			bar.tempoRamp = &tempoRamp{
+				from: float64(decl.From),
+				to:   float64(decl.To),
+				bars: int(decl.Bars),
+			}
+			bar.Events = append(bar.Events, Event{
+				Message: smf.MetaTempo(bar.tempoRamp.from),
+			})
+
+		case ast.CmdDyn:
+			it.velocity = int(it.dynamics[decl.Dynamic])
+			bar.Events = append(bar.Events, Event{
+				Track:   it.channel.Human(),
+				Voice:   it.voice,
+				Dynamic: decl.Dynamic,
+			})
+
+		case ast.CmdDynamics:
+			for _, o := range decl.Options {
+				it.dynamics[o.Name] = uint8(o.Value)
+			}
+
+		case ast.CmdHairpin:
+			h := &hairpin{
+				track:   it.channel.Human(),
+				voice:   it.voice,
+				wedge:   "crescendo",
+				dynamic: decl.Dynamic,
+				from:    it.velocity,
+				to:      int(it.dynamics[decl.Dynamic]),
+				bars:    int(decl.Bars),
+				control: decl.Control,
+			}
+			if decl.Type == ast.Diminuendo {
+				h.wedge = "diminuendo"
+			}
+			bar.hairpin = h
+			bar.Events = append(bar.Events, Event{
+				Track: h.track,
+				Voice: h.voice,
+				Wedge: h.wedge,
+			})
+
+			if h.control < 0 {
+				// Notes after the hairpin continue at the target dynamic.
+				it.velocity = h.to
+			} else {
+				bar.Events = append(bar.Events, Event{
+					Track:   h.track,
+					Message: smf.Message(midi.ControlChange(it.channel.Uint8(), uint8(h.control), uint8(h.from))),
+				})
+			}
+
 		case ast.CmdKey:
-			it.scales[it.channel] = decl.Key
-			makeMessage, _, _ := getScale(decl.Key)
+			key, err := newKeySig(decl, it.custom)
+			if err != nil {
+				return nil, err
+			}
+			it.scales[it.channel] = key
+			makeMessage, _, _ := getScale(key.signature)
 
 			bar.Events = append(bar.Events, Event{
 				Track:   it.channel.Human(),
 				Message: makeMessage(),
 			})
 
+		case ast.CmdSwing:
+			if decl.Percent == 50 {
+				delete(it.grooves, it.channel)
+			} else {
+				it.grooves[it.channel] = newSwing(decl.Percent, decl.Value)
+			}
+
+		case ast.CmdGroove:
+			g, ok := grooves[decl.Name]
+			if !ok {
+				return nil, &EvalError{
+					Err: fmt.Errorf("unknown groove '%s'", decl.Name),
+					Pos: decl.Pos,
+				}
+			}
+			if g.isStraight() {
+				delete(it.grooves, it.channel)
+			} else {
+				it.grooves[it.channel] = g
+			}
+
+		case ast.CmdHumanize:
+			if decl.Timing == 0 && decl.Velocity == 0 {
+				delete(it.humanize, it.channel)
+			} else {
+				it.humanize[it.channel] = newHumanizer(decl.Timing, decl.Velocity, decl.Seed, decl.Lock)
+			}
+
 		case ast.CmdTime:
 			it.timesig = [2]uint8{decl.Num, decl.Denom}
 			bar.timeSig = it.timesig
@@ -282,21 +1168,39 @@ func (it *Interpreter) parseBar(declList ast.NodeList) (*Bar, error) {
 				Message: smf.MetaMeter(decl.Num, decl.Denom),
 			})
 
+		case ast.CmdMeter:
+			it.meters[it.channel] = [2]uint8{decl.Num, decl.Denom}
+
 		case ast.CmdVelocity:
 			it.velocity = decl.Velocity
 
+		case ast.CmdOctave:
+			it.octave = decl.Octave
+
 		case ast.CmdChannel:
 			ch := NewChannelFromHuman(decl.Channel)
-			if !slices.Contains(it.channels, ch) {
-				it.channels = append(it.channels, ch)
-				slices.Sort(it.channels)
-			}
+			it.addChannel(ch)
 			it.channel = ch
 
 		case ast.CmdVoice:
 			it.voice = decl.Voice
 
 		case ast.CmdProgram:
+			if decl.Name != "" {
+				bar.Events = append(bar.Events, Event{
+					Track:   it.channel.Human(),
+					Message: smf.MetaTrackSequenceName(decl.Name),
+				})
+			}
+
+			// Bank select MSB and LSB.
+			for i, v := range decl.Bank {
+				bar.Events = append(bar.Events, Event{
+					Track:   it.channel.Human(),
+					Message: smf.Message(midi.ControlChange(it.channel.Uint8(), uint8(i*32), v)),
+				})
+			}
+
 			bar.Events = append(bar.Events, Event{
 				Track:   it.channel.Human(),
 				Message: smf.Message(midi.ProgramChange(it.channel.Uint8(), decl.Program)),
@@ -308,6 +1212,86 @@ func (it *Interpreter) parseBar(declList ast.NodeList) (*Bar, error) {
 				Message: smf.Message(midi.ControlChange(it.channel.Uint8(), decl.Control, decl.Parameter)),
 			})
 
+		case ast.CmdControlRamp:
+			bar.ramps = append(bar.ramps, &controlRamp{
+				track:   it.channel.Human(),
+				control: decl.Control,
+				from:    int(decl.From),
+				to:      int(decl.To),
+				curve:   decl.Curve,
+			})
+			bar.Events = append(bar.Events, Event{
+				Track:   it.channel.Human(),
+				Message: smf.Message(midi.ControlChange(it.channel.Uint8(), decl.Control, decl.From)),
+			})
+
+		case ast.CmdBend:
+			bar.Events = append(bar.Events, Event{
+				Track:   it.channel.Human(),
+				Message: smf.Message(midi.Pitchbend(it.channel.Uint8(), decl.Value)),
+			})
+
+		case ast.CmdPressure:
+			bar.Events = append(bar.Events, Event{
+				Track:   it.channel.Human(),
+				Message: smf.Message(midi.AfterTouch(it.channel.Uint8(), decl.Pressure)),
+			})
+
+		case ast.CmdSysex:
+			messages, _ := ast.SplitSysex(decl.Data)
+			for _, msg := range messages {
+				bar.Events = append(bar.Events, Event{
+					Track:   it.channel.Human(),
+					Message: smf.Message(msg),
+				})
+			}
+
+		case ast.CmdSysexFile:
+			data, err := os.ReadFile(resolvePath(decl.Pos, decl.Path))
+			if err != nil {
+				return nil, &EvalError{
+					Err: err,
+					Pos: decl.Pos,
+				}
+			}
+
+			messages, err := ast.SplitSysex(data)
+			if err != nil {
+				return nil, &EvalError{
+					Err: fmt.Errorf("invalid sysex file '%s': %w", decl.Path, err),
+					Pos: decl.Pos,
+				}
+			}
+
+			for _, msg := range messages {
+				bar.Events = append(bar.Events, Event{
+					Track:   it.channel.Human(),
+					Message: smf.Message(msg),
+				})
+			}
+
+		case ast.CmdRPN:
+			param, data := [2]uint8{101, 100}, [2]uint8{6, 38}
+			if decl.Type == ast.NRPN {
+				param = [2]uint8{99, 98}
+			}
+
+			ccs := [][2]uint8{
+				{param[0], decl.ParamMSB},
+				{param[1], decl.ParamLSB},
+				{data[0], decl.ValueMSB},
+			}
+			if decl.ValueLSB >= 0 {
+				ccs = append(ccs, [2]uint8{data[1], uint8(decl.ValueLSB)})
+			}
+
+			for _, cc := range ccs {
+				bar.Events = append(bar.Events, Event{
+					Track:   it.channel.Human(),
+					Message: smf.Message(midi.ControlChange(it.channel.Uint8(), cc[0], cc[1])),
+				})
+			}
+
 		case ast.CmdStart:
 			bar.Events = append(bar.Events, Event{
 				Message: smf.Message(midi.Start()),
@@ -318,15 +1302,20 @@ func (it *Interpreter) parseBar(declList ast.NodeList) (*Bar, error) {
 				Message: smf.Message(midi.Stop()),
 			})
 
+		case ast.CmdPickup:
+			it.pickup = &decl
+
 		case ast.NodeList:
 			if err := it.parseNoteList(bar, decl); err != nil {
 				return nil, err
 			}
+			length = max(length, it.pos)
 
 		case ast.NoteGroup:
 			if err := it.parseNoteList(bar, decl.Nodes); err != nil {
 				return nil, err
 			}
+			length = max(length, it.pos)
 
 		case ast.BlockComment:
 			bar.Events = append(bar.Events, Event{
@@ -334,11 +1323,25 @@ func (it *Interpreter) parseBar(declList ast.NodeList) (*Bar, error) {
 				Message: smf.MetaText(strings.TrimSpace(decl.Text)),
 			})
 
+		case ast.LineComment:
+			// Line comments are not exported.
+
 		default:
 			panic(fmt.Sprintf("parse: invalid node %T", decl))
 		}
 	}
 
+	if it.pickup != nil && length > 0 && !it.staff {
+		if barCap := bar.Cap(); length > barCap {
+			return nil, &EvalError{
+				Err: fmt.Errorf("pickup bar too long by %d ticks, timesig is %d/%d", length-barCap, bar.timeSig[0], bar.timeSig[1]),
+				Pos: it.pickup.Pos,
+			}
+		}
+		bar.pickup = length
+		it.pickup = nil
+	}
+
 	if it.pos == 0 && len(bar.Events) == 0 {
 		// Bar that consists of only velocity, channel or voice commands and no events.
 		return nil, nil
@@ -358,6 +1361,15 @@ func (it *Interpreter) parseNoteList(bar *Bar, nodes ast.NodeList) error {
 			firstNote = note
 		}
 
+		if !note.Props.IsExactLen() {
+			var buf strings.Builder
+			note.WriteTo(&buf)
+			return &EvalError{
+				Err: fmt.Errorf("note '%s' length can't be represented at %d ticks per quarter note", buf.String(), constants.TicksPerQuarter),
+				Pos: note.Pos,
+			}
+		}
+
 		noteLen := note.Props.NoteLen()
 
 		actualNoteLen := noteLen
@@ -376,53 +1388,91 @@ func (it *Interpreter) parseNoteList(bar *Bar, nodes ast.NodeList) error {
 			})
 
 		case false:
-			k, ok := it.keymap.Get(it.channel, note.Name)
-			if !ok {
-				return &EvalError{
-					Err: fmt.Errorf("note '%c' undefined", note.Name),
-					Pos: note.Pos,
+			scale := it.keySig(it.channel)
+
+			var keys []noteKey
+			if note.Chord != nil {
+				chordKeys, err := it.chordKeys(note, scale)
+				if err != nil {
+					return err
+				}
+				keys = chordKeys
+			} else if note.Degree > 0 {
+				keys = []noteKey{it.degreeKey(note)}
+			} else if note.Pitch != nil {
+				it.linter.lintPitch(it.channel)
+
+				key, isFlat, err := it.modifyKey(note.Pitch.Key(), note, scale)
+				if err != nil {
+					return err
+				}
+				keys = []noteKey{{key: key, isFlat: isFlat}}
+			} else {
+				k, ok := it.keymap.Get(it.channel, note.Name)
+				if !ok {
+					return &EvalError{
+						Err: fmt.Errorf("note '%s' undefined", note.Name),
+						Pos: note.Pos,
+					}
 				}
-			}
 
-			scale, ok := it.scales[it.channel]
-			if !ok {
-				scale = "C"
+				it.linter.lintLetter(it.channel, note, k)
+
+				key, isFlat, err := it.modifyKey(k, note, scale)
+				if err != nil {
+					return err
+				}
+				keys = []noteKey{{key: key, isFlat: isFlat}}
 			}
 
-			key, isFlat, err := it.modifyKey(k, note, scale)
-			if err != nil {
-				return err
+			octave := note.Props.Octave()
+			if note.Pitch == nil && (note.Chord == nil || note.Chord.Octave < 0) {
+				// The octave command does not shift absolute pitches.
+				octave += it.octave
 			}
 
-			v := it.velocity
-			v += note.Props.NumAccent() * 5
-			v += note.Props.NumMarcato() * 10
-			v -= note.Props.NumGhost() * 5
-			if v < 0 {
-				v = 0
-			} else if v > constants.MaxValue {
-				v = math.MaxUint8
+			for i := range keys {
+				keys[i].key += 12 * octave
+				if key := keys[i].key; key < 0 || key > constants.MaxValue {
+					return &EvalError{
+						Err: fmt.Errorf("note key must be in range [%d, %d], got: %d", 0, constants.MaxValue, key),
+						Pos: note.Pos,
+					}
+				}
 			}
 
-			bar.Events = append(bar.Events, Event{
-				Track:    it.channel.Human(),
-				Voice:    it.voice,
-				Note:     note,
-				Pos:      it.pos,
-				Duration: actualNoteLen,
-				IsFlat:   isFlat,
-				Message:  smf.Message(midi.NoteOn(it.channel.Uint8(), uint8(key), uint8(v))),
-			})
+			v := noteVelocity(it.velocity, note.Props)
 
-			if !note.Props.IsLetRing() {
-				// TODO: for let ring notes do we need a virtual "note off"
-				// so that fill with rests could be implemented?
+			for _, k := range keys {
 				bar.Events = append(bar.Events, Event{
 					Track:    it.channel.Human(),
-					Pos:      it.pos + actualNoteLen,
-					Duration: 0,
-					Message:  smf.Message(midi.NoteOff(it.channel.Uint8(), uint8(key))),
+					Voice:    it.voice,
+					Note:     note,
+					Pos:      it.pos,
+					Duration: actualNoteLen,
+					IsFlat:   k.isFlat,
+					Message:  smf.Message(midi.NoteOn(it.channel.Uint8(), uint8(k.key), v)),
+					TieStart: note.Props.IsTie(),
 				})
+
+				if pressure, ok := note.Props.Aftertouch(); ok {
+					bar.Events = append(bar.Events, Event{
+						Track:   it.channel.Human(),
+						Pos:     it.pos,
+						Message: smf.Message(midi.PolyAfterTouch(it.channel.Uint8(), uint8(k.key), pressure)),
+					})
+				}
+
+				if !note.Props.IsLetRing() {
+					// TODO: for let ring notes do we need a virtual "note off"
+					// so that fill with rests could be implemented?
+					bar.Events = append(bar.Events, Event{
+						Track:    it.channel.Human(),
+						Pos:      it.pos + actualNoteLen,
+						Duration: 0,
+						Message:  smf.Message(midi.NoteOff(it.channel.Uint8(), uint8(k.key))),
+					})
+				}
 			}
 		}
 
@@ -435,29 +1485,159 @@ func (it *Interpreter) parseNoteList(bar *Bar, nodes ast.NodeList) error {
 		return err
 	}
 
-	if it.pos > bar.Cap() {
+	timesig := it.timesig
+	if meter, ok := it.meters[it.channel]; ok {
+		timesig = meter
+		if bar.meters == nil {
+			bar.meters = map[uint8][2]uint8{}
+		}
+		bar.meters[it.channel.Human()] = meter
+	}
+
+	if barCap := timeSigCap(timesig); it.pos > barCap && !it.staff {
 		return &EvalError{
-			Err: fmt.Errorf("bar too long by %d ticks, timesig is %d/%d", it.pos-bar.Cap(), it.timesig[0], it.timesig[1]),
+			Err: fmt.Errorf("bar too long by %d ticks, timesig is %d/%d", it.pos-barCap, timesig[0], timesig[1]),
 			Pos: firstNote.Pos,
 		}
 	}
 
+	if g, ok := it.grooves[it.channel]; ok {
+		if bar.grooves == nil {
+			bar.grooves = map[uint8]groove{}
+		}
+		bar.grooves[it.channel.Human()] = g
+	}
+
+	if h, ok := it.humanize[it.channel]; ok {
+		if bar.humanize == nil {
+			bar.humanize = map[uint8]*humanizer{}
+		}
+		bar.humanize[it.channel.Human()] = h
+	}
+
 	return nil
 }
 
-func (it *Interpreter) modifyKey(key int, note *ast.Note, scale string) (newKey int, isFlat bool, err error) {
+// degreeKey returns the key of a scale degree in the current key.
+// The first degree is the tonic in the octave of middle C.
+func (it *Interpreter) degreeKey(note *ast.Note) noteKey {
+	scale := it.keySig(it.channel)
+
+	idx := note.Degree - 1
+	n := len(scale.intervals)
+
+	key := 60 + scale.tonic + scale.intervals[idx%n] + 12*(idx/n)
+
+	switch {
+	case note.Props.IsSharp():
+		key++
+	case note.Props.IsFlat():
+		key--
+	}
+
+	flats := scale.flats
+
+	return noteKey{
+		key:    key,
+		isFlat: isBlackKey(key) && (note.Props.IsFlat() || (!note.Props.IsSharp() && isFlatKey(key, flats))),
+	}
+}
+
+// keySig returns the key of a channel.
+func (it *Interpreter) keySig(ch Channel) keySig {
+	if key, ok := it.scales[ch]; ok {
+		return key
+	}
+	return defaultKeySig
+}
+
+// noteVelocity returns the velocity of a note with accents applied.
+func noteVelocity(velocity int, props ast.PropertyList) uint8 {
+	v := velocity
+	v += props.NumAccent() * 5
+	v += props.NumMarcato() * 10
+	v -= props.NumGhost() * 5
+	return uint8(max(0, min(v, constants.MaxValue)))
+}
+
+// noteKey is a MIDI key with its spelling.
+type noteKey struct {
+	key    int
+	isFlat bool
+}
+
+// chordKeys returns the keys of a chord. The root is voiced at the explicit root octave
+// or at the key assigned to the lowercase root letter on the current channel.
+// The bass note of a slash chord is voiced below the root.
+func (it *Interpreter) chordKeys(note *ast.Note, scale keySig) ([]noteKey, error) {
+	chord := note.Chord
+
+	if note.Props.IsSharp() || note.Props.IsFlat() {
+		return nil, &EvalError{
+			Err: fmt.Errorf("sharp or flat property not allowed on chord '%s'", chord.Symbol),
+			Pos: note.Pos,
+		}
+	}
+
+	var root int
+	if chord.Octave >= 0 {
+		root = (chord.Octave+1)*12 + chord.RootClass()
+	} else {
+		name := unicode.ToLower(chord.Root)
+		k, ok := it.keymap.Get(it.channel, string(name))
+		if !ok {
+			return nil, &EvalError{
+				Err: fmt.Errorf("chord root '%c' undefined", name),
+				Pos: note.Pos,
+			}
+		}
+		root = k + chord.RootShift
+	}
+
+	keys := make([]int, 0, len(chord.Intervals)+1)
+
+	if chord.Bass != 0 {
+		bass := root - ((root-chord.BassClass())%12+12)%12
+		if bass == root {
+			bass -= 12
+		}
+		keys = append(keys, bass)
+	}
+
+	for _, interval := range chord.Intervals {
+		keys = append(keys, root+interval)
+	}
+
+	flats := scale.flats
+
+	result := make([]noteKey, 0, len(keys))
+	for _, key := range keys {
+		if key < 0 || key > constants.MaxValue {
+			return nil, &EvalError{
+				Err: fmt.Errorf("chord '%s' key must be in range [%d, %d], got: %d", chord.Symbol, 0, constants.MaxValue, key),
+				Pos: note.Pos,
+			}
+		}
+
+		result = append(result, noteKey{
+			key:    key,
+			isFlat: isFlatKey(key, flats) || (chord.IsFlat() && isBlackKey(key)),
+		})
+	}
+
+	return result, nil
+}
+
+func (it *Interpreter) modifyKey(key int, note *ast.Note, scale keySig) (newKey int, isFlat bool, err error) {
 	step, _ := getPitch(key)
 
-	_, sharps, flats := getScale(scale)
+	sharps, flats := scale.sharps, scale.flats
 
 	// Detect whether we have Bb rather than A#.
 	if strings.HasSuffix(step, "#") {
-		tmpStep, _ := getPitch(key + 1)
-		if slices.Contains(flats, tmpStep) {
-			isFlat = true
-		}
+		isFlat = isFlatKey(key, flats)
 
-		if note.Props.IsSharp() || note.Props.IsFlat() {
+		if note.IsSharp() || note.IsFlat() {
 			msg := "already sharp"
 			if isFlat {
 				msg = "already flat"
@@ -465,7 +1645,7 @@ func (it *Interpreter) modifyKey(key int, note *ast.Note, scale string) (newKey
 
 			old, _ := it.keymap.Get(it.channel, note.Name)
 			return 0, false, &EvalError{
-				Err: fmt.Errorf("cannot use sharp/flat on note '%c' assigned to key '%d' on channel '%d': %s", note.Name, old, it.channel, msg),
+				Err: fmt.Errorf("cannot use sharp/flat on note '%s' assigned to key '%d' on channel '%d': %s", note.Name, old, it.channel, msg),
 				Pos: note.Pos,
 			}
 		}
@@ -476,7 +1656,7 @@ func (it *Interpreter) modifyKey(key int, note *ast.Note, scale string) (newKey
 	{
 		for _, sharp := range sharps {
 			// Let flat accidental override sharp.
-			if step == sharp && !note.Props.IsFlat() {
+			if step == sharp && !note.IsFlat() {
 				key++
 				isSharp = true
 				break
@@ -485,7 +1665,7 @@ func (it *Interpreter) modifyKey(key int, note *ast.Note, scale string) (newKey
 
 		for _, flat := range flats {
 			// Let sharp accidental override flat.
-			if step == flat && !note.Props.IsSharp() {
+			if step == flat && !note.IsSharp() {
 				key--
 				isFlat = true
 				break
@@ -495,12 +1675,13 @@ func (it *Interpreter) modifyKey(key int, note *ast.Note, scale string) (newKey
 
 	// Apply accidentals (courtesy accidentals don't modify the MIDI key).
 	{
-		if note.Props.IsSharp() && !isSharp {
+		if note.IsSharp() && !isSharp {
 			key++
 		}
 
-		if note.Props.IsFlat() && !isFlat {
+		if note.IsFlat() && !isFlat {
 			key--
+			isFlat = key >= 0 && isBlackKey(key)
 		}
 	}
 
@@ -514,6 +1695,22 @@ func (it *Interpreter) modifyKey(key int, note *ast.Note, scale string) (newKey
 	return key, isFlat, nil
 }
 
+// isFlatKey reports whether a black key is spelled as a flat in a key signature with the given flats.
+func isFlatKey(key int, flats []string) bool {
+	if !isBlackKey(key) {
+		return false
+	}
+
+	next, _ := getPitch(key + 1)
+	return slices.Contains(flats, next)
+}
+
+// isBlackKey reports whether the key is a sharp or flat key.
+func isBlackKey(key int) bool {
+	step, _ := getPitch(key)
+	return strings.HasSuffix(step, "#")
+}
+
 // New creates a balafon interpreter.
 func New() *Interpreter {
 	return &Interpreter{
@@ -522,6 +1719,17 @@ func New() *Interpreter {
 		timesig:  [2]uint8{4, 4},
 		keymap:   newKeyMap(),
 		bars:     map[string]*Bar{},
-		scales:   map[Channel]string{},
+		scales:   map[Channel]keySig{},
+		grooves:  map[Channel]groove{},
+		humanize: map[Channel]*humanizer{},
+		meters:   map[Channel][2]uint8{},
+		custom:   map[string][]int{},
+		dynamics: maps.Clone(defaultDynamics),
+		linter:   newLinter(),
+		rand:     newRand(0),
+		plays:    map[string]int{},
+
+		tempoResolution:   uint32(constants.DefaultTempoResolution),
+		controlResolution: uint32(constants.DefaultControlResolution),
 	}
 }
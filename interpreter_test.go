@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,6 +41,83 @@ func TestEvalError(t *testing.T) {
 	g.Expect(perr.Error()).To(HavePrefix("testdata/eval_error.bal:2:1: error:"))
 }
 
+func TestInclude(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalFile("testdata/include/main.bal")).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(1))
+
+	g.Expect(bars[0].String()).To(Equal(`time: 1/4
+events:
+track: 10 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 10 pos: 0 dur: 960 note: k message: NoteOn channel: 9 key: 36 velocity: 100
+track: 10 pos: 960 dur: 0 message: NoteOff channel: 9 key: 36
+`))
+}
+
+func TestIncludeError(t *testing.T) {
+	for _, tc := range []struct {
+		file   string
+		prefix string
+	}{
+		{
+			"testdata/include/cycle_a.bal",
+			"testdata/include/cycle_b.bal:1:1: error: include cycle",
+		},
+		{
+			"testdata/include/missing.bal",
+			"testdata/include/missing.bal:1:1: error: open testdata/include/kits/missing.bal",
+		},
+		{
+			"testdata/include/nested_error.bal",
+			"testdata/include/kits/error.bal:2:1: error: note 'x' undefined",
+		},
+	} {
+		t.Run(tc.file, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			err := it.EvalFile(tc.file)
+			g.Expect(err).To(HaveOccurred())
+
+			var perr *balafon.EvalError
+			g.Expect(errors.As(err, &perr)).To(BeTrue())
+			g.Expect(perr.Error()).To(HavePrefix(tc.prefix))
+		})
+	}
+}
+
+func TestLineCommentsAreIgnored(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(`
+// Assign a note.
+:assign c 60 // middle C
+:time 1 4
+:bar one // comment
+	c // note
+:end
+:play one
+`)).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(1))
+
+	g.Expect(bars[0].String()).To(Equal(`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+`))
+}
+
 func TestCommands(t *testing.T) {
 	for _, tc := range []struct {
 		input    string
@@ -711,8 +789,12 @@ track: 1 pos: 0 dur: 960 note: k* message: NoteOn channel: 0 key: 36 velocity: 1
 func TestCommandsForbiddenInBar(t *testing.T) {
 	for _, input := range []string{
 		":assign c 60",
+		":kit gm",
 		`:bar inner :start :end`,
 		`:play test`,
+		`:include "kit.bal"`,
+		`:repeat 2 c :end`,
+		`:volta 1`,
 	} {
 		t.Run(input, func(t *testing.T) {
 			g := NewGomegaWithT(t)
@@ -876,3 +958,1522 @@ track: 2 pos: 960 dur: 0 message: NoteOff channel: 1 key: 60
 `))
 	})
 }
+
+func TestTie(t *testing.T) {
+	t.Run("in bar", func(t *testing.T) {
+		g := NewWithT(t)
+
+		it := balafon.New()
+
+		g.Expect(it.EvalString(":assign c 60; :time 2 4; c~ c")).To(Succeed())
+
+		bars := it.Flush()
+		g.Expect(bars).To(HaveLen(1))
+
+		g.Expect(bars[0].String()).To(Equal(`time: 2/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 2/4
+track: 1 pos: 0 dur: 960 tie: start note: c~ message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 960 tie: stop note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 1920 dur: 0 message: NoteOff channel: 0 key: 60
+`))
+	})
+
+	t.Run("across played bars", func(t *testing.T) {
+		g := NewWithT(t)
+
+		it := balafon.New()
+
+		g.Expect(it.EvalString(`
+:assign c 60
+:time 1 4
+:bar one
+	c~
+:end
+:bar two
+	c
+:end
+:play one
+:play two
+:play two
+`)).To(Succeed())
+
+		bars := it.Flush()
+		g.Expect(bars).To(HaveLen(3))
+
+		g.Expect(bars[0].String()).To(Equal(`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 960 tie: start note: c~ message: NoteOn channel: 0 key: 60 velocity: 100
+`))
+
+		g.Expect(bars[1].String()).To(Equal(`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 960 tie: stop note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+`))
+
+		// The second play of the same bar is not tied.
+		g.Expect(bars[2].String()).To(Equal(`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+`))
+	})
+
+	t.Run("tie to nothing", func(t *testing.T) {
+		g := NewWithT(t)
+
+		it := balafon.New()
+
+		g.Expect(it.EvalString(":assign c 60; :assign d 62; :time 2 4; c~ d")).To(Succeed())
+
+		bars := it.Flush()
+		g.Expect(bars).To(HaveLen(1))
+
+		g.Expect(bars[0].String()).To(Equal(`time: 2/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 2/4
+track: 1 pos: 0 dur: 960 note: c~ message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 960 dur: 960 note: d message: NoteOn channel: 0 key: 62 velocity: 100
+track: 1 pos: 1920 dur: 0 message: NoteOff channel: 0 key: 62
+`))
+	})
+
+	for _, input := range []string{
+		":assign c 60; :assign d 62; c~ d c -",
+		":assign c 60; c~ - c -",
+		":assign c 60; :assign d 62; c~ d d d; d d d d; c",
+	} {
+		t.Run("tie to a later note: "+input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			g.Expect(it.EvalString(input)).To(Succeed())
+
+			var ons, offs int
+			for _, bar := range it.Flush() {
+				for _, ev := range bar.Events {
+					var ch, key uint8
+					switch {
+					case ev.Message.GetNoteStart(&ch, &key, nil) && key == 60:
+						g.Expect(ev.TieStart).To(BeFalse())
+						g.Expect(ev.TieStop).To(BeFalse())
+						ons++
+					case ev.Message.GetNoteEnd(&ch, &key) && key == 60:
+						offs++
+					}
+				}
+			}
+
+			g.Expect(ons).To(Equal(2))
+			g.Expect(offs).To(Equal(2))
+		})
+	}
+}
+
+func TestPlaySameBarTwice(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(`
+:assign c 60
+:bar one
+	c
+:end
+/* comment */
+:play one
+:play one
+`)).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(2))
+
+	// Events merged into the first play do not leak into the saved bar.
+	g.Expect(bars[0].String()).To(ContainSubstring("MetaText"))
+	g.Expect(bars[1].String()).NotTo(ContainSubstring("MetaText"))
+	g.Expect(bars[1].Events).To(HaveLen(3))
+}
+
+func TestTempoRamp(t *testing.T) {
+	t.Run("single bar", func(t *testing.T) {
+		g := NewWithT(t)
+
+		it := balafon.New()
+		it.SetTempoResolution(uint32(constants.TicksPerQuarter))
+
+		g.Expect(it.EvalString(":assign c 60; :tempo 120 -> 60; cccc")).To(Succeed())
+
+		bars := it.Flush()
+		g.Expect(bars).To(HaveLen(1))
+
+		g.Expect(bars[0].String()).To(Equal(`time: 4/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTempo bpm: 120.00
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 4/4
+track: 1 pos: 0 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 0 message: MetaTempo bpm: 105.00
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 960 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 1920 dur: 0 message: MetaTempo bpm: 90.00
+track: 1 pos: 1920 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 1920 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 2880 dur: 0 message: MetaTempo bpm: 75.00
+track: 1 pos: 2880 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 2880 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 3840 dur: 0 message: MetaTempo bpm: 60.00
+track: 1 pos: 3840 dur: 0 message: NoteOff channel: 0 key: 60
+`))
+	})
+
+	t.Run("multiple bars", func(t *testing.T) {
+		g := NewWithT(t)
+
+		it := balafon.New()
+		it.SetTempoResolution(uint32(constants.TicksPerQuarter))
+
+		g.Expect(it.EvalString(`
+:assign c 60
+:time 2 4
+:tempo 100 -> 140 2
+:bar one
+	c2
+:end
+:play one
+:play one
+:play one
+`)).To(Succeed())
+
+		bars := it.Flush()
+		g.Expect(bars).To(HaveLen(3))
+
+		g.Expect(bars[0].String()).To(Equal(`time: 2/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 2/4
+track: 1 pos: 0 dur: 0 message: MetaTempo bpm: 100.00
+track: 1 pos: 0 dur: 1920 note: c2 message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 0 message: MetaTempo bpm: 110.00
+track: 1 pos: 1920 dur: 0 message: NoteOff channel: 0 key: 60
+`))
+
+		g.Expect(bars[1].String()).To(Equal(`time: 2/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 2/4
+track: 1 pos: 0 dur: 0 message: MetaTempo bpm: 120.00
+track: 1 pos: 0 dur: 1920 note: c2 message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 0 message: MetaTempo bpm: 130.00
+track: 1 pos: 1920 dur: 0 message: MetaTempo bpm: 140.00
+track: 1 pos: 1920 dur: 0 message: NoteOff channel: 0 key: 60
+`))
+
+		g.Expect(bars[2].String()).To(Equal(`time: 2/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 2/4
+track: 1 pos: 0 dur: 1920 note: c2 message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 1920 dur: 0 message: NoteOff channel: 0 key: 60
+`))
+	})
+
+	t.Run("zero resolution", func(t *testing.T) {
+		g := NewWithT(t)
+
+		it := balafon.New()
+		it.SetTempoResolution(0)
+
+		g.Expect(it.EvalString(":assign c 60; :tempo 120 -> 60; c1")).To(Succeed())
+
+		var tempos int
+		for _, bar := range it.Flush() {
+			for _, ev := range bar.Events {
+				if ev.Message.GetMetaTempo(nil) {
+					tempos++
+				}
+			}
+		}
+
+		// The default resolution is a 16th note.
+		g.Expect(tempos).To(Equal(17))
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		notes []string
+	}{
+		{
+			input: `
+:repeat 3
+	c
+	d
+:end
+e
+`,
+			notes: []string{"c", "d", "c", "d", "c", "d", "e"},
+		},
+		{
+			input: `
+:bar one
+	c
+:end
+:repeat 2
+	:play one
+	:volta 1
+	d
+	:volta 2
+	e
+	f
+:end
+`,
+			notes: []string{"c", "d", "c", "e", "f"},
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			g.Expect(it.EvalString(":assign c 60; :assign d 62; :assign e 64; :assign f 65; :time 1 4")).To(Succeed())
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			var notes []string
+			for _, bar := range it.Flush() {
+				for _, ev := range bar.Events {
+					if ev.Note != nil {
+						notes = append(notes, string(ev.Note.Name))
+					}
+				}
+			}
+
+			g.Expect(notes).To(Equal(tc.notes))
+		})
+	}
+}
+
+func TestRepeatError(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{":repeat 2; :assign c 60; :end", "command 'assign' not allowed in repeat"},
+		{":repeat 2; :kit gm; c; :end", "command 'kit' not allowed in repeat"},
+		{":repeat 2; :bar one; c; :end; :end", "command 'bar' not allowed in repeat"},
+		{`:repeat 2; :include "kit.bal"; :end`, "command 'include' not allowed in repeat"},
+		{":repeat 2; :repeat 2; c; :end; :end", "command 'repeat' not allowed in repeat"},
+		{":repeat 2; :volta 2; c; :end", "expected volta 1, got 2"},
+		{":repeat 3; :volta 1; c; :volta 2; c; :end", "repeat 3 times requires 3 voltas, got 2"},
+		{":repeat 2; c; :volta 1; :tempo 100; :volta 2; c; :end", "invalid empty volta 1"},
+		{":repeat 2; :tempo 100; :end", "invalid empty repeat"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			err := it.EvalString(":assign c 60\n" + tc.input)
+			g.Expect(err).To(HaveOccurred())
+
+			var perr *balafon.EvalError
+			g.Expect(errors.As(err, &perr)).To(BeTrue())
+			g.Expect(perr.Error()).To(HaveSuffix(tc.err))
+		})
+	}
+}
+
+func TestPlayOptions(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected string
+	}{
+		{
+			":play one transpose=+2 velocity=-10",
+			`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 480 note: c8 message: NoteOn channel: 0 key: 62 velocity: 90
+track: 1 pos: 480 dur: 0 message: NoteOff channel: 0 key: 62
+track: 1 pos: 480 dur: 480 note: e8 message: NoteOn channel: 0 key: 66 velocity: 90
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 66
+`,
+		},
+		{
+			":play one velocity=20 channel=3",
+			`time: 1/4
+events:
+track: 3 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 3 pos: 0 dur: 480 note: c8 message: NoteOn channel: 2 key: 60 velocity: 20
+track: 3 pos: 480 dur: 0 message: NoteOff channel: 2 key: 60
+track: 3 pos: 480 dur: 480 note: e8 message: NoteOn channel: 2 key: 64 velocity: 20
+track: 3 pos: 960 dur: 0 message: NoteOff channel: 2 key: 64
+`,
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			g.Expect(it.EvalString(":assign c 60; :assign e 64; :bar one :time 1 4; c8 e8 :end")).To(Succeed())
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			bars := it.Flush()
+			g.Expect(bars).To(HaveLen(1))
+			g.Expect(bars[0].String()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestPlayOptionsDoNotModifySavedBar(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(":assign c 60; :bar one :time 1 4; c :end; :play one transpose=+12; :play one")).To(Succeed())
+
+	keys, _ := noteKeys(it.Flush())
+
+	g.Expect(keys).To(Equal([]uint8{72, 60}))
+}
+
+func TestPlayTransposeOutOfRange(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	err := it.EvalString(":assign c 120; :bar one; c; :end\n:play one transpose=+10")
+	g.Expect(err).To(HaveOccurred())
+
+	var perr *balafon.EvalError
+	g.Expect(errors.As(err, &perr)).To(BeTrue())
+	g.Expect(perr.Error()).To(Equal("2:1: error: transposed note key must be in range [0, 127], got: 130"))
+}
+
+func TestChords(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		keys  []uint8
+	}{
+		{":assign c 60; {C}", []uint8{60, 64, 67}},
+		{":assign c 60; {Cmaj7}", []uint8{60, 64, 67, 71}},
+		{":assign c 60; {C#m}", []uint8{61, 64, 68}},
+		{":assign d 62; {Dm/F}", []uint8{53, 62, 65, 69}},
+		{":assign d 62; {D/D}", []uint8{50, 62, 66, 69}},
+		{"{Bb:2}", []uint8{46, 50, 53}},
+		{":key F; :assign b 71; {B}", []uint8{71, 75, 78}},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			keys, _ := noteKeys(it.Flush())
+
+			g.Expect(keys).To(Equal(tc.keys))
+		})
+	}
+}
+
+func TestChordEvents(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(":assign c 60; :time 2 4; {C}8. c16 -")).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(1))
+	g.Expect(bars[0].String()).To(Equal(`time: 2/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 2/4
+track: 1 pos: 0 dur: 720 note: {C}8. message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 0 dur: 720 note: {C}8. message: NoteOn channel: 0 key: 64 velocity: 100
+track: 1 pos: 0 dur: 720 note: {C}8. message: NoteOn channel: 0 key: 67 velocity: 100
+track: 1 pos: 720 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 720 dur: 0 message: NoteOff channel: 0 key: 64
+track: 1 pos: 720 dur: 0 message: NoteOff channel: 0 key: 67
+track: 1 pos: 720 dur: 240 note: c16 message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 960 dur: 960 note: - message: UnknownType
+`))
+}
+
+func TestChordError(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{"{C}", "chord root 'c' undefined"},
+		{"{C7:9}", "chord 'C7:9' key must be in range [0, 127], got: 130"},
+		{":assign c 60; [{C}]#", "sharp or flat property not allowed on chord 'C'"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			err := it.EvalString(tc.input)
+			g.Expect(err).To(HaveOccurred())
+
+			var perr *balafon.EvalError
+			g.Expect(errors.As(err, &perr)).To(BeTrue())
+			g.Expect(perr.Error()).To(HaveSuffix(tc.err))
+		})
+	}
+}
+
+func TestInexactTupletError(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	err := it.EvalString(":assign c 60\n[ccccccc]16/7:4")
+	g.Expect(err).To(HaveOccurred())
+
+	var perr *balafon.EvalError
+	g.Expect(errors.As(err, &perr)).To(BeTrue())
+	g.Expect(perr.Error()).To(Equal("2:2: error: note 'c16/7:4' length can't be represented at 960 ticks per quarter note"))
+}
+
+func TestDynamics(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected string
+	}{
+		{
+			":assign c 60; :time 1 4; :dyn pp; c; :dynamics ff=120; :dyn ff; c>",
+			`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 0 dynamic: pp message: UnknownType
+track: 1 pos: 0 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 33
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+time: 1/4
+events:
+track: 1 pos: 0 dur: 0 dynamic: ff message: UnknownType
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 960 note: c> message: NoteOn channel: 0 key: 60 velocity: 125
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+`,
+		},
+		{
+			":assign c 60; :time 2 4; :dyn p; :cresc f; [cccc]8; c2",
+			`time: 2/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 2/4
+track: 1 pos: 0 dur: 0 dynamic: p message: UnknownType
+track: 1 pos: 0 dur: 0 wedge: crescendo message: UnknownType
+track: 1 pos: 0 dur: 480 note: c8 message: NoteOn channel: 0 key: 60 velocity: 49
+track: 1 pos: 480 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 480 dur: 480 note: c8 message: NoteOn channel: 0 key: 60 velocity: 61
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 960 dur: 480 note: c8 message: NoteOn channel: 0 key: 60 velocity: 73
+track: 1 pos: 1440 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 1440 dur: 480 note: c8 message: NoteOn channel: 0 key: 60 velocity: 84
+track: 1 pos: 1920 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 1920 dur: 0 dynamic: f wedge: stop message: UnknownType
+time: 2/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 2/4
+track: 1 pos: 0 dur: 1920 note: c2 message: NoteOn channel: 0 key: 60 velocity: 96
+track: 1 pos: 1920 dur: 0 message: NoteOff channel: 0 key: 60
+`,
+		},
+		{
+			":assign c 60; :time 2 4; :dyn p; :bar one :cresc f; [cccc]8 :end; :play one velocity=-20",
+			`time: 2/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 2/4
+track: 1 pos: 0 dur: 0 dynamic: p message: UnknownType
+track: 1 pos: 0 dur: 0 wedge: crescendo message: UnknownType
+track: 1 pos: 0 dur: 480 note: c8 message: NoteOn channel: 0 key: 60 velocity: 29
+track: 1 pos: 480 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 480 dur: 480 note: c8 message: NoteOn channel: 0 key: 60 velocity: 41
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 960 dur: 480 note: c8 message: NoteOn channel: 0 key: 60 velocity: 53
+track: 1 pos: 1440 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 1440 dur: 480 note: c8 message: NoteOn channel: 0 key: 60 velocity: 64
+track: 1 pos: 1920 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 1920 dur: 0 dynamic: f wedge: stop message: UnknownType
+`,
+		},
+		{
+			":assign c 60; :time 1 4; :dyn mf; :dim p cc=11; c",
+			`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 0 dynamic: mf message: UnknownType
+track: 1 pos: 0 dur: 0 wedge: diminuendo message: UnknownType
+track: 1 pos: 0 dur: 0 message: ControlChange channel: 0 controller: 11 value: 80
+track: 1 pos: 0 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 80
+track: 1 pos: 120 dur: 0 message: ControlChange channel: 0 controller: 11 value: 76
+track: 1 pos: 240 dur: 0 message: ControlChange channel: 0 controller: 11 value: 72
+track: 1 pos: 360 dur: 0 message: ControlChange channel: 0 controller: 11 value: 68
+track: 1 pos: 480 dur: 0 message: ControlChange channel: 0 controller: 11 value: 65
+track: 1 pos: 600 dur: 0 message: ControlChange channel: 0 controller: 11 value: 61
+track: 1 pos: 720 dur: 0 message: ControlChange channel: 0 controller: 11 value: 57
+track: 1 pos: 840 dur: 0 message: ControlChange channel: 0 controller: 11 value: 53
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 960 dur: 0 message: ControlChange channel: 0 controller: 11 value: 49
+track: 1 pos: 960 dur: 0 dynamic: p wedge: stop message: UnknownType
+`,
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			var s strings.Builder
+			for _, bar := range it.Flush() {
+				s.WriteString(bar.String())
+			}
+
+			g.Expect(s.String()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestControlRamps(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected string
+	}{
+		{
+			":assign c 60; :time 1 4; :control 1 0 -> 120; c",
+			`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 0 message: ControlChange channel: 0 controller: 1 value: 0
+track: 1 pos: 0 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 240 dur: 0 message: ControlChange channel: 0 controller: 1 value: 30
+track: 1 pos: 480 dur: 0 message: ControlChange channel: 0 controller: 1 value: 60
+track: 1 pos: 720 dur: 0 message: ControlChange channel: 0 controller: 1 value: 90
+track: 1 pos: 960 dur: 0 message: ControlChange channel: 0 controller: 1 value: 120
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+`,
+		},
+		{
+			":assign c 60; :time 1 4; :control 1 0 -> 127 log; c",
+			`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 0 message: ControlChange channel: 0 controller: 1 value: 0
+track: 1 pos: 0 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 240 dur: 0 message: ControlChange channel: 0 controller: 1 value: 85
+track: 1 pos: 480 dur: 0 message: ControlChange channel: 0 controller: 1 value: 106
+track: 1 pos: 720 dur: 0 message: ControlChange channel: 0 controller: 1 value: 118
+track: 1 pos: 960 dur: 0 message: ControlChange channel: 0 controller: 1 value: 127
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+`,
+		},
+		{
+			":assign c 60; :time 1 4; :control 1 0 -> 127 exp; c",
+			`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 0 message: ControlChange channel: 0 controller: 1 value: 0
+track: 1 pos: 0 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 240 dur: 0 message: ControlChange channel: 0 controller: 1 value: 4
+track: 1 pos: 480 dur: 0 message: ControlChange channel: 0 controller: 1 value: 15
+track: 1 pos: 720 dur: 0 message: ControlChange channel: 0 controller: 1 value: 45
+track: 1 pos: 960 dur: 0 message: ControlChange channel: 0 controller: 1 value: 127
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+`,
+		},
+		{
+			":assign c 60; :time 1 4; :control 7 100 -> 101; c",
+			`time: 1/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 1 pos: 0 dur: 0 message: ControlChange channel: 0 controller: 7 value: 100
+track: 1 pos: 0 dur: 960 note: c message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 480 dur: 0 message: ControlChange channel: 0 controller: 7 value: 101
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+`,
+		},
+		{
+			":assign c 60; :time 1 4; :bar a; :control 1 0 -> 4; c; :end; :play a channel=2",
+			`time: 1/4
+events:
+track: 2 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 2 pos: 0 dur: 0 message: ControlChange channel: 1 controller: 1 value: 0
+track: 2 pos: 0 dur: 960 note: c message: NoteOn channel: 1 key: 60 velocity: 100
+track: 2 pos: 240 dur: 0 message: ControlChange channel: 1 controller: 1 value: 1
+track: 2 pos: 480 dur: 0 message: ControlChange channel: 1 controller: 1 value: 2
+track: 2 pos: 720 dur: 0 message: ControlChange channel: 1 controller: 1 value: 3
+track: 2 pos: 960 dur: 0 message: ControlChange channel: 1 controller: 1 value: 4
+track: 2 pos: 960 dur: 0 message: NoteOff channel: 1 key: 60
+`,
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+			it.SetControlResolution(uint32(constants.TicksPerQuarter / 4))
+
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			var s strings.Builder
+			for _, bar := range it.Flush() {
+				s.WriteString(bar.String())
+			}
+
+			g.Expect(s.String()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestControlRampZeroResolution(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+	it.SetControlResolution(0)
+
+	g.Expect(it.EvalString(":assign c 60; :time 1 4; :control 1 0 -> 127; c")).To(Succeed())
+
+	var ccs int
+	for _, bar := range it.Flush() {
+		for _, ev := range bar.Events {
+			if ev.Message.GetControlChange(nil, nil, nil) {
+				ccs++
+			}
+		}
+	}
+
+	// The default resolution is a 32nd note.
+	g.Expect(ccs).To(Equal(9))
+}
+
+func TestExpressionMessages(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(":channel 2; :assign c 60; :time 1 4; :bend -8192; :pressure 64; c&100")).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(1))
+	g.Expect(bars[0].String()).To(Equal(`time: 1/4
+events:
+track: 2 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 2 pos: 0 dur: 0 message: PitchBend channel: 1 pitch: -8192 (0)
+track: 2 pos: 0 dur: 0 message: AfterTouch channel: 1 pressure: 64
+track: 2 pos: 0 dur: 960 note: c&100 message: NoteOn channel: 1 key: 60 velocity: 100
+track: 2 pos: 0 dur: 0 message: PolyAfterTouch channel: 1 key: 60 pressure: 100
+track: 2 pos: 960 dur: 0 message: NoteOff channel: 1 key: 60
+`))
+}
+
+func TestParameterNumbers(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(":channel 2; :assign c 60; :time 1 4; :rpn 0 0 2; :nrpn 1 8 64 10; c")).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(1))
+	g.Expect(bars[0].String()).To(Equal(`time: 1/4
+events:
+track: 2 pos: 0 dur: 0 message: MetaTimeSig meter: 1/4
+track: 2 pos: 0 dur: 0 message: ControlChange channel: 1 controller: 101 value: 0
+track: 2 pos: 0 dur: 0 message: ControlChange channel: 1 controller: 100 value: 0
+track: 2 pos: 0 dur: 0 message: ControlChange channel: 1 controller: 6 value: 2
+track: 2 pos: 0 dur: 0 message: ControlChange channel: 1 controller: 99 value: 1
+track: 2 pos: 0 dur: 0 message: ControlChange channel: 1 controller: 98 value: 8
+track: 2 pos: 0 dur: 0 message: ControlChange channel: 1 controller: 6 value: 64
+track: 2 pos: 0 dur: 0 message: ControlChange channel: 1 controller: 38 value: 10
+track: 2 pos: 0 dur: 960 note: c message: NoteOn channel: 1 key: 60 velocity: 100
+track: 2 pos: 960 dur: 0 message: NoteOff channel: 1 key: 60
+`))
+}
+
+func TestSysex(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(":sysex F0 7E 7F 09 01 F7")).To(Succeed())
+	g.Expect(it.EvalFile("testdata/sysex/main.bal")).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(1))
+	g.Expect(bars[0].String()).To(Equal(`time: 4/4
+events:
+track: 1 pos: 0 dur: 0 message: SysExType data: 7E 7F 09 01
+track: 1 pos: 0 dur: 0 message: SysExType data: 7E 7F 09 01
+track: 1 pos: 0 dur: 0 message: SysExType data: 43 10 4C 00 00 7E 00
+`))
+}
+
+func TestSysexFileError(t *testing.T) {
+	for _, tc := range []struct {
+		file   string
+		prefix string
+	}{
+		{
+			"testdata/sysex/invalid.bal",
+			"testdata/sysex/invalid.bal:1:1: error: invalid sysex file 'invalid.syx': sysex message must end with F7",
+		},
+		{
+			"testdata/sysex/missing.bal",
+			"testdata/sysex/missing.bal:1:1: error: open testdata/sysex/missing.syx",
+		},
+	} {
+		t.Run(tc.file, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			err := it.EvalFile(tc.file)
+			g.Expect(err).To(HaveOccurred())
+
+			var perr *balafon.EvalError
+			g.Expect(errors.As(err, &perr)).To(BeTrue())
+			g.Expect(perr.Error()).To(HavePrefix(tc.prefix))
+		})
+	}
+}
+
+func TestProgramBankSelect(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(`:channel 2; :program 4 bank=1:5; :program "Cello"`)).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(1))
+	g.Expect(bars[0].String()).To(Equal(`time: 4/4
+events:
+track: 2 pos: 0 dur: 0 message: ControlChange channel: 1 controller: 0 value: 1
+track: 2 pos: 0 dur: 0 message: ControlChange channel: 1 controller: 32 value: 5
+track: 2 pos: 0 dur: 0 message: ProgramChange channel: 1 program: 4
+track: 2 pos: 0 dur: 0 message: MetaTrackName text: "Cello"
+track: 2 pos: 0 dur: 0 message: ProgramChange channel: 1 program: 42
+`))
+}
+
+func TestKit(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(":channel 10; :assign s 40; :kit gm; :assign o 45; :channel 1; :kit latin; :channel 10; :time 3 8; [kso]8; :channel 1; c8")).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(2))
+	g.Expect(bars[0].String()).To(Equal(`time: 3/8
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 3/8
+track: 10 pos: 0 dur: 0 message: MetaTimeSig meter: 3/8
+track: 10 pos: 0 dur: 480 note: k8 message: NoteOn channel: 9 key: 36 velocity: 100
+track: 10 pos: 480 dur: 0 message: NoteOff channel: 9 key: 36
+track: 10 pos: 480 dur: 480 note: s8 message: NoteOn channel: 9 key: 40 velocity: 100
+track: 10 pos: 960 dur: 0 message: NoteOff channel: 9 key: 40
+track: 10 pos: 960 dur: 480 note: o8 message: NoteOn channel: 9 key: 45 velocity: 100
+track: 10 pos: 1440 dur: 0 message: NoteOff channel: 9 key: 45
+`))
+	g.Expect(bars[1].String()).To(Equal(`time: 3/8
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 3/8
+track: 10 pos: 0 dur: 0 message: MetaTimeSig meter: 3/8
+track: 1 pos: 0 dur: 480 note: c8 message: NoteOn channel: 0 key: 63 velocity: 100
+track: 1 pos: 480 dur: 0 message: NoteOff channel: 0 key: 63
+`))
+}
+
+func TestAbsolutePitches(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		keys   []uint8
+		isFlat []bool
+	}{
+		{"<c4> <f#3> <bb5> <c-1>", []uint8{60, 54, 82, 0}, []bool{false, false, true, false}},
+		{"<cb4> <e#4> <d4>$ <d4>#", []uint8{59, 65, 61, 63}, []bool{false, false, true, false}},
+		{":key G; <f4> <f#4> <fb4>", []uint8{66, 66, 64}, []bool{false, false, false}},
+		{":key F; <b4> <bb4> <b4>#", []uint8{70, 70, 72}, []bool{true, true, false}},
+		{":assign c 48; c <c4>", []uint8{48, 60}, []bool{false, false}},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			keys, isFlat := noteKeys(it.Flush())
+
+			g.Expect(keys).To(Equal(tc.keys))
+			g.Expect(isFlat).To(Equal(tc.isFlat))
+			g.Expect(it.Warnings()).To(BeEmpty())
+		})
+	}
+}
+
+func TestAbsolutePitchError(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	err := it.EvalString("<g9>#")
+	g.Expect(err).To(HaveOccurred())
+
+	var perr *balafon.EvalError
+	g.Expect(errors.As(err, &perr)).To(BeTrue())
+	g.Expect(perr.Error()).To(Equal("1:1: error: note key must be in range [0, 127], got: 128"))
+}
+
+func TestAbsolutePitchWarnings(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		warnings []string
+	}{
+		{":assign c 62\n:assign d 61\nc d\n<c4>", []string{
+			"3:1: warning: note 'c' is assigned to D4 and mixed with absolute pitches on channel '1'",
+		}},
+		{"<c4>\n:assign e 60\ne e\n", []string{
+			"3:1: warning: note 'e' is assigned to C4 and mixed with absolute pitches on channel '1'",
+		}},
+		{":assign c 62\nc\n:channel 2\n<c4>", nil},
+		{":assign k 36\nk <c4>", nil},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			var warnings []string
+			for _, w := range it.Warnings() {
+				warnings = append(warnings, w.String())
+			}
+
+			g.Expect(warnings).To(Equal(tc.warnings))
+		})
+	}
+}
+
+func TestOctave(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		keys  []uint8
+	}{
+		{":assign c 60; c'8 c,8 c''8 [cc,]8'", []uint8{72, 48, 84, 72, 60}},
+		{":key G; :assign f 65; f' f,$", []uint8{78, 52}},
+		{":assign c 60; :octave +1; c8 c,8 <c4>8 <c4>'8 {C} {C:4}", []uint8{72, 60, 60, 72, 72, 76, 79, 60, 64, 67}},
+		{":assign c 60; :bar a; :octave -1; c; :end; :play a; c", []uint8{48, 60}},
+		{":assign c 60; :octave -2; c; :octave 0; c", []uint8{36, 60}},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			keys, _ := noteKeys(it.Flush())
+
+			g.Expect(keys).To(Equal(tc.keys))
+		})
+	}
+}
+
+func TestOctaveError(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{":assign c 120; c'", "1:16: error: note key must be in range [0, 127], got: 132"},
+		{":assign c 60; :octave +6; c", "1:27: error: note key must be in range [0, 127], got: 132"},
+		{":assign c 5; c,", "1:14: error: note key must be in range [0, 127], got: -7"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			err := it.EvalString(tc.input)
+			g.Expect(err).To(HaveOccurred())
+
+			var perr *balafon.EvalError
+			g.Expect(errors.As(err, &perr)).To(BeTrue())
+			g.Expect(perr.Error()).To(Equal(tc.err))
+		})
+	}
+}
+
+func TestMultiCharacterSymbols(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(":channel 10; :assign @kick 36; :assign @hh_c 42; :assign k 35; :time 3 4; [@kick @hh_c]8 @kick k")).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(1))
+	g.Expect(bars[0].String()).To(Equal(`time: 3/4
+events:
+track: 10 pos: 0 dur: 0 message: MetaTimeSig meter: 3/4
+track: 10 pos: 0 dur: 480 note: @kick8 message: NoteOn channel: 9 key: 36 velocity: 100
+track: 10 pos: 480 dur: 0 message: NoteOff channel: 9 key: 36
+track: 10 pos: 480 dur: 480 note: @hh_c8 message: NoteOn channel: 9 key: 42 velocity: 100
+track: 10 pos: 960 dur: 0 message: NoteOff channel: 9 key: 42
+track: 10 pos: 960 dur: 960 note: @kick message: NoteOn channel: 9 key: 36 velocity: 100
+track: 10 pos: 1920 dur: 0 message: NoteOff channel: 9 key: 36
+track: 10 pos: 1920 dur: 960 note: k message: NoteOn channel: 9 key: 35 velocity: 100
+track: 10 pos: 2880 dur: 0 message: NoteOff channel: 9 key: 35
+`))
+}
+
+func TestMultiCharacterSymbolErrors(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{":assign @kick 36; @snare", "1:19: error: note '@snare' undefined"},
+		{":assign @kick 36; :assign @kick 35", "1:19: error: note '@kick' already assigned to key '36' on channel '0'"},
+		{":assign @hat 61; @hat#", "1:18: error: cannot use sharp/flat on note '@hat' assigned to key '61' on channel '0': already sharp"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			err := it.EvalString(tc.input)
+			g.Expect(err).To(HaveOccurred())
+
+			var perr *balafon.EvalError
+			g.Expect(errors.As(err, &perr)).To(BeTrue())
+			g.Expect(perr.Error()).To(Equal(tc.err))
+		})
+	}
+}
+
+func TestSwingAndGrooves(t *testing.T) {
+	type noteOn struct {
+		track    uint8
+		pos      uint32
+		velocity uint8
+	}
+
+	for _, tc := range []struct {
+		input string
+		notes []noteOn
+	}{
+		{
+			":assign c 60; :velocity 100; :swing 60%; c8 c8 c4 c2",
+			[]noteOn{{1, 0, 100}, {1, 576, 100}, {1, 960, 100}, {1, 1920, 100}},
+		},
+		{
+			":assign c 60; :velocity 100; :swing 75% 16; c16 c16 c16 c16 c4 c2",
+			[]noteOn{{1, 0, 100}, {1, 360, 100}, {1, 480, 100}, {1, 840, 100}, {1, 960, 100}, {1, 1920, 100}},
+		},
+		{
+			":assign c 60; :velocity 100; :groove funk16; c16 c16 c16 c16 c4 c2",
+			[]noteOn{{1, 0, 100}, {1, 259, 75}, {1, 480, 90}, {1, 748, 70}, {1, 960, 100}, {1, 1920, 100}},
+		},
+		{
+			":assign c 60; :velocity 100; :swing 60%; c8 c8 c4 c2; :swing 50%; c8 c8 c4 c2",
+			[]noteOn{{1, 0, 100}, {1, 576, 100}, {1, 960, 100}, {1, 1920, 100}, {1, 0, 100}, {1, 480, 100}, {1, 960, 100}, {1, 1920, 100}},
+		},
+		{
+			":assign c 60; :velocity 100; :groove shuffle; c8 c8 c4 c2; :groove straight; c8 c8 c4 c2",
+			[]noteOn{{1, 0, 100}, {1, 638, 80}, {1, 960, 100}, {1, 1920, 100}, {1, 0, 100}, {1, 480, 100}, {1, 960, 100}, {1, 1920, 100}},
+		},
+		{
+			":velocity 100; :channel 10; :assign k 36; :swing 60%; :channel 2; :assign c 60; :bar a; :channel 10; k8 k8 k4 k2; :channel 2; c8 c8 c4 c2; :end; :play a",
+			[]noteOn{{10, 0, 100}, {2, 0, 100}, {2, 480, 100}, {10, 576, 100}, {10, 960, 100}, {2, 960, 100}, {10, 1920, 100}, {2, 1920, 100}},
+		},
+		{
+			":velocity 100; :channel 2; :assign c 60; :swing 60%; :bar a; c8 c8 c4 c2; :end; :play a channel=3",
+			[]noteOn{{3, 0, 100}, {3, 576, 100}, {3, 960, 100}, {3, 1920, 100}},
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			var notes []noteOn
+			for _, bar := range it.Flush() {
+				on := map[uint8]uint32{}
+				for _, ev := range bar.Events {
+					var c, k, v uint8
+					switch {
+					case ev.Message.GetNoteStart(&c, &k, &v):
+						notes = append(notes, noteOn{ev.Track, ev.Pos, v})
+						on[k] = ev.Pos
+					case ev.Message.GetNoteEnd(&c, &k):
+						g.Expect(ev.Pos).To(BeNumerically(">=", on[k]))
+					}
+				}
+			}
+
+			g.Expect(notes).To(Equal(tc.notes))
+		})
+	}
+}
+
+func TestUnknownGroove(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	err := it.EvalString(":groove polka")
+	g.Expect(err).To(HaveOccurred())
+
+	var perr *balafon.EvalError
+	g.Expect(errors.As(err, &perr)).To(BeTrue())
+	g.Expect(perr.Error()).To(Equal("1:1: error: unknown groove 'polka'"))
+}
+
+func TestHumanize(t *testing.T) {
+	type note struct {
+		pos, dur uint32
+		velocity uint8
+	}
+
+	eval := func(g *WithT, input string) []note {
+		it := balafon.New()
+		g.Expect(it.EvalString(input)).To(Succeed())
+
+		bars := it.Flush()
+		g.Expect(bars).To(HaveLen(1))
+
+		var (
+			notes []note
+			open  = map[uint8]int{}
+		)
+		for _, ev := range bars[0].Events {
+			var c, k, v uint8
+			switch {
+			case ev.Message.GetNoteStart(&c, &k, &v):
+				open[k] = len(notes)
+				notes = append(notes, note{pos: ev.Pos, velocity: v})
+			case ev.Message.GetNoteEnd(&c, &k):
+				n := &notes[open[k]]
+				n.dur = ev.Pos - n.pos
+			}
+		}
+
+		return notes
+	}
+
+	const input = ":assign c 60; :assign d 62; :velocity 100; :humanize timing=40 velocity=8 seed=42 %s; c8 d8 c8 d8 c8 d8 c8 d8"
+
+	t.Run("timing and velocity", func(t *testing.T) {
+		g := NewWithT(t)
+
+		notes := eval(g, fmt.Sprintf(input, ""))
+		g.Expect(notes).To(HaveLen(8))
+
+		moved := 0
+		for i, n := range notes {
+			g.Expect(n.dur).To(BeEquivalentTo(480), "note on and note off move together")
+			g.Expect(int(n.pos)).To(BeNumerically("~", i*480, 40))
+			g.Expect(int(n.velocity)).To(BeNumerically("~", 100, 8))
+			if n.pos != uint32(i*480) {
+				moved++
+			}
+		}
+		g.Expect(moved).To(BeNumerically(">", 0))
+	})
+
+	t.Run("same seed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		a, err := balafon.ToSMF([]byte(fmt.Sprintf(input, "")))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		b, err := balafon.ToSMF([]byte(fmt.Sprintf(input, "")))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		c, err := balafon.ToSMF([]byte(strings.Replace(fmt.Sprintf(input, ""), "seed=42", "seed=43", 1)))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		g.Expect(a.Tracks).To(Equal(b.Tracks))
+		g.Expect(a.Tracks).NotTo(Equal(c.Tracks))
+	})
+
+	t.Run("locked grid", func(t *testing.T) {
+		g := NewWithT(t)
+
+		notes := eval(g, fmt.Sprintf(input, "lock=4"))
+		g.Expect(notes).To(HaveLen(8))
+
+		for i, n := range notes {
+			if i%2 == 0 {
+				g.Expect(n).To(Equal(note{pos: uint32(i * 480), dur: 480, velocity: 100}))
+			}
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		g := NewWithT(t)
+
+		notes := eval(g, ":assign c 60; :velocity 100; :humanize timing=40 velocity=8; :humanize timing=0 velocity=0; c4 c4 c4 c4")
+		g.Expect(notes).To(Equal([]note{{0, 960, 100}, {960, 960, 100}, {1920, 960, 100}, {2880, 960, 100}}))
+	})
+
+	t.Run("across bar lines", func(t *testing.T) {
+		g := NewWithT(t)
+
+		it := balafon.New()
+		g.Expect(it.EvalString(":assign c 60; :humanize timing=40 seed=1; c1; c1; c1")).To(Succeed())
+
+		s := balafon.NewSequencer()
+		s.AddBars(it.Flush()...)
+
+		var (
+			on    uint32
+			notes int
+		)
+		for _, ev := range s.Flush() {
+			switch {
+			case ev.Message.GetNoteStart(nil, nil, nil):
+				on = ev.AbsTicks
+			case ev.Message.GetNoteEnd(nil, nil):
+				// Each note ends in its own bar after its note on.
+				g.Expect(ev.AbsTicks).To(BeNumerically(">", on))
+				g.Expect(ev.AbsTicks).To(BeNumerically("<=", (on/3840+1)*3840))
+				notes++
+			}
+		}
+		g.Expect(notes).To(Equal(3))
+	})
+}
+
+func TestChanceNotes(t *testing.T) {
+	countNotes := func(bars []*balafon.Bar) (notes, rests int) {
+		for _, bar := range bars {
+			for _, ev := range bar.Events {
+				switch {
+				case ev.Message.GetNoteStart(nil, nil, nil):
+					notes++
+				case ev.Note != nil && ev.Note.IsPause():
+					rests++
+				}
+			}
+		}
+		return notes, rests
+	}
+
+	for _, tc := range []struct {
+		input string
+		notes int
+		rests int
+	}{
+		{":assign x 42; x?100 x?100 x?100 x?100", 4, 0},
+		{":assign x 42; x?0 x?0 x?100 x?0", 1, 3},
+		{":assign x 42; :assign c 60; {C}?0 x x x", 3, 1},
+		{":assign x 42; [x x]8?0 x x x", 3, 2},
+		{":assign x 42; x -?0 x x", 3, 1},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			notes, rests := countNotes(it.Flush())
+			g.Expect(notes).To(Equal(tc.notes))
+			g.Expect(rests).To(Equal(tc.rests))
+		})
+	}
+
+	t.Run("seed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		const input = ":assign x 42\n:bar a\nx8?50 x8?50 x8?50 x8?50 x8?50 x8?50 x8?50 x8?50\n:end\n:repeat 8\n:play a\n:end"
+
+		realize := func(seed uint64) []*balafon.Bar {
+			it := balafon.New()
+			it.SetSeed(seed)
+			g.Expect(it.EvalString(input)).To(Succeed())
+			return it.Flush()
+		}
+
+		a, b, c := realize(1), realize(1), realize(2)
+		g.Expect(a).To(Equal(b))
+		g.Expect(a).NotTo(Equal(c))
+
+		notes, rests := countNotes(a)
+		g.Expect(notes + rests).To(Equal(64))
+		g.Expect(notes).To(BeNumerically(">", 0))
+		g.Expect(rests).To(BeNumerically(">", 0))
+
+		// The replays of the bar differ.
+		g.Expect(a[0].Events).NotTo(Equal(a[1].Events))
+	})
+}
+
+func TestPlayEvery(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+	g.Expect(it.EvalString(":assign c 60\n:assign d 62\n:bar beat\nc1\n:end\n:bar fill\nd1\n:end\n:repeat 8\n:play beat\n:play fill every=4\n:end")).To(Succeed())
+
+	keys, _ := noteKeys(it.Flush())
+
+	g.Expect(keys).To(Equal([]uint8{60, 60, 60, 60, 62, 60, 60, 60, 60, 62}))
+}
+
+func TestPlayEverySeparateInputs(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+	g.Expect(it.EvalString(":assign c 60\n:assign d 62\n:bar a\nc1\n:end\n:bar b\nd1\n:end")).To(Succeed())
+
+	var keys []uint8
+	for range 4 {
+		for _, input := range []string{":play a every=2", ":play b every=2"} {
+			g.Expect(it.EvalString(input)).To(Succeed())
+
+			played, _ := noteKeys(it.Flush())
+			keys = append(keys, played...)
+		}
+	}
+
+	g.Expect(keys).To(Equal([]uint8{60, 62, 60, 62}))
+}
+
+func TestPlayEveryIntervalsOfSameBar(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+	g.Expect(it.EvalString(":assign c 60\n:bar a\nc1\n:end")).To(Succeed())
+
+	var played []string
+	for range 4 {
+		for _, input := range []string{":play a every=2", ":play a every=4"} {
+			g.Expect(it.EvalString(input)).To(Succeed())
+
+			if keys, _ := noteKeys(it.Flush()); len(keys) > 0 {
+				played = append(played, input)
+			}
+		}
+	}
+
+	g.Expect(played).To(Equal([]string{":play a every=2", ":play a every=2", ":play a every=4"}))
+}
+
+func TestMeterError(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	err := it.EvalString(":assign c 60; :meter 3 4; cccc")
+	g.Expect(err).To(HaveOccurred())
+
+	var perr *balafon.EvalError
+	g.Expect(errors.As(err, &perr)).To(BeTrue())
+	g.Expect(perr.Error()).To(Equal("1:27: error: bar too long by 960 ticks, timesig is 3/4"))
+}
+
+func TestStaffMode(t *testing.T) {
+	type note struct {
+		pos      uint32
+		note     string
+		tieStart bool
+		tieStop  bool
+	}
+
+	for _, tc := range []struct {
+		input string
+		bars  [][]note
+	}{
+		{
+			":assign c 60; :staff on; c2 c2 c2",
+			[][]note{
+				{{0, "c2", false, false}, {1920, "c2", false, false}},
+				{{0, "c2", false, false}},
+			},
+		},
+		{
+			":assign c 60; :time 3 4; :staff on; c2 c2",
+			[][]note{
+				{{0, "c2", false, false}, {1920, "c4", true, false}},
+				{{0, "c4", false, true}},
+			},
+		},
+		{
+			":assign c 60; :time 3 4; :staff on; c4 c1. c4",
+			[][]note{
+				{{0, "c4", false, false}, {960, "c2", true, false}},
+				{{0, "c2.", true, true}},
+				{{0, "c4", false, true}, {960, "c4", false, false}},
+			},
+		},
+		{
+			":assign c 60; :staff on; c2. -2 c4",
+			[][]note{
+				{{0, "c2.", false, false}, {2880, "-4", false, false}},
+				{{0, "-4", false, false}, {960, "c4", false, false}},
+			},
+		},
+		{
+			":assign c 60; :time 2 4; :staff on; c4. c4~ c4",
+			[][]note{
+				{{0, "c4.", false, false}, {1440, "c8~", true, false}},
+				{{0, "c8~", true, true}, {480, "c4", false, true}},
+			},
+		},
+		{
+			":assign c 60; :staff on; c1; :staff off; c2 c2",
+			[][]note{
+				{{0, "c1", false, false}},
+				{{0, "c2", false, false}, {1920, "c2", false, false}},
+			},
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			var bars [][]note
+			for _, bar := range it.Flush() {
+				var notes []note
+				for _, ev := range bar.Events {
+					if ev.Note == nil {
+						continue
+					}
+					var buf strings.Builder
+					_, err := ev.Note.WriteTo(&buf)
+					g.Expect(err).NotTo(HaveOccurred())
+					notes = append(notes, note{ev.Pos, buf.String(), ev.TieStart, ev.TieStop})
+				}
+				if notes != nil {
+					bars = append(bars, notes)
+				}
+			}
+
+			g.Expect(bars).To(Equal(tc.bars))
+		})
+	}
+}
+
+func TestStaffModePickup(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+	g.Expect(it.EvalString(":assign c 60; :time 3 4; :staff on; :pickup; c4 c2 c2 c2")).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(3))
+
+	g.Expect(bars[0].IsPickup()).To(BeTrue())
+	g.Expect(bars[0].Cap()).To(BeEquivalentTo(960))
+	g.Expect(bars[1].IsPickup()).To(BeFalse())
+	g.Expect(bars[2].IsPickup()).To(BeFalse())
+
+	g.Expect(bars[0].String()).To(Equal(`time: 3/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 3/4
+track: 1 pos: 0 dur: 960 note: c4 message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+`))
+
+	g.Expect(bars[1].String()).To(Equal(`time: 3/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 3/4
+track: 1 pos: 0 dur: 1920 note: c2 message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 1920 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 1920 dur: 960 tie: start note: c4 message: NoteOn channel: 0 key: 60 velocity: 100
+`))
+
+	g.Expect(bars[2].String()).To(Equal(`time: 3/4
+events:
+track: 1 pos: 0 dur: 0 message: MetaTimeSig meter: 3/4
+track: 1 pos: 0 dur: 960 tie: stop note: c4 message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 960 dur: 0 message: NoteOff channel: 0 key: 60
+track: 1 pos: 960 dur: 1920 note: c2 message: NoteOn channel: 0 key: 60 velocity: 100
+track: 1 pos: 2880 dur: 0 message: NoteOff channel: 0 key: 60
+`))
+}
+
+func TestStaffModeChance(t *testing.T) {
+	for seed := range uint64(16) {
+		t.Run(fmt.Sprintf("seed %d", seed), func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+			it.SetSeed(seed)
+			g.Expect(it.EvalString(":assign c 60; :time 3 4; :staff on; c2 c1?50 c4")).To(Succeed())
+
+			bars := it.Flush()
+			g.Expect(bars).To(HaveLen(3))
+
+			var ons, rests int
+			for i, bar := range bars {
+				for _, ev := range bar.Events {
+					switch {
+					case ev.Message.GetNoteStart(nil, nil, nil) && i == 2:
+						g.Expect(ev.TieStop).To(BeFalse(), "the last note is not tied")
+					case ev.Message.GetNoteStart(nil, nil, nil) && ev.Pos > 0:
+						ons++
+					case ev.Message.GetNoteStart(nil, nil, nil) && i == 1:
+						g.Expect(ev.TieStop).To(BeTrue())
+						ons++
+					case ev.Note != nil && ev.Note.IsPause():
+						rests++
+					}
+				}
+			}
+
+			// Both pieces of the split note are played or replaced with rests.
+			g.Expect([]int{ons, rests}).To(Or(Equal([]int{2, 0}), Equal([]int{0, 2})))
+		})
+	}
+}
+
+func TestStaffModeErrors(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		errMsg string
+	}{
+		{
+			":assign c 60; c1 c4",
+			"1:15: error: bar too long by 960 ticks, timesig is 4/4",
+		},
+		{
+			":assign c 60; :staff on; :staff off; c1 c4",
+			"1:38: error: bar too long by 960 ticks, timesig is 4/4",
+		},
+		{
+			":assign c 60; :staff on; c2 [ccc]1/3 c2",
+			"1:30: error: note 'c1/3' crossing the bar line can't be split into notes without tuplets",
+		},
+		{
+			":bar a; :staff on; :end",
+			"1:9: error: command 'staff' not allowed in bar",
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			err := it.EvalString(tc.input)
+			g.Expect(err).To(HaveOccurred())
+
+			var perr *balafon.EvalError
+			g.Expect(errors.As(err, &perr)).To(BeTrue())
+			g.Expect(perr.Error()).To(Equal(tc.errMsg))
+		})
+	}
+}
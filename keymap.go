@@ -2,39 +2,62 @@ package balafon
 
 type midiKey struct {
 	channel Channel
-	note    rune
+	note    string
 }
 
 // keyMap is a note keymap.
 type keyMap struct {
-	m map[midiKey]int
+	m   map[midiKey]int
+	kit map[midiKey]int // kit assignments that can be overridden
 }
 
 // newKeyMap creates a new keymap.
 func newKeyMap() *keyMap {
 	return &keyMap{
-		m: map[midiKey]int{},
+		m:   map[midiKey]int{},
+		kit: map[midiKey]int{},
 	}
 }
 
 // Range loops over the mapped keys.
-func (m *keyMap) Range(f func(channel Channel, note rune, key int)) {
+func (m *keyMap) Range(f func(channel Channel, note string, key int)) {
+	for k, v := range m.kit {
+		if _, ok := m.m[k]; !ok {
+			f(k.channel, k.note, v)
+		}
+	}
 	for k, v := range m.m {
 		f(k.channel, k.note, v)
 	}
 }
 
 // Get a note key on channel.
-func (m *keyMap) Get(channel Channel, note rune) (key int, exists bool) {
-	key, ok := m.m[midiKey{channel, note}]
+func (m *keyMap) Get(channel Channel, note string) (key int, exists bool) {
+	if key, ok := m.m[midiKey{channel, note}]; ok {
+		return key, true
+	}
+	key, ok := m.kit[midiKey{channel, note}]
 	return key, ok
 }
 
 // Set a note key on channel.
-func (m *keyMap) Set(channel Channel, note rune, key int) (success bool) {
+func (m *keyMap) Set(channel Channel, note string, key int) (success bool) {
 	if _, exists := m.m[midiKey{channel, note}]; exists {
 		return false
 	}
 	m.m[midiKey{channel, note}] = key
 	return true
 }
+
+// SetKit assigns the notes of a kit on channel.
+// Kit assignments replace previous kit assignments and are overridden by notes assigned with Set.
+func (m *keyMap) SetKit(channel Channel, kit map[string]uint8) {
+	for k := range m.kit {
+		if k.channel == channel {
+			delete(m.kit, k)
+		}
+	}
+	for note, key := range kit {
+		m.kit[midiKey{channel, note}] = int(key)
+	}
+}
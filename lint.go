@@ -0,0 +1,86 @@
+package balafon
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mgnsk/balafon/internal/ast"
+)
+
+// letterNote is the first use of a note letter assigned to a different pitch.
+type letterNote struct {
+	note *ast.Note
+	key  int
+}
+
+// linter collects lint warnings.
+type linter struct {
+	warnings    []*Warning
+	absPitches  map[Channel]bool
+	letterNotes map[Channel][]letterNote
+}
+
+func newLinter() *linter {
+	return &linter{
+		absPitches:  map[Channel]bool{},
+		letterNotes: map[Channel][]letterNote{},
+	}
+}
+
+// lintPitch warns about the note letters assigned to a different pitch
+// when absolute pitches are first used on the channel.
+func (l *linter) lintPitch(channel Channel) {
+	if l.absPitches[channel] {
+		return
+	}
+
+	l.absPitches[channel] = true
+
+	for _, n := range l.letterNotes[channel] {
+		l.warnLetter(channel, n)
+	}
+}
+
+// lintLetter warns about a note letter assigned to a different pitch
+// when absolute pitches are used on the channel.
+func (l *linter) lintLetter(channel Channel, note *ast.Note, key int) {
+	if len(note.Name) != 1 || note.Name < "a" || note.Name > "g" {
+		return
+	}
+
+	letter := strings.ToUpper(note.Name)
+
+	step, _ := getPitch(key)
+	if strings.HasPrefix(step, letter) {
+		return
+	}
+
+	if isBlackKey(key) {
+		if next, _ := getPitch(key + 1); next == letter {
+			return
+		}
+	}
+
+	if slices.ContainsFunc(l.letterNotes[channel], func(n letterNote) bool {
+		return n.note.Name == note.Name
+	}) {
+		return
+	}
+
+	n := letterNote{note: note, key: key}
+	l.letterNotes[channel] = append(l.letterNotes[channel], n)
+
+	if l.absPitches[channel] {
+		l.warnLetter(channel, n)
+	}
+}
+
+func (l *linter) warnLetter(channel Channel, n letterNote) {
+	step, octave := getPitch(n.key)
+
+	l.warnings = append(l.warnings, &Warning{
+		Msg: fmt.Sprintf("note '%s' is assigned to %s%d and mixed with absolute pitches on channel '%d'", n.note.Name, step, octave, channel.Human()),
+		Pos: n.note.Pos,
+	})
+}
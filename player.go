@@ -3,6 +3,7 @@ package balafon
 import (
 	"time"
 
+	"gitlab.com/gomidi/midi/v2"
 	"gitlab.com/gomidi/midi/v2/drivers"
 )
 
@@ -32,7 +33,7 @@ func (p *Player) Play(events ...TrackEvent) error {
 			time.Sleep(time.Duration(delta))
 			last = ev.AbsNanoseconds
 		}
-		if ev.Message.IsPlayable() {
+		if ev.Message.IsPlayable() || ev.Message.Is(midi.SysExMsg) {
 			if err := p.out.Send(ev.Message); err != nil {
 				return err
 			}
@@ -0,0 +1,30 @@
+package balafon_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mgnsk/balafon"
+	. "github.com/onsi/gomega"
+	"gitlab.com/gomidi/midi/v2"
+)
+
+func TestPlayerSysex(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+	g.Expect(it.EvalString(":sysex F0 7E 7F 09 01 F7; :control 1 2")).To(Succeed())
+
+	s := balafon.NewSequencer()
+	s.AddBars(it.Flush()...)
+
+	buf := &bytes.Buffer{}
+
+	p := balafon.NewPlayer(&out{buf: buf})
+	g.Expect(p.Play(s.Flush()...)).To(Succeed())
+
+	g.Expect(buf.Bytes()).To(Equal(append(
+		[]byte(midi.SysEx([]byte{0x7E, 0x7F, 0x09, 0x01})),
+		midi.ControlChange(0, 1, 2)...,
+	)))
+}
@@ -2,7 +2,11 @@ package balafon
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
+	"github.com/mgnsk/balafon/internal/ast"
 	"gitlab.com/gomidi/midi/v2/smf"
 )
 
@@ -84,3 +88,194 @@ func getScale(scale string) (func() smf.Message, []string, []string) {
 		panic(fmt.Sprintf("invalid scale %q", scale))
 	}
 }
+
+// keySig is the key of a channel.
+type keySig struct {
+	signature string   // the nearest standard key signature
+	tonic     int      // pitch class of the tonic
+	intervals []int    // scale steps in semitones above the tonic
+	sharps    []string // the note letters raised by the key
+	flats     []string // the note letters lowered by the key
+}
+
+var defaultKeySig = keySig{
+	signature: "C",
+	intervals: ast.Modes["major"],
+}
+
+// signatures are the standard major key signatures with their relative minor keys.
+var signatures = [][2]string{
+	{"C", "Am"},
+	{"G", "Em"},
+	{"F", "Dm"},
+	{"D", "Bm"},
+	{"Bb", "Gm"},
+	{"A", "F#m"},
+	{"Eb", "Cm"},
+	{"E", "C#m"},
+	{"Ab", "Fm"},
+	{"B", "G#m"},
+	{"Db", "Bbm"},
+	{"F#", "D#m"},
+	{"Gb", "Ebm"},
+}
+
+// newKeySig creates a key from a key command. Keys that are not standard key signatures
+// use the nearest standard key signature.
+func newKeySig(cmd ast.CmdKey, customScales map[string][]int) (keySig, error) {
+	if cmd.Mode == "" {
+		mode := "major"
+		if strings.HasSuffix(cmd.Key, "m") {
+			mode = "minor"
+		}
+
+		_, sharps, flats := getScale(cmd.Key)
+
+		return keySig{
+			signature: cmd.Key,
+			tonic:     pitchClass(strings.TrimSuffix(cmd.Key, "m")),
+			intervals: ast.Modes[mode],
+			sharps:    sharps,
+			flats:     flats,
+		}, nil
+	}
+
+	tonic := pitchClass(cmd.Key)
+
+	if intervals, ok := ast.Modes[cmd.Mode]; ok {
+		signature := nearestSignature(tonic, intervals)
+		_, sharps, flats := getScale(signature)
+
+		return keySig{
+			signature: signature,
+			tonic:     tonic,
+			intervals: intervals,
+			sharps:    sharps,
+			flats:     flats,
+		}, nil
+	}
+
+	intervals, ok := customScales[cmd.Mode]
+	if !ok {
+		return keySig{}, &EvalError{
+			Err: fmt.Errorf("unknown scale '%s'", cmd.Mode),
+			Pos: cmd.Pos,
+		}
+	}
+
+	signature := nearestSignature(tonic, intervals)
+	sharps, flats := scaleAccidentals(tonic, intervals, signature)
+
+	return keySig{
+		signature: signature,
+		tonic:     tonic,
+		intervals: intervals,
+		sharps:    sharps,
+		flats:     flats,
+	}, nil
+}
+
+// scaleAccidentals returns the note letters raised or lowered to the notes of a custom scale.
+// A black key of the scale is spelled on a neighboring letter that is not in the scale,
+// preferring flats if the nearest key signature has flats. A black key between two notes
+// of the scale is written with an accidental.
+func scaleAccidentals(tonic int, intervals []int, signature string) (sharps, flats []string) {
+	inScale := map[int]bool{}
+	for _, interval := range intervals {
+		inScale[(tonic+interval)%12] = true
+	}
+
+	_, _, sigFlats := getScale(signature)
+	preferFlat := len(sigFlats) > 0
+
+	used := map[string]bool{}
+
+	for _, pc := range slices.Sorted(maps.Keys(inScale)) {
+		if !isBlackKey(pc) {
+			continue
+		}
+
+		below, above := notes[pc-1], notes[(pc+1)%12]
+		canSharp := !inScale[pc-1] && !used[below]
+		canFlat := !inScale[(pc+1)%12] && !used[above]
+
+		switch {
+		case canFlat && (preferFlat || !canSharp):
+			flats = append(flats, above)
+			used[above] = true
+		case canSharp:
+			sharps = append(sharps, below)
+			used[below] = true
+		}
+	}
+
+	return sharps, flats
+}
+
+// nearestSignature returns the standard key signature that shares the most pitch classes with a scale.
+// Ties are resolved by preferring a major or minor key on the same tonic and then by fewer accidentals.
+func nearestSignature(tonic int, intervals []int) string {
+	var (
+		best      string
+		bestScore = -1
+	)
+
+	for _, sig := range signatures {
+		pitches := signaturePitches(sig[0])
+
+		score := 0
+		for _, interval := range intervals {
+			if pitches[(tonic+interval)%12] {
+				score++
+			}
+		}
+		score *= 2
+
+		name := sig[0]
+		switch tonic {
+		case pitchClass(sig[0]):
+			score++
+		case pitchClass(strings.TrimSuffix(sig[1], "m")):
+			name = sig[1]
+			score++
+		}
+
+		// Signatures are ordered by the number of accidentals.
+		if score > bestScore {
+			best, bestScore = name, score
+		}
+	}
+
+	return best
+}
+
+// signaturePitches returns the pitch classes of the major scale of a key signature.
+func signaturePitches(signature string) map[int]bool {
+	_, sharps, flats := getScale(signature)
+
+	pitches := map[int]bool{}
+	for _, step := range []string{"C", "D", "E", "F", "G", "A", "B"} {
+		pc := pitchClass(step)
+		switch {
+		case slices.Contains(sharps, step):
+			pc++
+		case slices.Contains(flats, step):
+			pc--
+		}
+		pitches[(pc+12)%12] = true
+	}
+
+	return pitches
+}
+
+// pitchClass returns the pitch class of a note name such as C, F# or Bb.
+func pitchClass(name string) int {
+	pc := slices.Index(notes, name[:1])
+	switch name[1:] {
+	case "#":
+		pc++
+	case "b":
+		pc--
+	}
+	return (pc + 12) % 12
+}
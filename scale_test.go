@@ -91,3 +91,104 @@ b$
 		g.Expect(err.Error()).To(ContainSubstring("already flat"))
 	})
 }
+
+func TestModesAndCustomScales(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		keys   []uint8
+		isFlat []bool
+		fifths int
+		mode   string
+	}{
+		{":key D dorian; :assign f 65; :assign b 71; f b", []uint8{65, 71}, []bool{false, false}, 0, "major"},
+		{":key A mixolydian; :assign f 65; :assign c 60; :assign g 67; f c g", []uint8{66, 61, 67}, []bool{false, false, false}, 2, "major"},
+		{":key E phrygian; :assign f 65; f", []uint8{65}, []bool{false}, 0, "major"},
+		{":key A aeolian; :assign g 67; g", []uint8{67}, []bool{false}, 0, "minor"},
+		{":key G# phrygian; :assign g 67; g", []uint8{68}, []bool{false}, 4, "major"},
+		{":scale blues 0 3 5 6 7 10; :key C blues; :assign e 64; :assign b 71; e b", []uint8{63, 70}, []bool{true, true}, -3, "minor"},
+		{":scale blues 0 3 5 6 7 10; :key C blues; :assign a 69; :assign f 65; a f f#", []uint8{69, 65, 66}, []bool{false, false, false}, -3, "minor"},
+		{":scale lydian7 0 2 4 6 7 9 10; :key D lydian7; :assign f 65; :assign g 67; :assign c 60; f g c", []uint8{66, 68, 60}, []bool{false, false, false}, 1, "major"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			{
+				it := balafon.New()
+				g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+				keys, isFlat := noteKeys(it.Flush())
+				g.Expect(keys).To(Equal(tc.keys))
+				g.Expect(isFlat).To(Equal(tc.isFlat))
+			}
+
+			{
+				var buf bytes.Buffer
+				g.Expect(balafon.ToXML(&buf, []byte(tc.input))).To(Succeed())
+
+				g.Expect(buf.String()).To(ContainSubstring(fmt.Sprintf("<fifths>%d</fifths>", tc.fifths)))
+				g.Expect(buf.String()).To(ContainSubstring(fmt.Sprintf("<mode>%s</mode>", tc.mode)))
+			}
+		})
+	}
+}
+
+func TestScaleDegrees(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		keys   []uint8
+		isFlat []bool
+	}{
+		{":time 5 4; <1> <3> <5> <8> <15>", []uint8{60, 64, 67, 72, 84}, []bool{false, false, false, false, false}},
+		{":key G; <1> <3> <7>", []uint8{67, 71, 78}, []bool{false, false, false}},
+		{":key Am; <1> <3> <2>,", []uint8{69, 72, 59}, []bool{false, false, false}},
+		{":key F; <4> <3>$ <4>#", []uint8{70, 68, 71}, []bool{true, true, false}},
+		{":scale blues 0 3 5 6 7 10; :key C blues; :time 5 4; <1> <2> <4> <6> <7>", []uint8{60, 63, 66, 70, 72}, []bool{false, true, false, true, false}},
+		{":key D dorian; :octave -1; <1> <3>", []uint8{50, 53}, []bool{false, false}},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+			g.Expect(it.EvalString(tc.input)).To(Succeed())
+
+			keys, isFlat := noteKeys(it.Flush())
+			g.Expect(keys).To(Equal(tc.keys))
+			g.Expect(isFlat).To(Equal(tc.isFlat))
+		})
+	}
+}
+
+func TestCustomScaleError(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{":key C blues", "1:1: error: unknown scale 'blues'"},
+		{":scale a 0 1\n:scale a 0 2", "2:1: error: scale 'a' already defined"},
+		{":bar x\n:scale a 0 1\n:end", "2:1: error: command 'scale' not allowed in bar"},
+		{":repeat 2\n:scale a 0 1\n:end", "2:1: error: command 'scale' not allowed in repeat"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			g := NewWithT(t)
+
+			it := balafon.New()
+
+			err := it.EvalString(tc.input)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(tc.err))
+		})
+	}
+}
+
+func noteKeys(bars []*balafon.Bar) (keys []uint8, isFlat []bool) {
+	for _, bar := range bars {
+		for _, ev := range bar.Events {
+			var c, k, v uint8
+			if ev.Message.GetNoteStart(&c, &k, &v) {
+				keys = append(keys, k)
+				isFlat = append(isFlat, ev.IsFlat)
+			}
+		}
+	}
+	return keys, isFlat
+}
@@ -3,6 +3,7 @@ package balafon
 import (
 	"cmp"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 
@@ -37,33 +38,58 @@ func (s *TrackEvent) String() string {
 
 // Sequencer is a MIDI sequencer.
 type Sequencer struct {
-	song           SMF
-	pos            uint32
-	tempo          float64
-	absNanoseconds int64
+	song   SMF
+	pos    uint32
+	tempos tempoMap
+	loops  map[uint8]trackLoop // the loops of tracks with their own meter
+}
+
+// trackLoop is the position of a track that loops on its own length.
+type trackLoop struct {
+	length uint32
+	phase  uint32 // the position in the loop at the beginning of the next bar
 }
 
 // AddBars adds bars to te sequence.
 func (s *Sequencer) AddBars(bars ...*Bar) {
+	start := len(s.song)
+
 	for _, bar := range bars {
+		var loops map[uint8][]Event
+
 		for _, ev := range bar.Events {
-			te := TrackEvent{
-				Message:        ev.Message,
-				AbsTicks:       s.pos + ev.Pos,
-				AbsNanoseconds: s.absNanoseconds + constants.TicksPerQuarter.Duration(s.tempo, ev.Pos).Nanoseconds(),
+			if ev.TieStop {
+				// The tied note is already sounding.
+				continue
 			}
 
-			s.song = append(s.song, te)
+			if _, ok := bar.meters[ev.Track]; ok && isNoteMessage(ev.Message) {
+				if loops == nil {
+					loops = map[uint8][]Event{}
+				}
+				loops[ev.Track] = append(loops[ev.Track], ev)
+				continue
+			}
+
+			s.add(ev.Message, s.pos+ev.Pos)
+		}
 
-			var newTempo float64
-			if ev.Message.GetMetaTempo(&newTempo) {
-				s.tempo = newTempo
+		for track := range s.loops {
+			if _, ok := bar.meters[track]; !ok {
+				delete(s.loops, track)
 			}
 		}
 
-		ticks := bar.Cap()
-		s.pos += ticks
-		s.absNanoseconds += constants.TicksPerQuarter.Duration(s.tempo, ticks).Nanoseconds()
+		for _, track := range slices.Sorted(maps.Keys(bar.meters)) {
+			s.addLoop(track, bar.trackCap(track), bar.Cap(), loops[track])
+		}
+
+		s.pos += bar.Cap()
+	}
+
+	// Convert the time after all tempo changes are known.
+	for i := start; i < len(s.song); i++ {
+		s.song[i].AbsNanoseconds = s.tempos.Nanoseconds(s.song[i].AbsTicks)
 	}
 
 	slices.SortStableFunc(s.song, func(a, b TrackEvent) int {
@@ -71,6 +97,62 @@ func (s *Sequencer) AddBars(bars ...*Bar) {
 	})
 }
 
+func (s *Sequencer) add(msg smf.Message, pos uint32) {
+	var bpm float64
+	if msg.GetMetaTempo(&bpm) {
+		s.tempos.Set(pos, bpm)
+	}
+
+	s.song = append(s.song, TrackEvent{
+		Message:  msg,
+		AbsTicks: pos,
+	})
+}
+
+// addLoop adds the note events of a track repeatedly on the track's own length
+// until the end of the bar. The loop continues from its position in the previous bar.
+func (s *Sequencer) addLoop(track uint8, length, barCap uint32, events []Event) {
+	loop := s.loops[track]
+	if loop.length != length {
+		loop = trackLoop{length: length}
+	}
+
+	type noteKey struct {
+		channel, key uint8
+	}
+
+	for offset := -int(loop.phase); offset < int(barCap); offset += int(length) {
+		playing := map[noteKey]bool{}
+
+		for _, ev := range events {
+			pos := offset + int(ev.Pos)
+			inBar := pos >= 0 && pos < int(barCap)
+
+			var channel, key uint8
+			switch {
+			case ev.Message.GetNoteStart(&channel, &key, nil):
+				// Notes that start in the bar end even after the bar.
+				playing[noteKey{channel, key}] = inBar
+			case ev.Message.GetNoteEnd(&channel, &key):
+				inBar = playing[noteKey{channel, key}]
+				delete(playing, noteKey{channel, key})
+			}
+
+			if inBar {
+				s.add(ev.Message, uint32(int(s.pos)+pos))
+			}
+		}
+	}
+
+	loop.phase = (loop.phase + barCap) % length
+	s.loops[track] = loop
+}
+
+// isNoteMessage reports whether the message is a note on, note off or polyphonic aftertouch message.
+func isNoteMessage(msg smf.Message) bool {
+	return msg.GetNoteStart(nil, nil, nil) || msg.GetNoteEnd(nil, nil) || msg.GetPolyAfterTouch(nil, nil, nil)
+}
+
 // Flush emits the accumulated SMF tracks.
 func (s *Sequencer) Flush() SMF {
 	song := make(SMF, len(s.song))
@@ -82,6 +164,7 @@ func (s *Sequencer) Flush() SMF {
 // NewSequencer creates an SMF sequencer.
 func NewSequencer() *Sequencer {
 	return &Sequencer{
-		tempo: constants.DefaultTempo,
+		tempos: newTempoMap(constants.DefaultTempo),
+		loops:  map[uint8]trackLoop{},
 	}
 }
@@ -178,6 +178,114 @@ func TestTempoChange(t *testing.T) {
 	}))
 }
 
+func TestSequencerTempoRamp(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+	it.SetTempoResolution(uint32(constants.TicksPerQuarter))
+
+	g.Expect(it.EvalString(":assign x 42; :tempo 120 -> 60; xxxx")).To(Succeed())
+
+	s := balafon.NewSequencer()
+	s.AddBars(it.Flush()...)
+
+	var expected time.Duration
+	for _, bpm := range []float64{120, 105, 90, 75} {
+		// Tempo is stored in microseconds per quarter note.
+		g.Expect(smf.MetaTempo(bpm).GetMetaTempo(&bpm)).To(BeTrue())
+		expected += constants.TicksPerQuarter.Duration(bpm, uint32(constants.TicksPerQuarter))
+	}
+
+	sm := s.Flush()
+	g.Expect(sm[len(sm)-1]).To(Equal(balafon.TrackEvent{
+		Message:        smf.Message(midi.NoteOff(0, 42)),
+		AbsTicks:       uint32(constants.TicksPerWhole),
+		AbsNanoseconds: expected.Nanoseconds(),
+	}))
+}
+
+func TestSequencerMidBarTempoChange(t *testing.T) {
+	g := NewWithT(t)
+
+	bar := &balafon.Bar{
+		Events: []balafon.Event{
+			{Pos: 0, Message: smf.MetaTempo(60)},
+			{Pos: 0, Message: smf.Message(midi.NoteOn(0, 42, 100))},
+			{Pos: 960, Message: smf.Message(midi.NoteOff(0, 42))},
+			{Pos: 960, Message: smf.MetaTempo(120)},
+			{Pos: 960, Message: smf.Message(midi.NoteOn(0, 42, 100))},
+			{Pos: 1920, Message: smf.Message(midi.NoteOff(0, 42))},
+			{Pos: 1920, Message: smf.MetaTempo(240)},
+		},
+	}
+	bar.SetTimeSig(3, 4)
+
+	next := &balafon.Bar{
+		Events: []balafon.Event{
+			{Pos: 0, Message: smf.Message(midi.NoteOn(0, 42, 100))},
+			{Pos: 960, Message: smf.Message(midi.NoteOff(0, 42))},
+		},
+	}
+	next.SetTimeSig(1, 4)
+
+	s := balafon.NewSequencer()
+	s.AddBars(bar, next)
+
+	g.Expect(s.Flush().String()).To(Equal(`pos: 0 ns: 0 message: MetaTempo bpm: 60.00
+pos: 0 ns: 0 message: NoteOn channel: 0 key: 42 velocity: 100
+pos: 960 ns: 1000000000 message: NoteOff channel: 0 key: 42
+pos: 960 ns: 1000000000 message: MetaTempo bpm: 120.00
+pos: 960 ns: 1000000000 message: NoteOn channel: 0 key: 42 velocity: 100
+pos: 1920 ns: 1500000000 message: NoteOff channel: 0 key: 42
+pos: 1920 ns: 1500000000 message: MetaTempo bpm: 240.00
+pos: 2880 ns: 1750000000 message: NoteOn channel: 0 key: 42 velocity: 100
+pos: 3840 ns: 2000000000 message: NoteOff channel: 0 key: 42
+`))
+}
+
+func TestSequencerUnsortedTempoChange(t *testing.T) {
+	g := NewWithT(t)
+
+	// The tempo change affects the earlier note on
+	// although it comes later in the bar.
+	bar := &balafon.Bar{
+		Events: []balafon.Event{
+			{Pos: 1920, Message: smf.Message(midi.NoteOn(0, 42, 100))},
+			{Pos: 2880, Message: smf.Message(midi.NoteOff(0, 42))},
+			{Pos: 960, Message: smf.MetaTempo(60)},
+		},
+	}
+	bar.SetTimeSig(3, 4)
+
+	s := balafon.NewSequencer()
+	s.AddBars(bar)
+
+	g.Expect(s.Flush().String()).To(Equal(`pos: 960 ns: 500000000 message: MetaTempo bpm: 60.00
+pos: 1920 ns: 1500000000 message: NoteOn channel: 0 key: 42 velocity: 100
+pos: 2880 ns: 2500000000 message: NoteOff channel: 0 key: 42
+`))
+}
+
+func TestSequencerTempoAcrossAddBars(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+	s := balafon.NewSequencer()
+
+	g.Expect(it.EvalString(":assign x 42; :time 1 4; :tempo 60; x")).To(Succeed())
+	s.AddBars(it.Flush()...)
+
+	g.Expect(it.EvalString("x")).To(Succeed())
+	s.AddBars(it.Flush()...)
+
+	sm := s.Flush()
+	g.Expect(sm[len(sm)-1]).To(Equal(balafon.TrackEvent{
+		Message:        smf.Message(midi.NoteOff(0, 42)),
+		AbsTicks:       uint32(2 * constants.TicksPerQuarter),
+		AbsNanoseconds: 2 * time.Second.Nanoseconds(),
+	}))
+}
+
 func TestZeroDurationBarCollapse(t *testing.T) {
 	g := NewWithT(t)
 
@@ -220,3 +328,126 @@ pos: 1920 ns: 2000000000 message: MetaTimeSig meter: 1/4
 pos: 1920 ns: 2000000000 message: UnknownType
 `))
 }
+
+func TestSequencerTie(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	g.Expect(it.EvalString(`
+:tempo 60
+:time 1 4
+:assign c 60
+c~
+c
+`)).To(Succeed())
+
+	s := balafon.NewSequencer()
+	s.AddBars(it.Flush()...)
+
+	sm := s.Flush()
+	g.Expect(sm.String()).To(Equal(`pos: 0 ns: 0 message: MetaTempo bpm: 60.00
+pos: 0 ns: 0 message: MetaTimeSig meter: 1/4
+pos: 0 ns: 0 message: NoteOn channel: 0 key: 60 velocity: 100
+pos: 960 ns: 1000000000 message: MetaTimeSig meter: 1/4
+pos: 1920 ns: 2000000000 message: NoteOff channel: 0 key: 60
+`))
+}
+
+func TestSequencerPolymeter(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	input := `
+:channel 10
+:assign k 36
+:channel 2
+:assign c 60
+:assign d 62
+:assign e 64
+:bar a
+	:channel 10
+	kkkk
+	:channel 2
+	:meter 3 4
+	cde
+:end
+:play a
+:play a
+`
+
+	g.Expect(it.EvalString(input)).To(Succeed())
+
+	s := balafon.NewSequencer()
+	s.AddBars(it.Flush()...)
+
+	type note struct {
+		pos uint32
+		key uint8
+	}
+
+	var (
+		drums, synth []note
+		offs         int
+	)
+
+	for _, ev := range s.Flush() {
+		var ch, key, vel uint8
+		switch {
+		case ev.Message.GetNoteStart(&ch, &key, &vel) && ch == 9:
+			drums = append(drums, note{ev.AbsTicks, key})
+		case ev.Message.GetNoteStart(&ch, &key, &vel) && ch == 1:
+			synth = append(synth, note{ev.AbsTicks, key})
+		case ev.Message.GetNoteEnd(&ch, &key) && ch == 1:
+			offs++
+		}
+	}
+
+	g.Expect(drums).To(HaveLen(8))
+	g.Expect(synth).To(Equal([]note{
+		{0, 60}, {960, 62}, {1920, 64}, {2880, 60},
+		{3840, 62}, {4800, 64}, {5760, 60}, {6720, 62},
+	}))
+	g.Expect(offs).To(Equal(len(synth)))
+}
+
+func TestSequencerPickup(t *testing.T) {
+	g := NewWithT(t)
+
+	it := balafon.New()
+
+	input := `
+:assign c 60
+:pickup
+c8 c8
+c1
+:bar a
+	:pickup
+	c4
+:end
+:play a
+c1
+`
+
+	g.Expect(it.EvalString(input)).To(Succeed())
+
+	bars := it.Flush()
+	g.Expect(bars).To(HaveLen(4))
+	g.Expect(bars[0].IsPickup()).To(BeTrue())
+	g.Expect(bars[0].Cap()).To(Equal(uint32(960)))
+	g.Expect(bars[1].IsPickup()).To(BeFalse())
+	g.Expect(bars[2].Cap()).To(Equal(uint32(960)))
+
+	s := balafon.NewSequencer()
+	s.AddBars(bars...)
+
+	var notes []uint32
+	for _, ev := range s.Flush() {
+		if ev.Message.GetNoteStart(nil, nil, nil) {
+			notes = append(notes, ev.AbsTicks)
+		}
+	}
+
+	g.Expect(notes).To(Equal([]uint32{0, 480, 960, 4800, 5760}))
+}
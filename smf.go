@@ -25,8 +25,11 @@ func ToSMF(input []byte) (*smf.SMF, error) {
 		return nil, err
 	}
 
-	bars := it.Flush()
+	return BarsToSMF(it.Flush()...)
+}
 
+// BarsToSMF converts bars to SMF1.
+func BarsToSMF(bars ...*Bar) (*smf.SMF, error) {
 	seq := NewSequencer()
 	seq.AddBars(bars...)
 
@@ -35,8 +38,24 @@ func ToSMF(input []byte) (*smf.SMF, error) {
 	metaTrack := &track{}
 	tracks := map[uint8]*track{}
 
+	// The first track name on a channel names the channel's track.
+	for _, bar := range bars {
+		for _, ev := range bar.Events {
+			var name string
+			if ev.Track == 0 || !ev.Message.GetMetaTrackName(&name) {
+				continue
+			}
+
+			ch := NewChannelFromHuman(ev.Track).Uint8()
+			if tracks[ch] == nil {
+				tracks[ch] = &track{}
+				tracks[ch].Add(TrackEvent{Message: ev.Message})
+			}
+		}
+	}
+
 	for _, ev := range events {
-		if ev.Message == nil {
+		if ev.Message == nil || ev.Message.Is(smf.MetaTrackNameMsg) {
 			continue
 		}
 
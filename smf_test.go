@@ -1,12 +1,14 @@
 package balafon_test
 
 import (
+	"bytes"
 	_ "embed"
 	"testing"
 
 	"github.com/aymanbagabas/go-udiff"
 	"github.com/mgnsk/balafon"
 	. "github.com/onsi/gomega"
+	"gitlab.com/gomidi/midi/v2/smf"
 )
 
 //go:embed examples/bonham.bal
@@ -27,3 +29,52 @@ func TestSMFConvert(t *testing.T) {
 
 	g.Expect(diff).To(BeEmpty())
 }
+
+func TestSMFExpressionMessages(t *testing.T) {
+	g := NewWithT(t)
+
+	song, err := balafon.ToSMF([]byte(":channel 2; :assign c 60; :bend 100; :pressure 64; c&100"))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(song.Tracks).To(HaveLen(2))
+	g.Expect(song.String()).To(SatisfyAll(
+		ContainSubstring("PitchBend channel: 1 pitch: 100"),
+		ContainSubstring("AfterTouch channel: 1 pressure: 64"),
+		ContainSubstring("PolyAfterTouch channel: 1 key: 60 pressure: 100"),
+	))
+}
+
+func TestSMFSysex(t *testing.T) {
+	g := NewWithT(t)
+
+	song, err := balafon.ToSMF([]byte(":sysex F0 7E 7F 09 01 F7"))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var buf bytes.Buffer
+	_, err = song.WriteTo(&buf)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	read, err := smf.ReadFrom(&buf)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(read.String()).To(ContainSubstring("SysExType data: 7E 7F 09 01"))
+}
+
+func TestSMFTrackNames(t *testing.T) {
+	g := NewWithT(t)
+
+	song, err := balafon.ToSMF([]byte(`:assign c 60; :program "Violin"; :channel 2; :assign c 48; :program "Cello"; c`))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(song.Tracks).To(HaveLen(3))
+
+	var name string
+	for _, ev := range song.Tracks[0] {
+		g.Expect(ev.Message.GetMetaTrackName(&name)).To(BeFalse())
+	}
+
+	g.Expect(song.Tracks[1][0].Message.GetMetaTrackName(&name)).To(BeTrue())
+	g.Expect(name).To(Equal("Violin"))
+
+	g.Expect(song.Tracks[2][0].Message.GetMetaTrackName(&name)).To(BeTrue())
+	g.Expect(name).To(Equal("Cello"))
+}
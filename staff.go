@@ -0,0 +1,209 @@
+package balafon
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/mgnsk/balafon/internal/ast"
+	"github.com/mgnsk/balafon/internal/constants"
+)
+
+// noteLen is a notated note length.
+type noteLen struct {
+	value uint8
+	dots  int
+}
+
+// splitLen splits a length in ticks into the fewest notated lengths, longest first.
+// It returns false if the length can't be notated without tuplets.
+func splitLen(ticks uint32) ([]noteLen, bool) {
+	var lens []noteLen
+
+	for value := uint32(1); ticks > 0 && value <= 128; value *= 2 {
+		length := uint32(constants.TicksPerWhole) / value
+
+		switch {
+		case value < 128 && ticks >= length+length/2:
+			lens = append(lens, noteLen{value: uint8(value), dots: 1})
+			ticks -= length + length/2
+		case ticks >= length:
+			lens = append(lens, noteLen{value: uint8(value)})
+			ticks -= length
+		}
+	}
+
+	return lens, ticks == 0
+}
+
+// staffGrid is the bar lines of a staff.
+type staffGrid struct {
+	barCap uint32
+	shift  uint32 // the length missing from the pickup bar or 0
+}
+
+// index returns the index of the bar at the position.
+func (g staffGrid) index(pos uint32) int {
+	return int((pos + g.shift) / g.barCap)
+}
+
+// start returns the position of the bar at the index.
+func (g staffGrid) start(i int) uint32 {
+	return max(uint32(i)*g.barCap, g.shift) - g.shift
+}
+
+// splitBar splits a staff bar that is longer than its time signature into bars.
+// Notes and rests crossing a bar line are split into tied notes and rests.
+// If pickup is true, the first bar is a pickup bar of the length left over from whole bars.
+func splitBar(bar *Bar, length uint32, pickup bool) ([]*Bar, error) {
+	timesig := bar.timeSig
+	for _, meter := range bar.meters {
+		// The staff is written in the meter of its channel.
+		timesig = meter
+	}
+
+	grid := staffGrid{barCap: timeSigCap(timesig)}
+	if pickup {
+		grid.shift = (grid.barCap - length%grid.barCap) % grid.barCap
+	}
+
+	if length <= grid.barCap {
+		if grid.shift > 0 {
+			bar.pickup = length
+		}
+		return []*Bar{bar}, nil
+	}
+
+	bars := make([]*Bar, grid.index(length-1)+1)
+	for i := range bars {
+		bars[i] = &Bar{
+			timeSig:  timesig,
+			grooves:  maps.Clone(bar.grooves),
+			humanize: maps.Clone(bar.humanize),
+		}
+	}
+	if grid.shift > 0 {
+		bars[0].pickup = grid.barCap - grid.shift
+	}
+
+	add := func(n int, pos uint32, ev Event) {
+		n = min(n, len(bars)-1)
+		ev.Pos = pos - grid.start(n)
+		bars[n].Events = append(bars[n].Events, ev)
+	}
+
+	skip := map[int]bool{} // note offs of split notes
+
+	for i, ev := range bar.Events {
+		if skip[i] {
+			continue
+		}
+
+		end := ev.Pos
+		if ev.Note != nil {
+			end += ev.Note.Props.NoteLen()
+		}
+
+		isNoteOn := ev.Message.GetNoteStart(nil, nil, nil)
+
+		switch {
+		case ev.Note != nil && grid.index(ev.Pos) != grid.index(end-1) && !ev.Note.Props.IsLetRing():
+			off := -1
+			if isNoteOn {
+				off = findNoteOff(bar.Events, i)
+				skip[off] = true
+			}
+
+			pieces, err := splitNote(ev, grid)
+			if err != nil {
+				return nil, err
+			}
+
+			for j, piece := range pieces {
+				if isNoteOn && j == len(pieces)-1 {
+					// The last piece keeps the staccato of the note.
+					piece.Duration -= min(piece.Duration, ev.Note.Props.NoteLen()-ev.Duration)
+				}
+
+				n := grid.index(piece.Pos)
+				add(n, piece.Pos, piece)
+
+				// The pieces are played by chance together.
+				if bars[n].split == nil {
+					bars[n].split = map[*ast.Note]*ast.Note{}
+				}
+				bars[n].split[piece.Note] = ev.Note
+
+				if off != -1 {
+					// Keep the note off in the bar of the note.
+					add(n, piece.Pos+piece.Duration, bar.Events[off])
+				}
+			}
+
+		case ev.Message.GetNoteEnd(nil, nil) && ev.Pos > 0:
+			// A note off at a bar line ends the previous bar.
+			add(grid.index(ev.Pos-1), ev.Pos, ev)
+
+		default:
+			add(grid.index(ev.Pos), ev.Pos, ev)
+		}
+	}
+
+	return bars, nil
+}
+
+// splitNote splits a note or rest event crossing bar lines into tied events
+// with absolute positions in the staff.
+func splitNote(ev Event, grid staffGrid) ([]Event, error) {
+	var (
+		pieces []Event
+		pos    = ev.Pos
+		end    = ev.Pos + ev.Note.Props.NoteLen()
+	)
+
+	for pos < end {
+		segment := min(end, grid.start(grid.index(pos)+1)) - pos
+
+		lens, ok := splitLen(segment)
+		if !ok {
+			var buf strings.Builder
+			ev.Note.WriteTo(&buf)
+			return nil, &EvalError{
+				Err: fmt.Errorf("note '%s' crossing the bar line can't be split into notes without tuplets", buf.String()),
+				Pos: ev.Note.Pos,
+			}
+		}
+
+		for _, l := range lens {
+			note := *ev.Note
+			note.Props = note.Props.WithLen(l.value, l.dots)
+
+			piece := ev
+			piece.Note = &note
+			piece.Pos = pos
+			piece.Duration = note.Props.NoteLen()
+			pieces = append(pieces, piece)
+
+			pos += piece.Duration
+		}
+	}
+
+	if !ev.Note.IsPause() {
+		// Tie the pieces together, the last piece keeps the tie of the note.
+		for i := range pieces[:len(pieces)-1] {
+			pieces[i].TieStart = true
+		}
+	}
+
+	return pieces, nil
+}
+
+// isStaffNoteList reports whether the declaration is a note list that is split into bars in staff mode.
+func isStaffNoteList(decl ast.Node) bool {
+	switch decl.(type) {
+	case ast.NodeList, ast.NoteGroup:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,58 @@
+package balafon
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/mgnsk/balafon/internal/constants"
+)
+
+// tempoChange is a tempo change at an absolute position.
+type tempoChange struct {
+	ticks       uint32
+	nanoseconds int64
+	bpm         float64
+}
+
+// tempoMap is a list of tempo changes sorted by ticks.
+// The first change is always at tick 0.
+type tempoMap []tempoChange
+
+func newTempoMap(bpm float64) tempoMap {
+	return tempoMap{{bpm: bpm}}
+}
+
+// Set sets the tempo at the absolute tick position.
+// A later change at the same position replaces the earlier one.
+func (m *tempoMap) Set(ticks uint32, bpm float64) {
+	i, found := slices.BinarySearchFunc(*m, ticks, func(c tempoChange, ticks uint32) int {
+		return cmp.Compare(c.ticks, ticks)
+	})
+
+	if found {
+		(*m)[i].bpm = bpm
+	} else {
+		*m = slices.Insert(*m, i, tempoChange{ticks: ticks, bpm: bpm})
+	}
+
+	// Changes after the position have moved in time.
+	for j := max(i, 1); j < len(*m); j++ {
+		prev := (*m)[j-1]
+		(*m)[j].nanoseconds = prev.nanoseconds + constants.TicksPerQuarter.Duration(prev.bpm, (*m)[j].ticks-prev.ticks).Nanoseconds()
+	}
+}
+
+// Nanoseconds converts the absolute tick position to absolute nanoseconds.
+func (m tempoMap) Nanoseconds(ticks uint32) int64 {
+	i, found := slices.BinarySearchFunc(m, ticks, func(c tempoChange, ticks uint32) int {
+		return cmp.Compare(c.ticks, ticks)
+	})
+	if !found {
+		// The last change before the position.
+		i--
+	}
+
+	c := m[i]
+
+	return c.nanoseconds + constants.TicksPerQuarter.Duration(c.bpm, ticks-c.ticks).Nanoseconds()
+}
@@ -0,0 +1,3 @@
+:channel 10
+:assign k 36
+:assign s 38
@@ -0,0 +1,2 @@
+:assign k 36
+x
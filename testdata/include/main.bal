@@ -0,0 +1,4 @@
+:include "kits/drums.bal"
+
+:time 1 4
+k
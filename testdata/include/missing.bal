@@ -0,0 +1 @@
+:include "kits/missing.bal"
@@ -0,0 +1 @@
+:include "kits/error.bal"
@@ -0,0 +1 @@
+:sysex "invalid.syx"
@@ -0,0 +1 @@
+:sysex "reset.syx"
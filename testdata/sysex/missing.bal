@@ -0,0 +1 @@
+:sysex "missing.syx"
@@ -0,0 +1,91 @@
+package balafon
+
+import "slices"
+
+type tieKey struct {
+	track uint8
+	voice uint8
+	key   uint8
+}
+
+type openTie struct {
+	bar *Bar
+	on  int    // index of the tied note on event
+	off int    // index of the tied note off event
+	end uint32 // absolute position of the tied note off event
+}
+
+// resolveTies joins tied notes with the note of the same pitch
+// on the same track and voice that starts where the tied note ends.
+// The joined notes keep their events for notation but the tied note off
+// and the continuation note on are not played.
+func resolveTies(bars []*Bar) {
+	var (
+		open    = map[tieKey]openTie{}
+		removed = map[*Bar][]int{}
+		offset  uint32 // absolute position of the bar
+	)
+
+	for _, bar := range bars {
+		for i := range bar.Events {
+			ev := &bar.Events[i]
+
+			var ch, key uint8
+			if !ev.Message.GetNoteStart(&ch, &key, nil) {
+				continue
+			}
+
+			k := tieKey{
+				track: ev.Track,
+				voice: ev.Voice,
+				key:   key,
+			}
+
+			if tie, ok := open[k]; ok {
+				switch pos := offset + ev.Pos; {
+				case pos == tie.end:
+					ev.TieStop = true
+					removed[tie.bar] = append(removed[tie.bar], tie.off)
+					delete(open, k)
+				case pos > tie.end:
+					// The tied note is followed by another note or a rest.
+					tie.bar.Events[tie.on].TieStart = false
+					delete(open, k)
+				}
+			}
+
+			if ev.TieStart {
+				off := findNoteOff(bar.Events, i)
+				if off == -1 {
+					// Let ring notes cannot be tied.
+					ev.TieStart = false
+					continue
+				}
+
+				open[k] = openTie{
+					bar: bar,
+					on:  i,
+					off: off,
+					end: offset + bar.Events[off].Pos,
+				}
+			}
+		}
+
+		offset += bar.Cap()
+	}
+
+	// Notes tied to nothing keep sounding for their own length.
+	for _, tie := range open {
+		tie.bar.Events[tie.on].TieStart = false
+	}
+
+	for bar, indices := range removed {
+		events := bar.Events[:0]
+		for i, ev := range bar.Events {
+			if !slices.Contains(indices, i) {
+				events = append(events, ev)
+			}
+		}
+		bar.Events = events
+	}
+}
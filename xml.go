@@ -8,7 +8,9 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/mgnsk/balafon/internal/ast"
 	"github.com/mgnsk/balafon/internal/constants"
+	"github.com/mgnsk/balafon/internal/gm"
 	"github.com/mgnsk/balafon/internal/mxl"
 	"gitlab.com/gomidi/midi/v2/smf"
 )
@@ -16,6 +18,7 @@ import (
 // ToXML converts a balafon script to MusicXML.
 func ToXML(w io.Writer, input []byte) error {
 	it := New()
+	it.straight = true
 
 	if err := it.Eval(input); err != nil {
 		return err
@@ -24,11 +27,18 @@ func ToXML(w io.Writer, input []byte) error {
 	bars := it.Flush()
 
 	var tracks []uint8
+	names := map[string]string{} // instrument names by part ID
 	{
 		seen := map[uint8]struct{}{}
 		for _, bar := range bars {
 			for _, ev := range bar.Events {
 				seen[ev.Track] = struct{}{}
+
+				var name string
+				id := fmt.Sprintf("%d", ev.Track)
+				if _, ok := names[id]; !ok && ev.Message.GetMetaTrackName(&name) {
+					names[id] = name
+				}
 			}
 		}
 
@@ -41,9 +51,24 @@ func ToXML(w io.Writer, input []byte) error {
 
 	// The partwise MusicXML structure.
 	parts := make(map[uint8]*mxl.Part, len(tracks))
-	timesig := [2]uint8{4, 4}
+	timesigs := map[uint8][2]uint8{} // the current time signatures of the parts
+
+	number := 0
+
+	for _, bar := range bars {
+		if bar.repeat.skip {
+			// Repeated bars are notated with repeat signs.
+			continue
+		}
+
+		implicit := ""
+		if bar.IsPickup() {
+			// A pickup measure is not counted, a leading one is numbered 0.
+			implicit = "yes"
+		} else {
+			number++
+		}
 
-	for i, bar := range bars {
 		events := map[uint8][]Event{}
 
 		for _, ev := range bar.Events {
@@ -69,7 +94,7 @@ func ToXML(w io.Writer, input []byte) error {
 					Sign: "percussion",
 					Line: 2,
 				}
-			} else if i == 0 {
+			} else if len(p.Measures) == 0 {
 				// Set default CMaj key on each channel's first bar.
 				key = &mxl.Key{
 					Fifths: 0,
@@ -77,15 +102,38 @@ func ToXML(w io.Writer, input []byte) error {
 				}
 			}
 
+			// A channel with its own meter is notated in its own time signature.
+			timesig := bar.timeSig
+			if meter, ok := bar.meters[tr]; ok {
+				timesig = meter
+			}
+
 			measure := mxl.Measure{
-				Number: i + 1,
+				Number:   number,
+				Implicit: implicit,
 				Atters: mxl.Attributes{
-					Divisions: int(constants.TicksPerWhole) / int(bar.timeSig[1]),
+					Divisions: int(constants.TicksPerWhole) / int(timesig[1]),
 					Key:       key,
 					Clef:      clef,
 				},
 			}
 
+			prev, ok := timesigs[tr]
+			if !ok {
+				prev = [2]uint8{4, 4}
+			}
+			if timesig != prev {
+				measure.Atters.Time = &mxl.Time{
+					Beats:    int(timesig[0]),
+					BeatType: int(timesig[1]),
+				}
+			}
+			timesigs[tr] = timesig
+
+			if barline := leftBarline(bar.repeat); barline != nil {
+				measure.Notes = append(measure.Notes, *barline)
+			}
+
 			if barEvents, ok := events[tr]; ok {
 				// Treat notes of the same voice on the same position as chords.
 				chords := map[uint32][]Event{}
@@ -113,6 +161,8 @@ func ToXML(w io.Writer, input []byte) error {
 				prevVoiceDur := 0
 
 				for i, voice := range voices {
+					var tuplet tupletGroup
+
 					if i > 0 && prevVoiceDur > 0 {
 						measure.Notes = append(measure.Notes, mxl.Backup{
 							Duration: prevVoiceDur,
@@ -147,6 +197,11 @@ func ToXML(w io.Writer, input []byte) error {
 							}
 
 							if ev.Note == nil {
+								if direction := newDirection(ev); direction != nil {
+									measure.Notes = append(measure.Notes, *direction)
+									continue
+								}
+
 								// Meta event.
 								if smfKey := (smf.Key{}); ev.Message.GetMetaKey(&smfKey) {
 									var fifths int
@@ -165,15 +220,6 @@ func ToXML(w io.Writer, input []byte) error {
 										Fifths: fifths,
 										Mode:   mode,
 									}
-								} else if num, denom := uint8(0), uint8(0); ev.Message.GetMetaMeter(&num, &denom) {
-									if num != timesig[0] || denom != timesig[1] {
-										measure.Atters.Time = &mxl.Time{
-											Beats:    int(num),
-											BeatType: int(denom),
-										}
-										timesig[0] = num
-										timesig[1] = denom
-									}
 								}
 							} else {
 								// Note event.
@@ -194,7 +240,27 @@ func ToXML(w io.Writer, input []byte) error {
 								prevVoiceDur += dur
 								prevNoteDur = dur
 
+								var timeMod *mxl.TimeMod
+								if n, m := ev.Note.Props.TupletRatio(); n > 0 {
+									timeMod = &mxl.TimeMod{
+										ActualNotes: n,
+										NormalNotes: m,
+									}
+								}
+
+								var tupletType string
+								if noteCountInPos == 0 {
+									tupletType = tuplet.next(ev.Note.Props)
+								}
+
 								if ev.Note.IsPause() {
+									var notations *mxl.Notations
+									if tupletType != "" {
+										notations = &mxl.Notations{
+											Tuplets: []mxl.Tuplet{{Type: tupletType}},
+										}
+									}
+
 									measure.Notes = append(measure.Notes, mxl.Note{
 										// Pitch: mxl.Pitch{
 										// 	// Accidental int8   `xml:"alter"`
@@ -207,8 +273,9 @@ func ToXML(w io.Writer, input []byte) error {
 										Rest: &xml.Name{
 											Local: "rest",
 										},
-										Chord: chord,
-										// Tie      Tie      `xml:"tie"`
+										Chord:     chord,
+										TimeMod:   timeMod,
+										Notations: notations,
 									})
 								} else {
 									var c, k, v uint8
@@ -220,6 +287,7 @@ func ToXML(w io.Writer, input []byte) error {
 										Duration: dur,
 										Voice:    int(ev.Voice),
 										Chord:    chord,
+										TimeMod:  timeMod,
 										NoteHead: &mxl.NoteHead{
 											Filled:      "yes",
 											Parentheses: "no",
@@ -233,8 +301,22 @@ func ToXML(w io.Writer, input []byte) error {
 									}
 
 									switch tr {
-									// case constants.PercussionTrack:
-									// get the pitch and notehead
+									case constants.PercussionTrack:
+										drum, ok := gm.Drums[k]
+										if !ok {
+											step, octave := getPitch(int(k))
+											drum = gm.Drum{
+												Step:     step[:1],
+												Octave:   octave,
+												Notehead: "normal",
+											}
+										}
+
+										note.Unpitched = &mxl.Unpitched{
+											DisplayStep:   drum.Step,
+											DisplayOctave: drum.Octave,
+										}
+										note.NoteHead.Value = drum.Notehead
 
 									default:
 										step, octave := getPitch(int(k))
@@ -271,9 +353,9 @@ func ToXML(w io.Writer, input []byte) error {
 											// Note: by interpreter not allowing sharp/flat properties
 											// on notes that are assigned to a non-natural key,
 											// we avoid an ambiguity here.
-											if ev.Note.Props.IsSharp() {
+											if ev.Note.IsSharp() {
 												setSharp()
-											} else if ev.Note.Props.IsFlat() {
+											} else if ev.Note.IsFlat() {
 												setFlat()
 											}
 										}
@@ -281,6 +363,28 @@ func ToXML(w io.Writer, input []byte) error {
 										note.Pitch = pitch
 									}
 
+									if ev.TieStop {
+										note.Ties = append(note.Ties, mxl.Tie{Type: "stop"})
+									}
+
+									if ev.TieStart {
+										note.Ties = append(note.Ties, mxl.Tie{Type: "start"})
+									}
+
+									if len(note.Ties) > 0 {
+										note.Notations = &mxl.Notations{}
+										for _, tie := range note.Ties {
+											note.Notations.Tied = append(note.Notations.Tied, mxl.Tied(tie))
+										}
+									}
+
+									if tupletType != "" {
+										if note.Notations == nil {
+											note.Notations = &mxl.Notations{}
+										}
+										note.Notations.Tuplets = append(note.Notations.Tuplets, mxl.Tuplet{Type: tupletType})
+									}
+
 									measure.Notes = append(measure.Notes, note)
 								}
 
@@ -291,6 +395,10 @@ func ToXML(w io.Writer, input []byte) error {
 				}
 			}
 
+			if barline := rightBarline(bar.repeat); barline != nil {
+				measure.Notes = append(measure.Notes, *barline)
+			}
+
 			p.Measures = append(p.Measures, measure)
 		}
 	}
@@ -325,7 +433,10 @@ func ToXML(w io.Writer, input []byte) error {
 	}
 
 	for _, p := range tps {
-		name := fmt.Sprintf("# %s", p.ID)
+		name, ok := names[p.ID]
+		if !ok {
+			name = fmt.Sprintf("# %s", p.ID)
+		}
 
 		score.PartList.Parts = append(score.PartList.Parts, mxl.ScorePart{
 			ID:   p.ID,
@@ -349,3 +460,118 @@ func ToXML(w io.Writer, input []byte) error {
 	enc.Indent("", "    ")
 	return enc.Encode(score)
 }
+
+// newDirection returns the notated direction of a dynamics event or nil.
+func newDirection(ev Event) *mxl.Direction {
+	if ev.Dynamic == "" && ev.Wedge == "" {
+		return nil
+	}
+
+	direction := &mxl.Direction{
+		Placement: "below",
+		Voice:     int(ev.Voice),
+	}
+
+	if ev.Wedge != "" {
+		direction.Types = append(direction.Types, mxl.DirectionType{
+			Wedge: &mxl.Wedge{Type: ev.Wedge},
+		})
+	}
+
+	if ev.Dynamic != "" {
+		direction.Types = append(direction.Types, mxl.DirectionType{
+			Dynamics: &mxl.Dynamics{Value: fmt.Sprintf("<%s/>", ev.Dynamic)},
+		})
+	}
+
+	return direction
+}
+
+// tupletGroup tracks the notated tuplet bracket of a voice.
+type tupletGroup struct {
+	n, m   int
+	length uint32 // length of the whole group in ticks
+	filled uint32
+}
+
+// next returns the tuplet bracket type of the next note in the voice.
+func (g *tupletGroup) next(props ast.PropertyList) string {
+	n, m := props.TupletRatio()
+	if n == 0 {
+		*g = tupletGroup{}
+		return ""
+	}
+
+	dur := props.NoteLen()
+
+	var typ string
+	if g.length == 0 || n != g.n || m != g.m {
+		*g = tupletGroup{
+			n:      n,
+			m:      m,
+			length: dur * uint32(n),
+		}
+		typ = "start"
+	}
+
+	g.filled += dur
+	if g.filled >= g.length {
+		*g = tupletGroup{}
+		typ = "stop"
+	}
+
+	return typ
+}
+
+func leftBarline(mark repeatMark) *mxl.Barline {
+	if !mark.forward && !mark.endingStart {
+		return nil
+	}
+
+	barline := &mxl.Barline{
+		Location: "left",
+	}
+
+	if mark.endingStart {
+		barline.Ending = &mxl.Ending{
+			Number: fmt.Sprintf("%d", mark.ending),
+			Type:   "start",
+		}
+	}
+
+	if mark.forward {
+		barline.BarStyle = "heavy-light"
+		barline.Repeat = &mxl.Repeat{
+			Direction: "forward",
+		}
+	}
+
+	return barline
+}
+
+func rightBarline(mark repeatMark) *mxl.Barline {
+	if !mark.backward && mark.endingStop == "" {
+		return nil
+	}
+
+	barline := &mxl.Barline{
+		Location: "right",
+	}
+
+	if mark.endingStop != "" {
+		barline.Ending = &mxl.Ending{
+			Number: fmt.Sprintf("%d", mark.ending),
+			Type:   mark.endingStop,
+		}
+	}
+
+	if mark.backward {
+		barline.BarStyle = "light-heavy"
+		barline.Repeat = &mxl.Repeat{
+			Direction: "backward",
+			Times:     mark.times,
+		}
+	}
+
+	return barline
+}
@@ -2,6 +2,7 @@ package balafon_test
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/mgnsk/balafon"
@@ -42,6 +43,43 @@ c
 	})
 }
 
+func TestXMLTransposedNotes(t *testing.T) {
+	t.Run("transposed sharp note becomes natural", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		err := balafon.ToXML(&buf, []byte(`
+:assign c 60
+:bar one
+	c#
+:end
+:play one transpose=+1
+`))
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(buf.String()).To(ContainSubstring("<step>D</step>"))
+		g.Expect(buf.String()).To(ContainSubstring("<alter>0</alter>"))
+	})
+
+	t.Run("transposed note is spelled by key signature", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		err := balafon.ToXML(&buf, []byte(`
+:key F
+:assign a 69
+:bar one
+	a
+:end
+:play one transpose=+1
+`))
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(buf.String()).To(ContainSubstring("<alter>-1</alter>"))
+		g.Expect(buf.String()).To(ContainSubstring("<step>B</step>"))
+	})
+}
+
 func TestXMLChords(t *testing.T) {
 	t.Run("single voice can chord", func(t *testing.T) {
 		g := NewWithT(t)
@@ -60,6 +98,19 @@ func TestXMLChords(t *testing.T) {
 		g.Expect(buf.String()).To(ContainSubstring("<chord>"))
 	})
 
+	t.Run("chord symbol", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		err := balafon.ToXML(&buf, []byte(`
+{Bb:4}1
+`))
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(strings.Count(buf.String(), "<chord>")).To(Equal(2))
+		g.Expect(strings.Count(buf.String(), "<alter>-1</alter>")).To(Equal(1))
+	})
+
 	t.Run("multiple voice cannot chord", func(t *testing.T) {
 		g := NewWithT(t)
 
@@ -80,3 +131,258 @@ func TestXMLChords(t *testing.T) {
 		g.Expect(buf.String()).NotTo(ContainSubstring("<chord>"))
 	})
 }
+
+func TestXMLTies(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := balafon.ToXML(&buf, []byte(`
+:assign c 60
+:time 1 4
+c~
+c
+`))
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(buf.String()).To(ContainSubstring(`<tie type="start"></tie>`))
+	g.Expect(buf.String()).To(ContainSubstring(`<tied type="start"></tied>`))
+	g.Expect(buf.String()).To(ContainSubstring(`<tie type="stop"></tie>`))
+	g.Expect(buf.String()).To(ContainSubstring(`<tied type="stop"></tied>`))
+}
+
+func TestXMLRepeat(t *testing.T) {
+	t.Run("repeat times", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		err := balafon.ToXML(&buf, []byte(`
+:assign c 60
+:time 1 4
+:repeat 3
+	c
+	c
+:end
+`))
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(strings.Count(buf.String(), "<measure ")).To(Equal(2))
+		g.Expect(buf.String()).To(ContainSubstring(`<barline location="left">
+                <bar-style>heavy-light</bar-style>
+                <repeat direction="forward"></repeat>
+            </barline>`))
+		g.Expect(buf.String()).To(ContainSubstring(`<barline location="right">
+                <bar-style>light-heavy</bar-style>
+                <repeat direction="backward" times="3"></repeat>
+            </barline>`))
+	})
+
+	t.Run("voltas", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		err := balafon.ToXML(&buf, []byte(`
+:assign c 60
+:time 1 4
+:repeat 2
+	c
+	:volta 1
+	c
+	:volta 2
+	c
+:end
+`))
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(strings.Count(buf.String(), "<measure ")).To(Equal(3))
+		g.Expect(buf.String()).To(ContainSubstring(`<barline location="left">
+                <ending number="1" type="start"></ending>
+            </barline>`))
+		g.Expect(buf.String()).To(ContainSubstring(`<barline location="right">
+                <bar-style>light-heavy</bar-style>
+                <ending number="1" type="stop"></ending>
+                <repeat direction="backward"></repeat>
+            </barline>`))
+		g.Expect(buf.String()).To(ContainSubstring(`<barline location="left">
+                <ending number="2" type="start"></ending>
+            </barline>`))
+		g.Expect(buf.String()).To(ContainSubstring(`<barline location="right">
+                <ending number="2" type="discontinue"></ending>
+            </barline>`))
+	})
+}
+
+func TestXMLTuplets(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := balafon.ToXML(&buf, []byte(`
+:assign c 60
+:time 2 4
+[ccc]8/3 [cc-cc-]16/6:4
+`))
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(strings.Count(buf.String(), "<actual-notes>3</actual-notes>")).To(Equal(3))
+	g.Expect(strings.Count(buf.String(), "<normal-notes>2</normal-notes>")).To(Equal(3))
+	g.Expect(strings.Count(buf.String(), "<actual-notes>6</actual-notes>")).To(Equal(6))
+	g.Expect(strings.Count(buf.String(), "<normal-notes>4</normal-notes>")).To(Equal(6))
+	g.Expect(strings.Count(buf.String(), `<tuplet type="start">`)).To(Equal(2))
+	g.Expect(strings.Count(buf.String(), `<tuplet type="stop">`)).To(Equal(2))
+}
+
+func TestXMLDynamics(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := balafon.ToXML(&buf, []byte(`
+:assign c 60
+:bar one
+	c1
+:end
+:dyn p
+:cresc ff bars=2
+:play one
+:play one
+`))
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(buf.String()).To(ContainSubstring("<dynamics><p/></dynamics>"))
+	g.Expect(buf.String()).To(ContainSubstring(`<wedge type="crescendo"></wedge>`))
+	g.Expect(buf.String()).To(ContainSubstring(`<wedge type="stop"></wedge>`))
+	g.Expect(buf.String()).To(ContainSubstring("<dynamics><ff/></dynamics>"))
+	g.Expect(strings.Count(buf.String(), "<direction ")).To(Equal(3))
+}
+
+func TestXMLInstrumentNames(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := balafon.ToXML(&buf, []byte(`
+:assign c 60
+:program "Flute"
+:channel 2
+:assign c 48
+c
+`))
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(buf.String()).To(SatisfyAll(
+		ContainSubstring("<part-name>Flute</part-name>"),
+		ContainSubstring("<instrument-name>Flute</instrument-name>"),
+		ContainSubstring("<part-name># 2</part-name>"),
+	))
+}
+
+func TestXMLPercussion(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := balafon.ToXML(&buf, []byte(`
+:channel 10
+:kit gm
+xk
+`))
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(buf.String()).NotTo(ContainSubstring("<pitch>"))
+	g.Expect(buf.String()).To(SatisfyAll(
+		ContainSubstring("<unpitched>"),
+		ContainSubstring("<display-step>G</display-step>"),
+		ContainSubstring("<display-octave>5</display-octave>"),
+		ContainSubstring("<display-step>F</display-step>"),
+		ContainSubstring("<display-octave>4</display-octave>"),
+		ContainSubstring(">x</notehead>"),
+	))
+}
+
+func TestXMLAbsolutePitches(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := balafon.ToXML(&buf, []byte(`
+<db4> <c#4> <cb4>
+`))
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(buf.String()).To(SatisfyAll(
+		ContainSubstring("<step>D</step>"),
+		ContainSubstring("<step>C</step>"),
+		ContainSubstring("<alter>-1</alter>"),
+		ContainSubstring("<alter>1</alter>"),
+	))
+	g.Expect(strings.Count(buf.String(), "<alter>-1</alter>")).To(Equal(2))
+	g.Expect(buf.String()).NotTo(ContainSubstring("<step>B</step>"))
+}
+
+func TestSwingNotation(t *testing.T) {
+	g := NewWithT(t)
+
+	var straight, swing bytes.Buffer
+	g.Expect(balafon.ToXML(&straight, []byte(":assign c 60; c8 c8 c4 c2"))).To(Succeed())
+	g.Expect(balafon.ToXML(&swing, []byte(":assign c 60; :groove shuffle; c8 c8 c4 c2"))).To(Succeed())
+	g.Expect(swing.String()).To(Equal(straight.String()))
+}
+
+func TestXMLPolymeter(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := balafon.ToXML(&buf, []byte(`
+:assign c 60
+:channel 2
+:assign c 60
+:bar a
+	:channel 1
+	cccc
+	:channel 2
+	:meter 7 8
+	c2..
+:end
+:play a
+:play a
+`))
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(strings.Count(buf.String(), "<time>")).To(Equal(1))
+	g.Expect(buf.String()).To(ContainSubstring("<beats>7</beats>"))
+	g.Expect(buf.String()).To(ContainSubstring("<beat-type>8</beat-type>"))
+}
+
+func TestXMLStaff(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := balafon.ToXML(&buf, []byte(`
+:assign c 60
+:time 3 4
+:staff on
+c2 c2 c1
+`))
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(strings.Count(buf.String(), "<measure ")).To(Equal(3))
+	g.Expect(strings.Count(buf.String(), `<tie type="start"></tie>`)).To(Equal(2))
+	g.Expect(strings.Count(buf.String(), `<tie type="stop"></tie>`)).To(Equal(2))
+	g.Expect(strings.Count(buf.String(), "<duration>960</duration>")).To(Equal(2))
+	g.Expect(strings.Count(buf.String(), "<duration>1920</duration>")).To(Equal(3))
+}
+
+func TestXMLPickup(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	err := balafon.ToXML(&buf, []byte(`
+:assign c 60
+:time 3 4
+:pickup
+c4
+c2.
+c2.
+`))
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(buf.String()).To(ContainSubstring(`<measure number="0" implicit="yes">`))
+	g.Expect(buf.String()).To(ContainSubstring(`<measure number="1">`))
+	g.Expect(buf.String()).To(ContainSubstring(`<measure number="2">`))
+	g.Expect(strings.Count(buf.String(), "<key>")).To(Equal(1))
+}